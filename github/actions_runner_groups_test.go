@@ -34,9 +34,9 @@ func TestActionsService_ListOrganizationRunnerGroups(t *testing.T) {
 	want := &RunnerGroups{
 		TotalCount: 3,
 		RunnerGroups: []*RunnerGroup{
-			{ID: Int64(1), Name: String("Default"), Visibility: String("all"), Default: Bool(true), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/1/runners"), Inherited: Bool(false), AllowsPublicRepositories: Bool(true), RestrictedToWorkflows: Bool(true), SelectedWorkflows: []string{"a", "b"}},
-			{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("selected"), Default: Bool(false), SelectedRepositoriesURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"), Inherited: Bool(true), AllowsPublicRepositories: Bool(true), RestrictedToWorkflows: Bool(false), SelectedWorkflows: []string{}},
-			{ID: Int64(3), Name: String("expensive-hardware"), Visibility: String("private"), Default: Bool(false), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/3/runners"), Inherited: Bool(false), AllowsPublicRepositories: Bool(true), RestrictedToWorkflows: Bool(false), SelectedWorkflows: []string{}},
+			{ID: Ptr(int64(1)), Name: Ptr("Default"), Visibility: Ptr("all"), Default: Ptr(true), RunnersURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/1/runners"), Inherited: Ptr(false), AllowsPublicRepositories: Ptr(true), RestrictedToWorkflows: Ptr(true), SelectedWorkflows: []string{"a", "b"}},
+			{ID: Ptr(int64(2)), Name: Ptr("octo-runner-group"), Visibility: Ptr("selected"), Default: Ptr(false), SelectedRepositoriesURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"), RunnersURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"), Inherited: Ptr(true), AllowsPublicRepositories: Ptr(true), RestrictedToWorkflows: Ptr(false), SelectedWorkflows: []string{}},
+			{ID: Ptr(int64(3)), Name: Ptr("expensive-hardware"), Visibility: Ptr("private"), Default: Ptr(false), RunnersURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/3/runners"), Inherited: Ptr(false), AllowsPublicRepositories: Ptr(true), RestrictedToWorkflows: Ptr(false), SelectedWorkflows: []string{}},
 		},
 	}
 	if !cmp.Equal(groups, want) {
@@ -78,9 +78,9 @@ func TestActionsService_ListOrganizationRunnerGroupsVisibleToRepo(t *testing.T)
 	want := &RunnerGroups{
 		TotalCount: 3,
 		RunnerGroups: []*RunnerGroup{
-			{ID: Int64(1), Name: String("Default"), Visibility: String("all"), Default: Bool(true), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/1/runners"), Inherited: Bool(false), AllowsPublicRepositories: Bool(true), RestrictedToWorkflows: Bool(false), SelectedWorkflows: []string{}},
-			{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("selected"), Default: Bool(false), SelectedRepositoriesURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"), Inherited: Bool(true), AllowsPublicRepositories: Bool(true), RestrictedToWorkflows: Bool(false), SelectedWorkflows: []string{}},
-			{ID: Int64(3), Name: String("expensive-hardware"), Visibility: String("private"), Default: Bool(false), RunnersURL: String("https://api.github.com/orgs/octo-org/actions/runner_groups/3/runners"), Inherited: Bool(false), AllowsPublicRepositories: Bool(true), RestrictedToWorkflows: Bool(false), SelectedWorkflows: []string{}},
+			{ID: Ptr(int64(1)), Name: Ptr("Default"), Visibility: Ptr("all"), Default: Ptr(true), RunnersURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/1/runners"), Inherited: Ptr(false), AllowsPublicRepositories: Ptr(true), RestrictedToWorkflows: Ptr(false), SelectedWorkflows: []string{}},
+			{ID: Ptr(int64(2)), Name: Ptr("octo-runner-group"), Visibility: Ptr("selected"), Default: Ptr(false), SelectedRepositoriesURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"), RunnersURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"), Inherited: Ptr(true), AllowsPublicRepositories: Ptr(true), RestrictedToWorkflows: Ptr(false), SelectedWorkflows: []string{}},
+			{ID: Ptr(int64(3)), Name: Ptr("expensive-hardware"), Visibility: Ptr("private"), Default: Ptr(false), RunnersURL: Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/3/runners"), Inherited: Ptr(false), AllowsPublicRepositories: Ptr(true), RestrictedToWorkflows: Ptr(false), SelectedWorkflows: []string{}},
 		},
 	}
 	if !cmp.Equal(groups, want) {
@@ -118,15 +118,15 @@ func TestActionsService_GetOrganizationRunnerGroup(t *testing.T) {
 	}
 
 	want := &RunnerGroup{
-		ID:                       Int64(2),
-		Name:                     String("octo-runner-group"),
-		Visibility:               String("selected"),
-		Default:                  Bool(false),
-		SelectedRepositoriesURL:  String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"),
-		RunnersURL:               String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"),
-		Inherited:                Bool(false),
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(false),
+		ID:                       Ptr(int64(2)),
+		Name:                     Ptr("octo-runner-group"),
+		Visibility:               Ptr("selected"),
+		Default:                  Ptr(false),
+		SelectedRepositoriesURL:  Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"),
+		RunnersURL:               Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"),
+		Inherited:                Ptr(false),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(false),
 		SelectedWorkflows:        []string{},
 	}
 
@@ -185,10 +185,10 @@ func TestActionsService_CreateOrganizationRunnerGroup(t *testing.T) {
 
 	ctx := context.Background()
 	req := CreateRunnerGroupRequest{
-		Name:                     String("octo-runner-group"),
-		Visibility:               String("selected"),
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(false),
+		Name:                     Ptr("octo-runner-group"),
+		Visibility:               Ptr("selected"),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(false),
 		SelectedWorkflows:        []string{},
 	}
 	group, _, err := client.Actions.CreateOrganizationRunnerGroup(ctx, "o", req)
@@ -197,15 +197,15 @@ func TestActionsService_CreateOrganizationRunnerGroup(t *testing.T) {
 	}
 
 	want := &RunnerGroup{
-		ID:                       Int64(2),
-		Name:                     String("octo-runner-group"),
-		Visibility:               String("selected"),
-		Default:                  Bool(false),
-		SelectedRepositoriesURL:  String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"),
-		RunnersURL:               String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"),
-		Inherited:                Bool(false),
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(false),
+		ID:                       Ptr(int64(2)),
+		Name:                     Ptr("octo-runner-group"),
+		Visibility:               Ptr("selected"),
+		Default:                  Ptr(false),
+		SelectedRepositoriesURL:  Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"),
+		RunnersURL:               Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"),
+		Inherited:                Ptr(false),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(false),
 		SelectedWorkflows:        []string{},
 	}
 
@@ -239,10 +239,10 @@ func TestActionsService_UpdateOrganizationRunnerGroup(t *testing.T) {
 
 	ctx := context.Background()
 	req := UpdateRunnerGroupRequest{
-		Name:                     String("octo-runner-group"),
-		Visibility:               String("selected"),
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(false),
+		Name:                     Ptr("octo-runner-group"),
+		Visibility:               Ptr("selected"),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(false),
 		SelectedWorkflows:        []string{},
 	}
 	group, _, err := client.Actions.UpdateOrganizationRunnerGroup(ctx, "o", 2, req)
@@ -251,15 +251,15 @@ func TestActionsService_UpdateOrganizationRunnerGroup(t *testing.T) {
 	}
 
 	want := &RunnerGroup{
-		ID:                       Int64(2),
-		Name:                     String("octo-runner-group"),
-		Visibility:               String("selected"),
-		Default:                  Bool(false),
-		SelectedRepositoriesURL:  String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"),
-		RunnersURL:               String("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"),
-		Inherited:                Bool(false),
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(false),
+		ID:                       Ptr(int64(2)),
+		Name:                     Ptr("octo-runner-group"),
+		Visibility:               Ptr("selected"),
+		Default:                  Ptr(false),
+		SelectedRepositoriesURL:  Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/repositories"),
+		RunnersURL:               Ptr("https://api.github.com/orgs/octo-org/actions/runner_groups/2/runners"),
+		Inherited:                Ptr(false),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(false),
 		SelectedWorkflows:        []string{},
 	}
 
@@ -300,9 +300,9 @@ func TestActionsService_ListRepositoryAccessRunnerGroup(t *testing.T) {
 	}
 
 	want := &ListRepositories{
-		TotalCount: Int(1),
+		TotalCount: Ptr(1),
 		Repositories: []*Repository{
-			{ID: Int64(43), NodeID: String("MDEwOlJlcG9zaXRvcnkxMjk2MjY5"), Name: String("Hello-World"), FullName: String("octocat/Hello-World")},
+			{ID: Ptr(int64(43)), NodeID: Ptr("MDEwOlJlcG9zaXRvcnkxMjk2MjY5"), Name: Ptr("Hello-World"), FullName: Ptr("octocat/Hello-World")},
 		},
 	}
 	if !cmp.Equal(groups, want) {
@@ -426,8 +426,8 @@ func TestActionsService_ListRunnerGroupRunners(t *testing.T) {
 	want := &Runners{
 		TotalCount: 2,
 		Runners: []*Runner{
-			{ID: Int64(23), Name: String("MBP"), OS: String("macos"), Status: String("online")},
-			{ID: Int64(24), Name: String("iMac"), OS: String("macos"), Status: String("offline")},
+			{ID: Ptr(int64(23)), Name: Ptr("MBP"), OS: Ptr("macos"), Status: Ptr("online")},
+			{ID: Ptr(int64(24)), Name: Ptr("iMac"), OS: Ptr("macos"), Status: Ptr("offline")},
 		},
 	}
 	if !cmp.Equal(runners, want) {
@@ -535,15 +535,15 @@ func TestRunnerGroup_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RunnerGroup{}, "{}")
 
 	u := &RunnerGroup{
-		ID:                       Int64(1),
-		Name:                     String("n"),
-		Visibility:               String("v"),
-		Default:                  Bool(true),
-		SelectedRepositoriesURL:  String("s"),
-		RunnersURL:               String("r"),
-		Inherited:                Bool(true),
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(false),
+		ID:                       Ptr(int64(1)),
+		Name:                     Ptr("n"),
+		Visibility:               Ptr("v"),
+		Default:                  Ptr(true),
+		SelectedRepositoriesURL:  Ptr("s"),
+		RunnersURL:               Ptr("r"),
+		Inherited:                Ptr(true),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(false),
 		SelectedWorkflows:        []string{},
 	}
 
@@ -570,15 +570,15 @@ func TestRunnerGroups_Marshal(t *testing.T) {
 		TotalCount: int(1),
 		RunnerGroups: []*RunnerGroup{
 			{
-				ID:                       Int64(1),
-				Name:                     String("n"),
-				Visibility:               String("v"),
-				Default:                  Bool(true),
-				SelectedRepositoriesURL:  String("s"),
-				RunnersURL:               String("r"),
-				Inherited:                Bool(true),
-				AllowsPublicRepositories: Bool(true),
-				RestrictedToWorkflows:    Bool(false),
+				ID:                       Ptr(int64(1)),
+				Name:                     Ptr("n"),
+				Visibility:               Ptr("v"),
+				Default:                  Ptr(true),
+				SelectedRepositoriesURL:  Ptr("s"),
+				RunnersURL:               Ptr("r"),
+				Inherited:                Ptr(true),
+				AllowsPublicRepositories: Ptr(true),
+				RestrictedToWorkflows:    Ptr(false),
 				SelectedWorkflows:        []string{},
 			},
 		},
@@ -607,12 +607,12 @@ func TestCreateRunnerGroupRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CreateRunnerGroupRequest{}, "{}")
 
 	u := &CreateRunnerGroupRequest{
-		Name:                     String("n"),
-		Visibility:               String("v"),
+		Name:                     Ptr("n"),
+		Visibility:               Ptr("v"),
 		SelectedRepositoryIDs:    []int64{1},
 		Runners:                  []int64{1},
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(true),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(true),
 		SelectedWorkflows:        []string{"a", "b"},
 	}
 
@@ -633,10 +633,10 @@ func TestUpdateRunnerGroupRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &UpdateRunnerGroupRequest{}, "{}")
 
 	u := &UpdateRunnerGroupRequest{
-		Name:                     String("n"),
-		Visibility:               String("v"),
-		AllowsPublicRepositories: Bool(true),
-		RestrictedToWorkflows:    Bool(false),
+		Name:                     Ptr("n"),
+		Visibility:               Ptr("v"),
+		AllowsPublicRepositories: Ptr(true),
+		RestrictedToWorkflows:    Ptr(false),
 		SelectedWorkflows:        []string{},
 	}
 
@@ -138,11 +138,30 @@ type Runner struct {
 	Labels []*RunnerLabels `json:"labels,omitempty"`
 }
 
+// RunnerLabelType specifies whether a runner label was assigned
+// automatically or added by a user.
+type RunnerLabelType string
+
+// Possible values for RunnerLabels.Type.
+const (
+	RunnerLabelTypeReadOnly RunnerLabelType = "read-only"
+	RunnerLabelTypeCustom   RunnerLabelType = "custom"
+)
+
 // RunnerLabels represents a collection of labels attached to each runner.
 type RunnerLabels struct {
-	ID   *int64  `json:"id,omitempty"`
-	Name *string `json:"name,omitempty"`
-	Type *string `json:"type,omitempty"`
+	ID   *int64           `json:"id,omitempty"`
+	Name *string          `json:"name,omitempty"`
+	Type *RunnerLabelType `json:"type,omitempty"`
+}
+
+// Validate reports an error if Type is set to a value that the API does not
+// use.
+func (r *RunnerLabels) Validate() error {
+	if r.Type == nil {
+		return nil
+	}
+	return validateEnum("type", *r.Type, RunnerLabelTypeReadOnly, RunnerLabelTypeCustom)
 }
 
 // Runners represents a collection of self-hosted runners for a repository.
@@ -0,0 +1,194 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	headerPollInterval = "X-Poll-Interval"
+	headerETag         = "ETag"
+	headerIfNoneMatch  = "If-None-Match"
+
+	// defaultPollInterval is used until the server sends an X-Poll-Interval header.
+	defaultPollInterval = 60 * time.Second
+)
+
+// EventPoller polls one of the events endpoints the way the Events API
+// expects to be polled: it waits for the interval requested by the server
+// in the X-Poll-Interval header, sends the last seen ETag in If-None-Match
+// so unchanged results don't count against the rate limit, and only returns
+// events that were not returned by the previous poll.
+//
+// An EventPoller is not safe for concurrent use.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events
+type EventPoller struct {
+	client *Client
+	url    string
+
+	etag     string
+	interval time.Duration
+	lastPoll time.Time
+	seen     map[string]bool
+}
+
+// NewEventPoller returns an EventPoller that uses client to poll the events
+// endpoint at urlStr, which is resolved relative to the client's BaseURL in
+// the same way as the urlStr passed to NewRequest, for example
+// "repos/o/r/events" or "events".
+func NewEventPoller(client *Client, urlStr string) *EventPoller {
+	return &EventPoller{
+		client:   client,
+		url:      urlStr,
+		interval: defaultPollInterval,
+	}
+}
+
+// Interval returns how long the poller waits between polls. It reflects the
+// most recent X-Poll-Interval header returned by the server.
+func (p *EventPoller) Interval() time.Duration {
+	return p.interval
+}
+
+// Poll immediately fetches the events endpoint and returns the events that
+// have not been returned by a previous call, oldest first. If the server
+// responds with 304 Not Modified, Poll returns no events and a nil error.
+//
+// When more than a page of events has arrived since the previous poll, Poll
+// follows the pagination links until it reaches an event it has already
+// returned, so no events are skipped; the returned Response is that of the
+// first page. The first call to Poll only fetches the first page. The Events
+// API itself only lists events of the past 90 days, up to 300 events, so
+// events may still be lost if polls are very infrequent.
+func (p *EventPoller) Poll(ctx context.Context) ([]*Event, *Response, error) {
+	req, err := p.client.NewRequest("GET", p.url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.etag != "" {
+		req.Header.Set(headerIfNoneMatch, p.etag)
+	}
+
+	var events []*Event
+	resp, err := p.client.Do(ctx, req, &events)
+	if resp != nil && resp.Response != nil {
+		p.lastPoll = time.Now()
+		if secs, convErr := strconv.Atoi(resp.Header.Get(headerPollInterval)); convErr == nil && secs > 0 {
+			p.interval = time.Duration(secs) * time.Second
+		}
+	}
+	if err != nil {
+		if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotModified {
+			return nil, resp, nil
+		}
+		return nil, resp, err
+	}
+
+	// The API lists events newest first. Keep fetching older pages until one
+	// overlaps with the events returned by the previous poll.
+	for next := resp.NextPage; next != 0 && p.seen != nil && !p.anySeen(events); {
+		var page []*Event
+		next, err = p.fetchPage(ctx, next, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+		events = append(events, page...)
+	}
+
+	if etag := resp.Header.Get(headerETag); etag != "" {
+		p.etag = etag
+	}
+
+	// Only the IDs from the latest poll are remembered, which bounds memory
+	// while still de-duplicating events that appear on consecutive polls.
+	seen := make(map[string]bool, len(events))
+	var fresh []*Event
+	for i := len(events) - 1; i >= 0; i-- {
+		e := events[i]
+		id := e.GetID()
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if p.seen[id] {
+			continue
+		}
+		fresh = append(fresh, e)
+	}
+	p.seen = seen
+
+	return fresh, resp, nil
+}
+
+// anySeen reports whether any of events was returned by the previous poll.
+func (p *EventPoller) anySeen(events []*Event) bool {
+	for _, e := range events {
+		if p.seen[e.GetID()] {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchPage fetches the given page of the events endpoint into v and returns
+// the number of the page after it, or 0 if it is the last one.
+func (p *EventPoller) fetchPage(ctx context.Context, page int, v *[]*Event) (int, error) {
+	u, err := url.Parse(p.url)
+	if err != nil {
+		return 0, err
+	}
+	q := u.Query()
+	q.Set("page", strconv.Itoa(page))
+	u.RawQuery = q.Encode()
+
+	req, err := p.client.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := p.client.Do(ctx, req, v)
+	if err != nil {
+		return 0, err
+	}
+	return resp.NextPage, nil
+}
+
+// Next waits until the poll interval has elapsed since the previous poll and
+// then calls Poll. The first call to Next polls immediately. Next returns
+// ctx.Err() if the context is done before the interval has elapsed.
+//
+// Next can be called in a loop to iterate over new events as they arrive:
+//
+//	p := github.NewEventPoller(client, "repos/o/r/events")
+//	for {
+//		events, _, err := p.Next(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		for _, e := range events {
+//			// handle e
+//		}
+//	}
+func (p *EventPoller) Next(ctx context.Context) ([]*Event, *Response, error) {
+	if !p.lastPoll.IsZero() {
+		if wait := time.Until(p.lastPoll.Add(p.interval)); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	return p.Poll(ctx)
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEventPoller_Poll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	call := 0
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		call++
+		switch call {
+		case 1:
+			testHeader(t, r, "If-None-Match", "")
+			w.Header().Set("ETag", `"a"`)
+			w.Header().Set("X-Poll-Interval", "30")
+			fmt.Fprint(w, `[{"id":"2"},{"id":"1"}]`)
+		case 2:
+			testHeader(t, r, "If-None-Match", `"a"`)
+			w.Header().Set("X-Poll-Interval", "90")
+			w.WriteHeader(http.StatusNotModified)
+		case 3:
+			testHeader(t, r, "If-None-Match", `"a"`)
+			w.Header().Set("ETag", `"b"`)
+			fmt.Fprint(w, `[{"id":"4"},{"id":"3"},{"id":"2"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	p := NewEventPoller(client, "repos/o/r/events")
+	if got, want := p.Interval(), defaultPollInterval; got != want {
+		t.Errorf("EventPoller.Interval = %v, want %v", got, want)
+	}
+
+	events, _, err := p.Poll(ctx)
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	want := []*Event{{ID: Ptr("1")}, {ID: Ptr("2")}}
+	if !cmp.Equal(events, want) {
+		t.Errorf("EventPoller.Poll returned %+v, want %+v", events, want)
+	}
+	if got, want := p.Interval(), 30*time.Second; got != want {
+		t.Errorf("EventPoller.Interval = %v, want %v", got, want)
+	}
+
+	events, resp, err := p.Poll(ctx)
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	if events != nil {
+		t.Errorf("EventPoller.Poll returned %+v, want nil", events)
+	}
+	if got, want := resp.StatusCode, http.StatusNotModified; got != want {
+		t.Errorf("EventPoller.Poll status = %v, want %v", got, want)
+	}
+	if got, want := p.Interval(), 90*time.Second; got != want {
+		t.Errorf("EventPoller.Interval = %v, want %v", got, want)
+	}
+
+	events, _, err = p.Poll(ctx)
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	want = []*Event{{ID: Ptr("3")}, {ID: Ptr("4")}}
+	if !cmp.Equal(events, want) {
+		t.Errorf("EventPoller.Poll returned %+v, want %+v", events, want)
+	}
+}
+
+func TestEventPoller_Poll_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	p := NewEventPoller(client, "events")
+	events, resp, err := p.Poll(ctx)
+	if err == nil {
+		t.Error("EventPoller.Poll returned nil error, want error")
+	}
+	if events != nil {
+		t.Errorf("EventPoller.Poll returned %+v, want nil", events)
+	}
+	if got, want := resp.StatusCode, http.StatusInternalServerError; got != want {
+		t.Errorf("EventPoller.Poll status = %v, want %v", got, want)
+	}
+
+	p = NewEventPoller(client, "\n")
+	if _, _, err := p.Poll(ctx); err == nil {
+		t.Error("EventPoller.Poll with bad URL returned nil error, want error")
+	}
+}
+
+func TestEventPoller_Next(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Poll-Interval", "60")
+		fmt.Fprint(w, `[{"id":"1"}]`)
+	})
+
+	ctx := context.Background()
+	p := NewEventPoller(client, "events")
+	events, _, err := p.Next(ctx)
+	if err != nil {
+		t.Fatalf("EventPoller.Next returned error: %v", err)
+	}
+	if want := []*Event{{ID: Ptr("1")}}; !cmp.Equal(events, want) {
+		t.Errorf("EventPoller.Next returned %+v, want %+v", events, want)
+	}
+
+	// The second call must wait for the poll interval, so it is cut short by
+	// the context deadline.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := p.Next(ctx); err != context.DeadlineExceeded {
+		t.Errorf("EventPoller.Next returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestEventPoller_Poll_pagination(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	call := 0
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		call++
+		switch call {
+		case 1:
+			testFormValues(t, r, values{})
+			w.Header().Set("Link", `<https://api.github.com/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"2"},{"id":"1"}]`)
+		case 2:
+			testFormValues(t, r, values{})
+			w.Header().Set("Link", `<https://api.github.com/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"6"},{"id":"5"}]`)
+		case 3:
+			testFormValues(t, r, values{"page": "2"})
+			w.Header().Set("Link", `<https://api.github.com/events?page=3>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"4"},{"id":"3"}]`)
+		case 4:
+			testFormValues(t, r, values{"page": "3"})
+			w.Header().Set("Link", `<https://api.github.com/events?page=4>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"2"},{"id":"1"}]`)
+		default:
+			t.Errorf("unexpected request for page %v", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	p := NewEventPoller(client, "events")
+
+	// The first poll only fetches the first page.
+	events, _, err := p.Poll(ctx)
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	if want := []*Event{{ID: Ptr("1")}, {ID: Ptr("2")}}; !cmp.Equal(events, want) {
+		t.Errorf("EventPoller.Poll returned %+v, want %+v", events, want)
+	}
+
+	// The next poll follows the links until it reaches a seen event.
+	events, _, err = p.Poll(ctx)
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	want := []*Event{{ID: Ptr("3")}, {ID: Ptr("4")}, {ID: Ptr("5")}, {ID: Ptr("6")}}
+	if !cmp.Equal(events, want) {
+		t.Errorf("EventPoller.Poll returned %+v, want %+v", events, want)
+	}
+}
+
+func TestEventPoller_Poll_paginationError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	call := 0
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		call++
+		switch {
+		case call == 1:
+			fmt.Fprint(w, `[{"id":"1"}]`)
+		case r.FormValue("page") == "":
+			w.Header().Set("Link", `<https://api.github.com/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"2"}]`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	ctx := context.Background()
+	p := NewEventPoller(client, "events")
+	if _, _, err := p.Poll(ctx); err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	events, resp, err := p.Poll(ctx)
+	if err == nil {
+		t.Error("EventPoller.Poll returned nil error, want error")
+	}
+	if events != nil {
+		t.Errorf("EventPoller.Poll returned %+v, want nil", events)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("EventPoller.Poll returned response %v, want the first page", resp)
+	}
+}
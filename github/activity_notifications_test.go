@@ -44,7 +44,7 @@ func TestActivityService_ListNotification(t *testing.T) {
 		t.Errorf("Activity.ListNotifications returned error: %v", err)
 	}
 
-	want := []*Notification{{ID: String("1"), Subject: &NotificationSubject{Title: String("t")}}}
+	want := []*Notification{{ID: Ptr("1"), Subject: &NotificationSubject{Title: Ptr("t")}}}
 	if !cmp.Equal(notifications, want) {
 		t.Errorf("Activity.ListNotifications returned %+v, want %+v", notifications, want)
 	}
@@ -74,7 +74,7 @@ func TestActivityService_ListRepositoryNotifications(t *testing.T) {
 		t.Errorf("Activity.ListRepositoryNotifications returned error: %v", err)
 	}
 
-	want := []*Notification{{ID: String("1")}}
+	want := []*Notification{{ID: Ptr("1")}}
 	if !cmp.Equal(notifications, want) {
 		t.Errorf("Activity.ListRepositoryNotifications returned %+v, want %+v", notifications, want)
 	}
@@ -162,7 +162,7 @@ func TestActivityService_GetThread(t *testing.T) {
 		t.Errorf("Activity.GetThread returned error: %v", err)
 	}
 
-	want := &Notification{ID: String("1")}
+	want := &Notification{ID: Ptr("1")}
 	if !cmp.Equal(notification, want) {
 		t.Errorf("Activity.GetThread returned %+v, want %+v", notification, want)
 	}
@@ -208,6 +208,32 @@ func TestActivityService_MarkThreadRead(t *testing.T) {
 	})
 }
 
+func TestActivityService_MarkThreadDone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications/threads/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Activity.MarkThreadDone(ctx, "1")
+	if err != nil {
+		t.Errorf("Activity.MarkThreadDone returned error: %v", err)
+	}
+
+	const methodName = "MarkThreadDone"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Activity.MarkThreadDone(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Activity.MarkThreadDone(ctx, "1")
+	})
+}
+
 func TestActivityService_GetThreadSubscription(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -223,7 +249,7 @@ func TestActivityService_GetThreadSubscription(t *testing.T) {
 		t.Errorf("Activity.GetThreadSubscription returned error: %v", err)
 	}
 
-	want := &Subscription{Subscribed: Bool(true)}
+	want := &Subscription{Subscribed: Ptr(true)}
 	if !cmp.Equal(sub, want) {
 		t.Errorf("Activity.GetThreadSubscription returned %+v, want %+v", sub, want)
 	}
@@ -247,7 +273,7 @@ func TestActivityService_SetThreadSubscription(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &Subscription{Subscribed: Bool(true)}
+	input := &Subscription{Subscribed: Ptr(true)}
 
 	mux.HandleFunc("/notifications/threads/1/subscription", func(w http.ResponseWriter, r *http.Request) {
 		v := new(Subscription)
@@ -267,7 +293,7 @@ func TestActivityService_SetThreadSubscription(t *testing.T) {
 		t.Errorf("Activity.SetThreadSubscription returned error: %v", err)
 	}
 
-	want := &Subscription{Ignored: Bool(true)}
+	want := &Subscription{Ignored: Ptr(true)}
 	if !cmp.Equal(sub, want) {
 		t.Errorf("Activity.SetThreadSubscription returned %+v, want %+v", sub, want)
 	}
@@ -317,23 +343,23 @@ func TestNotification_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Notification{}, "{}")
 
 	u := &Notification{
-		ID: String("id"),
+		ID: Ptr("id"),
 		Repository: &Repository{
-			ID:   Int64(1),
-			URL:  String("u"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("u"),
+			Name: Ptr("n"),
 		},
 		Subject: &NotificationSubject{
-			Title:            String("t"),
-			URL:              String("u"),
-			LatestCommentURL: String("l"),
-			Type:             String("t"),
+			Title:            Ptr("t"),
+			URL:              Ptr("u"),
+			LatestCommentURL: Ptr("l"),
+			Type:             Ptr("t"),
 		},
-		Reason:     String("r"),
-		Unread:     Bool(true),
+		Reason:     Ptr("r"),
+		Unread:     Ptr(true),
 		UpdatedAt:  &Timestamp{referenceTime},
 		LastReadAt: &Timestamp{referenceTime},
-		URL:        String("u"),
+		URL:        Ptr("u"),
 	}
 
 	want := `{
@@ -363,10 +389,10 @@ func TestNotificationSubject_Marshal(t *testing.T) {
 	testJSONMarshal(t, &NotificationSubject{}, "{}")
 
 	u := &NotificationSubject{
-		Title:            String("t"),
-		URL:              String("u"),
-		LatestCommentURL: String("l"),
-		Type:             String("t"),
+		Title:            Ptr("t"),
+		URL:              Ptr("u"),
+		LatestCommentURL: Ptr("l"),
+		Type:             Ptr("t"),
 	}
 
 	want := `{
@@ -34,7 +34,7 @@ func TestActivityService_ListWatchers(t *testing.T) {
 		t.Errorf("Activity.ListWatchers returned error: %v", err)
 	}
 
-	want := []*User{{ID: Int64(1)}}
+	want := []*User{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(watchers, want) {
 		t.Errorf("Activity.ListWatchers returned %+v, want %+v", watchers, want)
 	}
@@ -72,7 +72,7 @@ func TestActivityService_ListWatched_authenticatedUser(t *testing.T) {
 		t.Errorf("Activity.ListWatched returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(watched, want) {
 		t.Errorf("Activity.ListWatched returned %+v, want %+v", watched, want)
 	}
@@ -110,7 +110,7 @@ func TestActivityService_ListWatched_specifiedUser(t *testing.T) {
 		t.Errorf("Activity.ListWatched returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(watched, want) {
 		t.Errorf("Activity.ListWatched returned %+v, want %+v", watched, want)
 	}
@@ -131,7 +131,7 @@ func TestActivityService_GetRepositorySubscription_true(t *testing.T) {
 		t.Errorf("Activity.GetRepositorySubscription returned error: %v", err)
 	}
 
-	want := &Subscription{Subscribed: Bool(true)}
+	want := &Subscription{Subscribed: Ptr(true)}
 	if !cmp.Equal(sub, want) {
 		t.Errorf("Activity.GetRepositorySubscription returned %+v, want %+v", sub, want)
 	}
@@ -192,7 +192,7 @@ func TestActivityService_SetRepositorySubscription(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &Subscription{Subscribed: Bool(true)}
+	input := &Subscription{Subscribed: Ptr(true)}
 
 	mux.HandleFunc("/repos/o/r/subscription", func(w http.ResponseWriter, r *http.Request) {
 		v := new(Subscription)
@@ -212,7 +212,7 @@ func TestActivityService_SetRepositorySubscription(t *testing.T) {
 		t.Errorf("Activity.SetRepositorySubscription returned error: %v", err)
 	}
 
-	want := &Subscription{Ignored: Bool(true)}
+	want := &Subscription{Ignored: Ptr(true)}
 	if !cmp.Equal(sub, want) {
 		t.Errorf("Activity.SetRepositorySubscription returned %+v, want %+v", sub, want)
 	}
@@ -262,13 +262,13 @@ func TestSubscription_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Subscription{}, "{}")
 
 	u := &Subscription{
-		Subscribed:    Bool(true),
-		Ignored:       Bool(false),
-		Reason:        String("r"),
+		Subscribed:    Ptr(true),
+		Ignored:       Ptr(false),
+		Reason:        Ptr("r"),
 		CreatedAt:     &Timestamp{referenceTime},
-		URL:           String("u"),
-		RepositoryURL: String("ru"),
-		ThreadURL:     String("tu"),
+		URL:           Ptr("u"),
+		RepositoryURL: Ptr("ru"),
+		ThreadURL:     Ptr("tu"),
 	}
 
 	want := `{
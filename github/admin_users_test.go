@@ -25,7 +25,7 @@ func TestAdminUsers_Create(t *testing.T) {
 		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
 
 		testMethod(t, r, "POST")
-		want := &CreateUserRequest{Login: "github", Email: String("email@domain.com"), Suspended: Bool(false)}
+		want := &CreateUserRequest{Login: "github", Email: Ptr("email@domain.com"), Suspended: Ptr(false)}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -36,14 +36,14 @@ func TestAdminUsers_Create(t *testing.T) {
 	ctx := context.Background()
 	org, _, err := client.Admin.CreateUser(ctx, CreateUserRequest{
 		Login:     "github",
-		Email:     String("email@domain.com"),
-		Suspended: Bool(false),
+		Email:     Ptr("email@domain.com"),
+		Suspended: Ptr(false),
 	})
 	if err != nil {
 		t.Errorf("Admin.CreateUser returned error: %v", err)
 	}
 
-	want := &User{ID: Int64(1), Login: String("github")}
+	want := &User{ID: Ptr(int64(1)), Login: Ptr("github")}
 	if !cmp.Equal(org, want) {
 		t.Errorf("Admin.CreateUser returned %+v, want %+v", org, want)
 	}
@@ -52,8 +52,8 @@ func TestAdminUsers_Create(t *testing.T) {
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
 		got, resp, err := client.Admin.CreateUser(ctx, CreateUserRequest{
 			Login:     "github",
-			Email:     String("email@domain.com"),
-			Suspended: Bool(false),
+			Email:     Ptr("email@domain.com"),
+			Suspended: Ptr(false),
 		})
 		if got != nil {
 			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
@@ -123,16 +123,16 @@ func TestUserImpersonation_Create(t *testing.T) {
 
 	date := Timestamp{Time: time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)}
 	want := &UserAuthorization{
-		ID:  Int64(1234),
-		URL: String("https://git.company.com/api/v3/authorizations/1234"),
+		ID:  Ptr(int64(1234)),
+		URL: Ptr("https://git.company.com/api/v3/authorizations/1234"),
 		App: &OAuthAPP{
-			Name:     String("GitHub Site Administrator"),
-			URL:      String("https://docs.github.com/en/rest/enterprise/users/"),
-			ClientID: String("1234"),
+			Name:     Ptr("GitHub Site Administrator"),
+			URL:      Ptr("https://docs.github.com/en/rest/enterprise/users/"),
+			ClientID: Ptr("1234"),
 		},
-		Token:          String("1234"),
-		HashedToken:    String("1234"),
-		TokenLastEight: String("1234"),
+		Token:          Ptr("1234"),
+		HashedToken:    Ptr("1234"),
+		TokenLastEight: Ptr("1234"),
 		Note:           nil,
 		NoteURL:        nil,
 		CreatedAt:      &date,
@@ -189,7 +189,7 @@ func TestCreateUserRequest_Marshal(t *testing.T) {
 
 	u := &CreateUserRequest{
 		Login: "l",
-		Email: String("e"),
+		Email: Ptr("e"),
 	}
 
 	want := `{
@@ -220,9 +220,9 @@ func TestOAuthAPP_Marshal(t *testing.T) {
 	testJSONMarshal(t, &OAuthAPP{}, "{}")
 
 	u := &OAuthAPP{
-		URL:      String("u"),
-		Name:     String("n"),
-		ClientID: String("cid"),
+		URL:      Ptr("u"),
+		Name:     Ptr("n"),
+		ClientID: Ptr("cid"),
 	}
 
 	want := `{
@@ -238,24 +238,24 @@ func TestUserAuthorization_Marshal(t *testing.T) {
 	testJSONMarshal(t, &UserAuthorization{}, "{}")
 
 	u := &UserAuthorization{
-		ID:  Int64(1),
-		URL: String("u"),
+		ID:  Ptr(int64(1)),
+		URL: Ptr("u"),
 		Scopes: []string{
 			"s",
 		},
-		Token:          String("t"),
-		TokenLastEight: String("tle"),
-		HashedToken:    String("ht"),
+		Token:          Ptr("t"),
+		TokenLastEight: Ptr("tle"),
+		HashedToken:    Ptr("ht"),
 		App: &OAuthAPP{
-			URL:      String("u"),
-			Name:     String("n"),
-			ClientID: String("cid"),
+			URL:      Ptr("u"),
+			Name:     Ptr("n"),
+			ClientID: Ptr("cid"),
 		},
-		Note:        String("n"),
-		NoteURL:     String("nu"),
+		Note:        Ptr("n"),
+		NoteURL:     Ptr("nu"),
 		UpdatedAt:   &Timestamp{referenceTime},
 		CreatedAt:   &Timestamp{referenceTime},
-		Fingerprint: String("f"),
+		Fingerprint: Ptr("f"),
 	}
 
 	want := `{
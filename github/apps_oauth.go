@@ -0,0 +1,123 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UserAccessToken represents a user access token issued to a GitHub App
+// that has expiring user access tokens enabled.
+//
+// ExpiresAt and RefreshTokenExpiresAt are not part of the API response;
+// they are computed from ExpiresIn and RefreshTokenExpiresIn relative to
+// the time the request was sent.
+//
+// GitHub API docs: https://docs.github.com/apps/creating-github-apps/authenticating-with-a-github-app/refreshing-user-access-tokens
+type UserAccessToken struct {
+	AccessToken           *string `json:"access_token,omitempty"`
+	ExpiresIn             *int64  `json:"expires_in,omitempty"`
+	RefreshToken          *string `json:"refresh_token,omitempty"`
+	RefreshTokenExpiresIn *int64  `json:"refresh_token_expires_in,omitempty"`
+	Scope                 *string `json:"scope,omitempty"`
+	TokenType             *string `json:"token_type,omitempty"`
+
+	ExpiresAt             *Timestamp `json:"-"`
+	RefreshTokenExpiresAt *Timestamp `json:"-"`
+}
+
+// OAuthError is returned when the OAuth token endpoint rejects a request.
+// The endpoint reports these errors in the body of a 200 OK response.
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	URI         string `json:"error_uri,omitempty"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return fmt.Sprintf("%v: %v", e.Code, e.Description)
+}
+
+// refreshUserTokenRequest represents the body of a token refresh request.
+type refreshUserTokenRequest struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	GrantType    string `json:"grant_type"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshUserToken exchanges a refresh token for a new user access token
+// and refresh token. The previous refresh token can no longer be used once
+// it has been exchanged.
+//
+// The token endpoint is served from the web host rather than the API host,
+// so the request is sent to login/oauth/access_token on the host derived
+// from the client's BaseURL (e.g. github.com for api.github.com, or the
+// server root for a GitHub Enterprise Server BaseURL ending in "api/v3/").
+//
+// If the endpoint rejects the refresh token, the returned error is an
+// *OAuthError.
+//
+// GitHub API docs: https://docs.github.com/apps/creating-github-apps/authenticating-with-a-github-app/refreshing-user-access-tokens#refreshing-a-user-access-token-with-a-refresh-token
+//
+//meta:operation POST /login/oauth/access_token
+func (s *AppsService) RefreshUserToken(ctx context.Context, clientID, clientSecret, refreshToken string) (*UserAccessToken, *Response, error) {
+	body := &refreshUserTokenRequest{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		GrantType:    "refresh_token",
+		RefreshToken: refreshToken,
+	}
+	req, err := s.client.NewRequest("POST", s.client.oauthURL("login/oauth/access_token"), body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var result struct {
+		UserAccessToken
+		OAuthError
+	}
+	now := time.Now()
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Code != "" {
+		oauthErr := result.OAuthError
+		return nil, resp, &oauthErr
+	}
+	if result.AccessToken == nil {
+		return nil, resp, errors.New("token response did not include an access token")
+	}
+
+	t := result.UserAccessToken
+	if t.ExpiresIn != nil {
+		t.ExpiresAt = &Timestamp{now.Add(time.Duration(*t.ExpiresIn) * time.Second)}
+	}
+	if t.RefreshTokenExpiresIn != nil {
+		t.RefreshTokenExpiresAt = &Timestamp{now.Add(time.Duration(*t.RefreshTokenExpiresIn) * time.Second)}
+	}
+
+	return &t, resp, nil
+}
+
+// oauthURL returns the absolute URL of path on the web host that serves the
+// OAuth endpoints for the client's BaseURL.
+func (c *Client) oauthURL(path string) string {
+	u := *c.BaseURL
+	u.Host = strings.TrimPrefix(u.Host, "api.")
+	u.Path = strings.TrimSuffix(u.Path, "api/v3/")
+	u.RawPath = ""
+	return u.String() + path
+}
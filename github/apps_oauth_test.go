@@ -0,0 +1,128 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAppsService_RefreshUserToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testBody(t, r, `{"client_id":"id","client_secret":"secret","grant_type":"refresh_token","refresh_token":"r1"}`+"\n")
+		fmt.Fprint(w, `{
+			"access_token": "t2",
+			"expires_in": 28800,
+			"refresh_token": "r2",
+			"refresh_token_expires_in": 15811200,
+			"scope": "",
+			"token_type": "bearer"
+		}`)
+	})
+
+	ctx := context.Background()
+	before := time.Now()
+	token, _, err := client.Apps.RefreshUserToken(ctx, "id", "secret", "r1")
+	if err != nil {
+		t.Fatalf("Apps.RefreshUserToken returned error: %v", err)
+	}
+
+	want := &UserAccessToken{
+		AccessToken:           Ptr("t2"),
+		ExpiresIn:             Ptr(int64(28800)),
+		RefreshToken:          Ptr("r2"),
+		RefreshTokenExpiresIn: Ptr(int64(15811200)),
+		Scope:                 Ptr(""),
+		TokenType:             Ptr("bearer"),
+	}
+	got := *token
+	got.ExpiresAt, got.RefreshTokenExpiresAt = nil, nil
+	if !cmp.Equal(&got, want) {
+		t.Errorf("Apps.RefreshUserToken returned %+v, want %+v", got, want)
+	}
+
+	if e := token.GetExpiresAt().Time; e.Before(before.Add(8*time.Hour)) || e.After(time.Now().Add(8*time.Hour)) {
+		t.Errorf("Apps.RefreshUserToken ExpiresAt = %v, want about 8h from now", e)
+	}
+	if e := token.GetRefreshTokenExpiresAt().Time; e.Before(before.Add(15811200 * time.Second)) {
+		t.Errorf("Apps.RefreshUserToken RefreshTokenExpiresAt = %v, want about 6 months from now", e)
+	}
+
+	const methodName = "RefreshUserToken"
+	testBadOptions(t, methodName, func() (err error) {
+		client.BaseURL.Path = ""
+		_, _, err = client.Apps.RefreshUserToken(ctx, "id", "secret", "r1")
+		return err
+	})
+}
+
+func TestAppsService_RefreshUserToken_oauthError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error":"bad_refresh_token","error_description":"The refresh token passed is incorrect or expired.","error_uri":"https://docs.github.com"}`)
+	})
+
+	ctx := context.Background()
+	token, _, err := client.Apps.RefreshUserToken(ctx, "id", "secret", "r1")
+	if token != nil {
+		t.Errorf("Apps.RefreshUserToken returned %+v, want nil", token)
+	}
+	var oauthErr *OAuthError
+	if !errors.As(err, &oauthErr) {
+		t.Fatalf("Apps.RefreshUserToken returned error %v, want *OAuthError", err)
+	}
+	want := &OAuthError{
+		Code:        "bad_refresh_token",
+		Description: "The refresh token passed is incorrect or expired.",
+		URI:         "https://docs.github.com",
+	}
+	if !cmp.Equal(oauthErr, want) {
+		t.Errorf("Apps.RefreshUserToken returned error %+v, want %+v", oauthErr, want)
+	}
+	if got, want := err.Error(), "bad_refresh_token: The refresh token passed is incorrect or expired."; got != want {
+		t.Errorf("OAuthError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestOAuthError_Error(t *testing.T) {
+	err := &OAuthError{Code: "incorrect_client_credentials"}
+	if got, want := err.Error(), "incorrect_client_credentials"; got != want {
+		t.Errorf("OAuthError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestClient_oauthURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"https://api.github.com/", "https://github.com/login/oauth/access_token"},
+		{"https://api.octocorp.ghe.com/", "https://octocorp.ghe.com/login/oauth/access_token"},
+		{"https://ghe.example.com/api/v3/", "https://ghe.example.com/login/oauth/access_token"},
+	}
+
+	for _, tt := range tests {
+		c := NewClient(nil)
+		c.BaseURL, _ = url.Parse(tt.baseURL)
+		if got := c.oauthURL("login/oauth/access_token"); got != tt.want {
+			t.Errorf("oauthURL for %v = %v, want %v", tt.baseURL, got, tt.want)
+		}
+	}
+}
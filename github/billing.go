@@ -56,8 +56,8 @@ type RepositoryActiveCommitters struct {
 
 // AdvancedSecurityCommittersBreakdown represents the user activity breakdown for ActiveCommitters.
 type AdvancedSecurityCommittersBreakdown struct {
-	UserLogin      *string `json:"user_login,omitempty"`
-	LastPushedDate *string `json:"last_pushed_date,omitempty"`
+	UserLogin      *string    `json:"user_login,omitempty"`
+	LastPushedDate *Timestamp `json:"last_pushed_date,omitempty"`
 }
 
 // GetActionsBillingOrg returns the summary of the free and paid GitHub Actions minutes used for an Org.
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -462,12 +463,12 @@ func TestBillingService_GetAdvancedSecurityActiveCommittersOrg(t *testing.T) {
 		TotalAdvancedSecurityCommitters: 2,
 		Repositories: []*RepositoryActiveCommitters{
 			{
-				Name:                       String("octocat-org/Hello-World"),
-				AdvancedSecurityCommitters: Int(2),
+				Name:                       Ptr("octocat-org/Hello-World"),
+				AdvancedSecurityCommitters: Ptr(2),
 				AdvancedSecurityCommittersBreakdown: []*AdvancedSecurityCommittersBreakdown{
 					{
-						UserLogin:      String("octokitten"),
-						LastPushedDate: String("2021-10-25"),
+						UserLogin:      Ptr("octokitten"),
+						LastPushedDate: &Timestamp{time.Date(2021, time.October, 25, 0, 0, 0, 0, time.UTC)},
 					},
 				},
 			},
@@ -0,0 +1,231 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+	"time"
+)
+
+const headerFromCache = "X-From-Cache"
+
+// CacheStore stores API responses so that a client configured with
+// WithCacheStore can make conditional requests. Responses that are not
+// modified since they were stored count against the rate limit only once.
+//
+// Implementations must be safe for concurrent use. Get and Set errors are
+// treated as cache misses, so a store that is temporarily unavailable only
+// costs extra requests.
+//
+// A store shared by several clients, for example one backed by Redis and
+// used by several replicas of a service, must only be shared by clients
+// that use the same credentials, since cached responses may contain data
+// that only those credentials can see. A Redis-backed store can be as simple
+// as:
+//
+//	type redisStore struct{ rdb *redis.Client }
+//
+//	func (s redisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+//		v, err := s.rdb.Get(ctx, key).Bytes()
+//		if err == redis.Nil {
+//			return nil, false, nil
+//		}
+//		return v, err == nil, err
+//	}
+//
+//	func (s redisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+//		return s.rdb.Set(ctx, key, value, ttl).Err()
+//	}
+type CacheStore interface {
+	// Get returns the value stored for key, and whether it was found.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+
+	// Set stores value for key. The value may be discarded after ttl;
+	// a ttl of zero means it never expires.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// WithCacheStore returns a copy of the client that caches the responses to
+// GET requests that include an ETag header in store for up to ttl, and
+// revalidates them with If-None-Match. When GitHub responds 304 Not
+// Modified, the cached response is returned instead, with the X-From-Cache
+// header set. Requests that already set If-None-Match are not cached.
+//
+// Cached responses are keyed by the token set with WithAuthToken and by any
+// Authorization header set on the request, so clients with different tokens
+// don't see each other's responses. Credentials added by the transport of the
+// *http.Client passed to NewClient, such as an oauth2 or GitHub App
+// transport, are not visible to the cache: clients authenticated that way
+// must not share a store unless they use the same credentials, since cached
+// responses may contain data that only those credentials can see.
+//
+// Passing a nil store disables caching.
+func (c *Client) WithCacheStore(store CacheStore, ttl time.Duration) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.cacheStore = store
+	c2.cacheTTL = ttl
+	return c2
+}
+
+// cacheKey returns the key under which the response to req is cached. The
+// key includes the headers that select the representation of the resource,
+// and a hash of the credentials known to the client.
+func (c *Client) cacheKey(req *http.Request) string {
+	key := req.URL.String() + " " + req.Header.Get("Accept") + " " + req.Header.Get(headerAPIVersion)
+	if c.credentialKey != "" {
+		key += " " + c.credentialKey
+	}
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		key += " " + hashCredential(auth)
+	}
+	return key
+}
+
+// hashCredential returns a digest of an Authorization header value that can
+// be stored in a cache key without revealing it.
+func hashCredential(auth string) string {
+	sum := sha256.Sum256([]byte(auth))
+	return hex.EncodeToString(sum[:16])
+}
+
+// doWithCache sends req, using the client's CacheStore, if any, to make the
+// request conditional and to serve unmodified responses.
+func (c *Client) doWithCache(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.cacheStore == nil || req.Method != http.MethodGet || req.Header.Get(headerIfNoneMatch) != "" || ctx.Value(bypassCache) != nil {
+		return c.doWithRetry(ctx, req)
+	}
+
+	key := c.cacheKey(req)
+	var cached *http.Response
+	if data, ok, err := c.cacheStore.Get(ctx, key); err == nil && ok {
+		if r, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req); err == nil {
+			cached = r
+		}
+	}
+	if cached != nil {
+		if etag := cached.Header.Get(headerETag); etag != "" {
+			req = req.Clone(req.Context())
+			req.Header.Set(headerIfNoneMatch, etag)
+		}
+	}
+
+	resp, err := c.doWithRetry(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		drainAndClose(resp.Body)
+		cached.Header.Set(headerFromCache, "1")
+		return cached, nil
+	}
+
+	if resp.StatusCode == http.StatusOK && resp.Header.Get(headerETag) != "" {
+		// DumpResponse reads the body and replaces it with an equivalent one.
+		if data, err := httputil.DumpResponse(resp, true); err == nil {
+			_ = c.cacheStore.Set(ctx, key, data, c.cacheTTL)
+		}
+	}
+	return resp, nil
+}
+
+// defaultMemoryCacheEntries is the number of responses a MemoryCacheStore
+// keeps when MaxEntries is not set.
+const defaultMemoryCacheEntries = 1000
+
+// MemoryCacheStore is a CacheStore that keeps responses in memory. The zero
+// value is ready to use.
+//
+// It holds at most MaxEntries responses. When it is full, expired responses
+// are dropped first, then the least recently used ones.
+type MemoryCacheStore struct {
+	// MaxEntries is the maximum number of responses kept. If zero, up to
+	// 1000 responses are kept.
+	MaxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     list.List // of *memoryCacheEntry, most recently used first
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time // zero if the entry never expires
+}
+
+func (e *memoryCacheEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// Get implements CacheStore.
+func (s *MemoryCacheStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	e := el.Value.(*memoryCacheEntry)
+	if e.expired(time.Now()) {
+		s.remove(el)
+		return nil, false, nil
+	}
+	s.lru.MoveToFront(el)
+	return e.value, true, nil
+}
+
+// Set implements CacheStore.
+func (s *MemoryCacheStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.entries == nil {
+		s.entries = make(map[string]*list.Element)
+	}
+	e := &memoryCacheEntry{key: key, value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	if el, ok := s.entries[key]; ok {
+		el.Value = e
+		s.lru.MoveToFront(el)
+		return nil
+	}
+
+	max := s.MaxEntries
+	if max <= 0 {
+		max = defaultMemoryCacheEntries
+	}
+	if len(s.entries) >= max {
+		now := time.Now()
+		for el := s.lru.Front(); el != nil; {
+			next := el.Next()
+			if el.Value.(*memoryCacheEntry).expired(now) {
+				s.remove(el)
+			}
+			el = next
+		}
+	}
+	for len(s.entries) >= max {
+		s.remove(s.lru.Back())
+	}
+	s.entries[key] = s.lru.PushFront(e)
+	return nil
+}
+
+func (s *MemoryCacheStore) remove(el *list.Element) {
+	s.lru.Remove(el)
+	delete(s.entries, el.Value.(*memoryCacheEntry).key)
+}
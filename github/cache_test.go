@@ -0,0 +1,191 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithCacheStore(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	store := &MemoryCacheStore{}
+	client = client.WithCacheStore(store, time.Hour)
+
+	calls := 0
+	mux.HandleFunc("/users/u", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			testHeader(t, r, headerIfNoneMatch, "")
+			w.Header().Set(headerETag, `"v1"`)
+			fmt.Fprint(w, `{"login":"u","name":"one"}`)
+		case 2:
+			testHeader(t, r, headerIfNoneMatch, `"v1"`)
+			w.WriteHeader(http.StatusNotModified)
+		case 3:
+			testHeader(t, r, headerIfNoneMatch, `"v1"`)
+			w.Header().Set(headerETag, `"v2"`)
+			fmt.Fprint(w, `{"login":"u","name":"two"}`)
+		}
+	})
+
+	ctx := context.Background()
+	for i, want := range []struct {
+		name      string
+		fromCache bool
+	}{
+		{"one", false},
+		{"one", true},
+		{"two", false},
+	} {
+		user, resp, err := client.Users.Get(ctx, "u")
+		if err != nil {
+			t.Fatalf("Users.Get returned error: %v", err)
+		}
+		if got := user.GetName(); got != want.name {
+			t.Errorf("call %v: Users.Get returned name %v, want %v", i, got, want.name)
+		}
+		if got := resp.Header.Get(headerFromCache) != ""; got != want.fromCache {
+			t.Errorf("call %v: response from cache is %v, want %v", i, got, want.fromCache)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("call %v: status is %v, want %v", i, resp.StatusCode, http.StatusOK)
+		}
+	}
+}
+
+type failingCacheStore struct{}
+
+func (failingCacheStore) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, errors.New("unavailable")
+}
+
+func (failingCacheStore) Set(context.Context, string, []byte, time.Duration) error {
+	return errors.New("unavailable")
+}
+
+func TestWithCacheStore_storeErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client = client.WithCacheStore(failingCacheStore{}, 0)
+
+	mux.HandleFunc("/users/u", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, headerIfNoneMatch, "")
+		w.Header().Set(headerETag, `"v1"`)
+		fmt.Fprint(w, `{"login":"u"}`)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Users.Get(ctx, "u"); err != nil {
+			t.Fatalf("Users.Get returned error: %v", err)
+		}
+	}
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	ctx := context.Background()
+	s := &MemoryCacheStore{}
+
+	if _, ok, _ := s.Get(ctx, "k"); ok {
+		t.Error("Get on empty store found a value")
+	}
+
+	assertNilError(t, s.Set(ctx, "k", []byte("v"), 0))
+	if v, ok, _ := s.Get(ctx, "k"); !ok || string(v) != "v" {
+		t.Errorf("Get returned %q, %v, want v, true", v, ok)
+	}
+
+	assertNilError(t, s.Set(ctx, "expired", []byte("v"), time.Nanosecond))
+	time.Sleep(time.Millisecond)
+	if _, ok, _ := s.Get(ctx, "expired"); ok {
+		t.Error("Get returned an expired value")
+	}
+}
+
+func TestMemoryCacheStore_maxEntries(t *testing.T) {
+	ctx := context.Background()
+	s := &MemoryCacheStore{MaxEntries: 2}
+
+	assertNilError(t, s.Set(ctx, "a", []byte("a"), 0))
+	assertNilError(t, s.Set(ctx, "b", []byte("b"), 0))
+	// Using a makes b the least recently used entry.
+	if _, ok, _ := s.Get(ctx, "a"); !ok {
+		t.Error("Get(a) found no value")
+	}
+	assertNilError(t, s.Set(ctx, "c", []byte("c"), 0))
+	if _, ok, _ := s.Get(ctx, "b"); ok {
+		t.Error("Get(b) returned an evicted value")
+	}
+	for _, k := range []string{"a", "c"} {
+		if _, ok, _ := s.Get(ctx, k); !ok {
+			t.Errorf("Get(%v) found no value", k)
+		}
+	}
+
+	// Expired entries are dropped before used ones.
+	assertNilError(t, s.Set(ctx, "a", []byte("a"), time.Nanosecond))
+	time.Sleep(time.Millisecond)
+	assertNilError(t, s.Set(ctx, "d", []byte("d"), 0))
+	for _, k := range []string{"c", "d"} {
+		if _, ok, _ := s.Get(ctx, k); !ok {
+			t.Errorf("Get(%v) found no value", k)
+		}
+	}
+	if got := len(s.entries); got != 2 {
+		t.Errorf("store has %v entries, want 2", got)
+	}
+}
+
+func TestMemoryCacheStore_defaultMaxEntries(t *testing.T) {
+	ctx := context.Background()
+	s := &MemoryCacheStore{}
+	for i := 0; i <= defaultMemoryCacheEntries; i++ {
+		assertNilError(t, s.Set(ctx, fmt.Sprint(i), nil, 0))
+	}
+	if got := len(s.entries); got != defaultMemoryCacheEntries {
+		t.Errorf("store has %v entries, want %v", got, defaultMemoryCacheEntries)
+	}
+}
+
+func TestWithCacheStore_credentials(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	store := &MemoryCacheStore{}
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, headerIfNoneMatch, "")
+		w.Header().Set(headerETag, `"v1"`)
+		fmt.Fprintf(w, `{"login":%q}`, r.Header.Get("Authorization"))
+	})
+
+	ctx := context.Background()
+	for _, token := range []string{"a", "b"} {
+		c := client.WithAuthToken(token).WithCacheStore(store, time.Hour)
+		user, _, err := c.Users.Get(ctx, "")
+		if err != nil {
+			t.Fatalf("Users.Get returned error: %v", err)
+		}
+		if got, want := user.GetLogin(), "Bearer "+token; got != want {
+			t.Errorf("Users.Get returned login %v, want %v", got, want)
+		}
+	}
+	if got := len(store.entries); got != 2 {
+		t.Errorf("store has %v entries, want 2", got)
+	}
+	for key := range store.entries {
+		if strings.Contains(key, "Bearer") {
+			t.Errorf("cache key %q contains the credentials", key)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
@@ -188,11 +189,12 @@ func (s *ChecksService) CreateCheckRun(ctx context.Context, owner, repo string,
 
 // UpdateCheckRunOptions sets up parameters needed to update a CheckRun.
 type UpdateCheckRunOptions struct {
-	Name        string            `json:"name"`                   // The name of the check (e.g., "code-coverage"). (Required.)
+	Name        string            `json:"name,omitempty"`         // The name of the check (e.g., "code-coverage"). (Optional. The name is left unchanged if empty.)
 	DetailsURL  *string           `json:"details_url,omitempty"`  // The URL of the integrator's site that has the full details of the check. (Optional.)
 	ExternalID  *string           `json:"external_id,omitempty"`  // A reference for the run on the integrator's system. (Optional.)
 	Status      *string           `json:"status,omitempty"`       // The current status. Can be one of "queued", "in_progress", or "completed". Default: "queued". (Optional.)
 	Conclusion  *string           `json:"conclusion,omitempty"`   // Can be one of "success", "failure", "neutral", "cancelled", "skipped", "timed_out", or "action_required". (Optional. Required if you provide a status of "completed".)
+	StartedAt   *Timestamp        `json:"started_at,omitempty"`   // The time that the check run began. (Optional.)
 	CompletedAt *Timestamp        `json:"completed_at,omitempty"` // The time the check completed. (Optional. Required if you provide conclusion.)
 	Output      *CheckRunOutput   `json:"output,omitempty"`       // Provide descriptive details about the run. (Optional)
 	Actions     []*CheckRunAction `json:"actions,omitempty"`      // Possible further actions the integrator can perform, which a user may trigger. (Optional.)
@@ -221,6 +223,88 @@ func (s *ChecksService) UpdateCheckRun(ctx context.Context, owner, repo string,
 	return checkRun, resp, nil
 }
 
+// maxCheckRunAnnotations is the maximum number of annotations GitHub accepts
+// in a single check run create or update request.
+const maxCheckRunAnnotations = 50
+
+// CheckRunAnnotationsError is returned by UpdateCheckRunWithAnnotations when
+// one of its requests fails. Annotations before Uploaded were added to the
+// check run; the remaining ones were not.
+type CheckRunAnnotationsError struct {
+	Uploaded int   // Number of annotations successfully added before the failure.
+	Total    int   // Total number of annotations that were to be added.
+	Err      error // The error returned by the failed UpdateCheckRun call.
+}
+
+func (e *CheckRunAnnotationsError) Error() string {
+	return fmt.Sprintf("uploaded %v of %v check run annotations: %v", e.Uploaded, e.Total, e.Err)
+}
+
+func (e *CheckRunAnnotationsError) Unwrap() error { return e.Err }
+
+// UpdateCheckRunWithAnnotations updates a check run and adds an arbitrary
+// number of annotations to it. Since GitHub accepts at most 50 annotations per
+// request, the annotations are sent in batches through a sequence of
+// UpdateCheckRun calls. opts.Output must be set, as its Title and Summary are
+// required with every batch; any annotations already in opts.Output are sent
+// before the ones in annotations.
+//
+// The status, conclusion, and other fields of opts are only sent with the
+// final batch, so a check run is not completed before all of its annotations
+// have been added. If progress is non-nil, it is called after each successful
+// batch with the number of annotations uploaded so far and the total.
+//
+// If a request fails, the returned error is a *CheckRunAnnotationsError that
+// reports how many annotations were uploaded.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/runs#update-a-check-run
+//
+//meta:operation PATCH /repos/{owner}/{repo}/check-runs/{check_run_id}
+func (s *ChecksService) UpdateCheckRunWithAnnotations(ctx context.Context, owner, repo string, checkRunID int64, opts UpdateCheckRunOptions, annotations []*CheckRunAnnotation, progress func(uploaded, total int)) (*CheckRun, *Response, error) {
+	if opts.Output == nil {
+		return nil, nil, errors.New("opts.Output must be set to add annotations")
+	}
+
+	all := make([]*CheckRunAnnotation, 0, len(opts.Output.Annotations)+len(annotations))
+	all = append(all, opts.Output.Annotations...)
+	all = append(all, annotations...)
+	total := len(all)
+
+	uploaded := 0
+	for {
+		n := total - uploaded
+		if n > maxCheckRunAnnotations {
+			n = maxCheckRunAnnotations
+		}
+		last := uploaded+n == total
+
+		output := *opts.Output
+		output.Annotations = all[uploaded : uploaded+n]
+
+		batch := UpdateCheckRunOptions{Output: &output}
+		if last {
+			batch = opts
+			batch.Output = &output
+		} else {
+			// Images are only sent once, with the final batch.
+			output.Images = nil
+		}
+
+		checkRun, resp, err := s.UpdateCheckRun(ctx, owner, repo, checkRunID, batch)
+		if err != nil {
+			return nil, resp, &CheckRunAnnotationsError{Uploaded: uploaded, Total: total, Err: err}
+		}
+
+		uploaded += n
+		if progress != nil {
+			progress(uploaded, total)
+		}
+		if last {
+			return checkRun, resp, nil
+		}
+	}
+}
+
 // ListCheckRunAnnotations lists the annotations for a check run.
 //
 // GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-run-annotations
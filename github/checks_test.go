@@ -7,8 +7,11 @@ package github
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -39,12 +42,12 @@ func TestChecksService_GetCheckRun(t *testing.T) {
 	completeAt, _ := time.Parse(time.RFC3339, "2018-05-04T01:14:52Z")
 
 	want := &CheckRun{
-		ID:          Int64(1),
-		Status:      String("completed"),
-		Conclusion:  String("neutral"),
+		ID:          Ptr(int64(1)),
+		Status:      Ptr("completed"),
+		Conclusion:  Ptr("neutral"),
 		StartedAt:   &Timestamp{startedAt},
 		CompletedAt: &Timestamp{completeAt},
-		Name:        String("testCheckRun"),
+		Name:        Ptr("testCheckRun"),
 	}
 	if !cmp.Equal(checkRun, want) {
 		t.Errorf("Checks.GetCheckRun return %+v, want %+v", checkRun, want)
@@ -87,13 +90,13 @@ func TestChecksService_GetCheckSuite(t *testing.T) {
 		t.Errorf("Checks.GetCheckSuite return error: %v", err)
 	}
 	want := &CheckSuite{
-		ID:         Int64(1),
-		HeadBranch: String("master"),
-		HeadSHA:    String("deadbeef"),
-		AfterSHA:   String("deadbeefa"),
-		BeforeSHA:  String("deadbeefb"),
-		Status:     String("completed"),
-		Conclusion: String("neutral"),
+		ID:         Ptr(int64(1)),
+		HeadBranch: Ptr("master"),
+		HeadSHA:    Ptr("deadbeef"),
+		AfterSHA:   Ptr("deadbeefa"),
+		BeforeSHA:  Ptr("deadbeefb"),
+		Status:     Ptr("completed"),
+		Conclusion: Ptr("neutral"),
 	}
 	if !cmp.Equal(checkSuite, want) {
 		t.Errorf("Checks.GetCheckSuite return %+v, want %+v", checkSuite, want)
@@ -135,12 +138,12 @@ func TestChecksService_CreateCheckRun(t *testing.T) {
 	checkRunOpt := CreateCheckRunOptions{
 		Name:      "testCreateCheckRun",
 		HeadSHA:   "deadbeef",
-		Status:    String("in_progress"),
+		Status:    Ptr("in_progress"),
 		StartedAt: &Timestamp{startedAt},
 		Output: &CheckRunOutput{
-			Title:   String("Mighty test report"),
-			Summary: String(""),
-			Text:    String(""),
+			Title:   Ptr("Mighty test report"),
+			Summary: Ptr(""),
+			Text:    Ptr(""),
 		},
 	}
 
@@ -151,15 +154,15 @@ func TestChecksService_CreateCheckRun(t *testing.T) {
 	}
 
 	want := &CheckRun{
-		ID:        Int64(1),
-		Status:    String("in_progress"),
+		ID:        Ptr(int64(1)),
+		Status:    Ptr("in_progress"),
 		StartedAt: &Timestamp{startedAt},
-		HeadSHA:   String("deadbeef"),
-		Name:      String("testCreateCheckRun"),
+		HeadSHA:   Ptr("deadbeef"),
+		Name:      Ptr("testCreateCheckRun"),
 		Output: &CheckRunOutput{
-			Title:   String("Mighty test report"),
-			Summary: String(""),
-			Text:    String(""),
+			Title:   Ptr("Mighty test report"),
+			Summary: Ptr(""),
+			Text:    Ptr(""),
 		},
 	}
 	if !cmp.Equal(checkRun, want) {
@@ -181,6 +184,31 @@ func TestChecksService_CreateCheckRun(t *testing.T) {
 	})
 }
 
+func TestChecksService_UpdateCheckRun_withoutName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/check-runs/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"status":"in_progress","started_at":`+referenceTimeStr+`}`+"\n")
+		fmt.Fprint(w, `{"id": 1, "name": "n", "status": "in_progress"}`)
+	})
+
+	ctx := context.Background()
+	checkRun, _, err := client.Checks.UpdateCheckRun(ctx, "o", "r", 1, UpdateCheckRunOptions{
+		Status:    Ptr("in_progress"),
+		StartedAt: &Timestamp{referenceTime},
+	})
+	if err != nil {
+		t.Errorf("Checks.UpdateCheckRun return error: %v", err)
+	}
+
+	want := &CheckRun{ID: Ptr(int64(1)), Name: Ptr("n"), Status: Ptr("in_progress")}
+	if !cmp.Equal(checkRun, want) {
+		t.Errorf("Checks.UpdateCheckRun return %+v, want %+v", checkRun, want)
+	}
+}
+
 func TestChecksService_ListCheckRunAnnotations(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -211,15 +239,15 @@ func TestChecksService_ListCheckRunAnnotations(t *testing.T) {
 	}
 
 	want := []*CheckRunAnnotation{{
-		Path:            String("README.md"),
-		StartLine:       Int(2),
-		EndLine:         Int(2),
-		StartColumn:     Int(1),
-		EndColumn:       Int(5),
-		AnnotationLevel: String("warning"),
-		Message:         String("Check your spelling for 'banaas'."),
-		Title:           String("Spell check"),
-		RawDetails:      String("Do you mean 'bananas' or 'banana'?"),
+		Path:            Ptr("README.md"),
+		StartLine:       Ptr(2),
+		EndLine:         Ptr(2),
+		StartColumn:     Ptr(1),
+		EndColumn:       Ptr(5),
+		AnnotationLevel: Ptr("warning"),
+		Message:         Ptr("Check your spelling for 'banaas'."),
+		Title:           Ptr("Spell check"),
+		RawDetails:      Ptr("Do you mean 'bananas' or 'banana'?"),
 	}}
 
 	if !cmp.Equal(checkRunAnnotations, want) {
@@ -260,12 +288,12 @@ func TestChecksService_UpdateCheckRun(t *testing.T) {
 	startedAt, _ := time.Parse(time.RFC3339, "2018-05-04T01:14:52Z")
 	updateCheckRunOpt := UpdateCheckRunOptions{
 		Name:        "testUpdateCheckRun",
-		Status:      String("completed"),
+		Status:      Ptr("completed"),
 		CompletedAt: &Timestamp{startedAt},
 		Output: &CheckRunOutput{
-			Title:   String("Mighty test report"),
-			Summary: String("There are 0 failures, 2 warnings and 1 notice"),
-			Text:    String("You may have misspelled some words."),
+			Title:   Ptr("Mighty test report"),
+			Summary: Ptr("There are 0 failures, 2 warnings and 1 notice"),
+			Text:    Ptr("You may have misspelled some words."),
 		},
 	}
 
@@ -276,16 +304,16 @@ func TestChecksService_UpdateCheckRun(t *testing.T) {
 	}
 
 	want := &CheckRun{
-		ID:          Int64(1),
-		Status:      String("completed"),
+		ID:          Ptr(int64(1)),
+		Status:      Ptr("completed"),
 		StartedAt:   &Timestamp{startedAt},
 		CompletedAt: &Timestamp{startedAt},
-		Conclusion:  String("neutral"),
-		Name:        String("testUpdateCheckRun"),
+		Conclusion:  Ptr("neutral"),
+		Name:        Ptr("testUpdateCheckRun"),
 		Output: &CheckRunOutput{
-			Title:   String("Mighty test report"),
-			Summary: String("There are 0 failures, 2 warnings and 1 notice"),
-			Text:    String("You may have misspelled some words."),
+			Title:   Ptr("Mighty test report"),
+			Summary: Ptr("There are 0 failures, 2 warnings and 1 notice"),
+			Text:    Ptr("You may have misspelled some words."),
 		},
 	}
 	if !cmp.Equal(checkRun, want) {
@@ -335,10 +363,10 @@ func TestChecksService_ListCheckRunsForRef(t *testing.T) {
 	})
 
 	opt := &ListCheckRunsOptions{
-		CheckName:   String("testing"),
-		Status:      String("completed"),
-		Filter:      String("all"),
-		AppID:       Int64(1),
+		CheckName:   Ptr("testing"),
+		Status:      Ptr("completed"),
+		Filter:      Ptr("all"),
+		AppID:       Ptr(int64(1)),
 		ListOptions: ListOptions{Page: 1},
 	}
 	ctx := context.Background()
@@ -348,15 +376,15 @@ func TestChecksService_ListCheckRunsForRef(t *testing.T) {
 	}
 	startedAt, _ := time.Parse(time.RFC3339, "2018-05-04T01:14:52Z")
 	want := &ListCheckRunsResults{
-		Total: Int(1),
+		Total: Ptr(1),
 		CheckRuns: []*CheckRun{{
-			ID:          Int64(1),
-			Status:      String("completed"),
+			ID:          Ptr(int64(1)),
+			Status:      Ptr("completed"),
 			StartedAt:   &Timestamp{startedAt},
 			CompletedAt: &Timestamp{startedAt},
-			Conclusion:  String("neutral"),
-			HeadSHA:     String("deadbeef"),
-			App:         &App{ID: Int64(1)},
+			Conclusion:  Ptr("neutral"),
+			HeadSHA:     Ptr("deadbeef"),
+			App:         &App{ID: Ptr(int64(1))},
 		}},
 	}
 
@@ -404,9 +432,9 @@ func TestChecksService_ListCheckRunsCheckSuite(t *testing.T) {
 	})
 
 	opt := &ListCheckRunsOptions{
-		CheckName:   String("testing"),
-		Status:      String("completed"),
-		Filter:      String("all"),
+		CheckName:   Ptr("testing"),
+		Status:      Ptr("completed"),
+		Filter:      Ptr("all"),
 		ListOptions: ListOptions{Page: 1},
 	}
 	ctx := context.Background()
@@ -416,14 +444,14 @@ func TestChecksService_ListCheckRunsCheckSuite(t *testing.T) {
 	}
 	startedAt, _ := time.Parse(time.RFC3339, "2018-05-04T01:14:52Z")
 	want := &ListCheckRunsResults{
-		Total: Int(1),
+		Total: Ptr(1),
 		CheckRuns: []*CheckRun{{
-			ID:          Int64(1),
-			Status:      String("completed"),
+			ID:          Ptr(int64(1)),
+			Status:      Ptr("completed"),
 			StartedAt:   &Timestamp{startedAt},
 			CompletedAt: &Timestamp{startedAt},
-			Conclusion:  String("neutral"),
-			HeadSHA:     String("deadbeef"),
+			Conclusion:  Ptr("neutral"),
+			HeadSHA:     Ptr("deadbeef"),
 		}},
 	}
 
@@ -471,8 +499,8 @@ func TestChecksService_ListCheckSuiteForRef(t *testing.T) {
 	})
 
 	opt := &ListCheckSuiteOptions{
-		CheckName:   String("testing"),
-		AppID:       Int(2),
+		CheckName:   Ptr("testing"),
+		AppID:       Ptr(2),
 		ListOptions: ListOptions{Page: 1},
 	}
 	ctx := context.Background()
@@ -481,15 +509,15 @@ func TestChecksService_ListCheckSuiteForRef(t *testing.T) {
 		t.Errorf("Checks.ListCheckSuitesForRef return error: %v", err)
 	}
 	want := &ListCheckSuiteResults{
-		Total: Int(1),
+		Total: Ptr(1),
 		CheckSuites: []*CheckSuite{{
-			ID:         Int64(1),
-			Status:     String("completed"),
-			Conclusion: String("neutral"),
-			HeadSHA:    String("deadbeef"),
-			HeadBranch: String("master"),
-			BeforeSHA:  String("deadbeefb"),
-			AfterSHA:   String("deadbeefa"),
+			ID:         Ptr(int64(1)),
+			Status:     Ptr("completed"),
+			Conclusion: Ptr("neutral"),
+			HeadSHA:    Ptr("deadbeef"),
+			HeadBranch: Ptr("master"),
+			BeforeSHA:  Ptr("deadbeefb"),
+			AfterSHA:   Ptr("deadbeefa"),
 		}},
 	}
 
@@ -523,8 +551,8 @@ func TestChecksService_SetCheckSuitePreferences(t *testing.T) {
 		fmt.Fprint(w, `{"preferences":{"auto_trigger_checks":[{"app_id": 2,"setting": false}]}}`)
 	})
 	a := []*AutoTriggerCheck{{
-		AppID:   Int64(2),
-		Setting: Bool(false),
+		AppID:   Ptr(int64(2)),
+		Setting: Ptr(false),
 	}}
 	opt := CheckSuitePreferenceOptions{AutoTriggerChecks: a}
 	ctx := context.Background()
@@ -578,7 +606,7 @@ func TestChecksService_CreateCheckSuite(t *testing.T) {
 
 	checkSuiteOpt := CreateCheckSuiteOptions{
 		HeadSHA:    "deadbeef",
-		HeadBranch: String("master"),
+		HeadBranch: Ptr("master"),
 	}
 
 	ctx := context.Background()
@@ -588,13 +616,13 @@ func TestChecksService_CreateCheckSuite(t *testing.T) {
 	}
 
 	want := &CheckSuite{
-		ID:         Int64(2),
-		Status:     String("completed"),
-		HeadSHA:    String("deadbeef"),
-		HeadBranch: String("master"),
-		Conclusion: String("neutral"),
-		BeforeSHA:  String("deadbeefb"),
-		AfterSHA:   String("deadbeefa"),
+		ID:         Ptr(int64(2)),
+		Status:     Ptr("completed"),
+		HeadSHA:    Ptr("deadbeef"),
+		HeadBranch: Ptr("master"),
+		Conclusion: Ptr("neutral"),
+		BeforeSHA:  Ptr("deadbeefb"),
+		AfterSHA:   Ptr("deadbeefa"),
 	}
 	if !cmp.Equal(checkSuite, want) {
 		t.Errorf("Checks.CreateCheckSuite return %+v, want %+v", checkSuite, want)
@@ -647,86 +675,86 @@ func Test_CheckRunMarshal(t *testing.T) {
 	ts := now.Format(time.RFC3339Nano)
 
 	c := CheckRun{
-		ID:          Int64(1),
-		NodeID:      String("n"),
-		HeadSHA:     String("h"),
-		ExternalID:  String("1"),
-		URL:         String("u"),
-		HTMLURL:     String("u"),
-		DetailsURL:  String("u"),
-		Status:      String("s"),
-		Conclusion:  String("c"),
+		ID:          Ptr(int64(1)),
+		NodeID:      Ptr("n"),
+		HeadSHA:     Ptr("h"),
+		ExternalID:  Ptr("1"),
+		URL:         Ptr("u"),
+		HTMLURL:     Ptr("u"),
+		DetailsURL:  Ptr("u"),
+		Status:      Ptr("s"),
+		Conclusion:  Ptr("c"),
 		StartedAt:   &Timestamp{Time: now},
 		CompletedAt: &Timestamp{Time: now},
 		Output: &CheckRunOutput{
 			Annotations: []*CheckRunAnnotation{
 				{
-					AnnotationLevel: String("a"),
-					EndLine:         Int(1),
-					Message:         String("m"),
-					Path:            String("p"),
-					RawDetails:      String("r"),
-					StartLine:       Int(1),
-					Title:           String("t"),
+					AnnotationLevel: Ptr("a"),
+					EndLine:         Ptr(1),
+					Message:         Ptr("m"),
+					Path:            Ptr("p"),
+					RawDetails:      Ptr("r"),
+					StartLine:       Ptr(1),
+					Title:           Ptr("t"),
 				},
 			},
-			AnnotationsCount: Int(1),
-			AnnotationsURL:   String("a"),
+			AnnotationsCount: Ptr(1),
+			AnnotationsURL:   Ptr("a"),
 			Images: []*CheckRunImage{
 				{
-					Alt:      String("a"),
-					ImageURL: String("i"),
-					Caption:  String("c"),
+					Alt:      Ptr("a"),
+					ImageURL: Ptr("i"),
+					Caption:  Ptr("c"),
 				},
 			},
-			Title:   String("t"),
-			Summary: String("s"),
-			Text:    String("t"),
+			Title:   Ptr("t"),
+			Summary: Ptr("s"),
+			Text:    Ptr("t"),
 		},
-		Name: String("n"),
+		Name: Ptr("n"),
 		CheckSuite: &CheckSuite{
-			ID: Int64(1),
+			ID: Ptr(int64(1)),
 		},
 		App: &App{
-			ID:     Int64(1),
-			NodeID: String("n"),
+			ID:     Ptr(int64(1)),
+			NodeID: Ptr("n"),
 			Owner: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
-			Name:        String("n"),
-			Description: String("d"),
-			HTMLURL:     String("h"),
-			ExternalURL: String("u"),
+			Name:        Ptr("n"),
+			Description: Ptr("d"),
+			HTMLURL:     Ptr("h"),
+			ExternalURL: Ptr("u"),
 			CreatedAt:   &Timestamp{now},
 			UpdatedAt:   &Timestamp{now},
 		},
 		PullRequests: []*PullRequest{
 			{
-				URL:    String("u"),
-				ID:     Int64(1),
-				Number: Int(1),
+				URL:    Ptr("u"),
+				ID:     Ptr(int64(1)),
+				Number: Ptr(1),
 				Head: &PullRequestBranch{
-					Ref: String("r"),
-					SHA: String("s"),
+					Ref: Ptr("r"),
+					SHA: Ptr("s"),
 					Repo: &Repository{
-						ID:   Int64(1),
-						URL:  String("s"),
-						Name: String("n"),
+						ID:   Ptr(int64(1)),
+						URL:  Ptr("s"),
+						Name: Ptr("n"),
 					},
 				},
 				Base: &PullRequestBranch{
-					Ref: String("r"),
-					SHA: String("s"),
+					Ref: Ptr("r"),
+					SHA: Ptr("s"),
 					Repo: &Repository{
-						ID:   Int64(1),
-						URL:  String("u"),
-						Name: String("n"),
+						ID:   Ptr(int64(1)),
+						URL:  Ptr("u"),
+						Name: Ptr("n"),
 					},
 				},
 			},
@@ -829,64 +857,64 @@ func Test_CheckSuiteMarshal(t *testing.T) {
 	ts := now.Format(time.RFC3339Nano)
 
 	c := CheckSuite{
-		ID:         Int64(1),
-		NodeID:     String("n"),
-		HeadBranch: String("h"),
-		HeadSHA:    String("h"),
-		URL:        String("u"),
-		BeforeSHA:  String("b"),
-		AfterSHA:   String("a"),
-		Status:     String("s"),
-		Conclusion: String("c"),
+		ID:         Ptr(int64(1)),
+		NodeID:     Ptr("n"),
+		HeadBranch: Ptr("h"),
+		HeadSHA:    Ptr("h"),
+		URL:        Ptr("u"),
+		BeforeSHA:  Ptr("b"),
+		AfterSHA:   Ptr("a"),
+		Status:     Ptr("s"),
+		Conclusion: Ptr("c"),
 		App: &App{
-			ID:     Int64(1),
-			NodeID: String("n"),
+			ID:     Ptr(int64(1)),
+			NodeID: Ptr("n"),
 			Owner: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
-			Name:        String("n"),
-			Description: String("d"),
-			HTMLURL:     String("h"),
-			ExternalURL: String("u"),
+			Name:        Ptr("n"),
+			Description: Ptr("d"),
+			HTMLURL:     Ptr("h"),
+			ExternalURL: Ptr("u"),
 			CreatedAt:   &Timestamp{now},
 			UpdatedAt:   &Timestamp{now},
 		},
 		Repository: &Repository{
-			ID: Int64(1),
+			ID: Ptr(int64(1)),
 		},
 		PullRequests: []*PullRequest{
 			{
-				URL:    String("u"),
-				ID:     Int64(1),
-				Number: Int(1),
+				URL:    Ptr("u"),
+				ID:     Ptr(int64(1)),
+				Number: Ptr(1),
 				Head: &PullRequestBranch{
-					Ref: String("r"),
-					SHA: String("s"),
+					Ref: Ptr("r"),
+					SHA: Ptr("s"),
 					Repo: &Repository{
-						ID:   Int64(1),
-						URL:  String("s"),
-						Name: String("n"),
+						ID:   Ptr(int64(1)),
+						URL:  Ptr("s"),
+						Name: Ptr("n"),
 					},
 				},
 				Base: &PullRequestBranch{
-					Ref: String("r"),
-					SHA: String("s"),
+					Ref: Ptr("r"),
+					SHA: Ptr("s"),
 					Repo: &Repository{
-						ID:   Int64(1),
-						URL:  String("u"),
-						Name: String("n"),
+						ID:   Ptr(int64(1)),
+						URL:  Ptr("u"),
+						Name: Ptr("n"),
 					},
 				},
 			},
 		},
 		HeadCommit: &Commit{
-			SHA: String("s"),
+			SHA: Ptr("s"),
 		},
 	}
 
@@ -959,15 +987,15 @@ func TestCheckRunAnnotation_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CheckRunAnnotation{}, "{}")
 
 	u := &CheckRunAnnotation{
-		Path:            String("p"),
-		StartLine:       Int(1),
-		EndLine:         Int(1),
-		StartColumn:     Int(1),
-		EndColumn:       Int(1),
-		AnnotationLevel: String("al"),
-		Message:         String("m"),
-		Title:           String("t"),
-		RawDetails:      String("rd"),
+		Path:            Ptr("p"),
+		StartLine:       Ptr(1),
+		EndLine:         Ptr(1),
+		StartColumn:     Ptr(1),
+		EndColumn:       Ptr(1),
+		AnnotationLevel: Ptr("al"),
+		Message:         Ptr("m"),
+		Title:           Ptr("t"),
+		RawDetails:      Ptr("rd"),
 	}
 
 	want := `{
@@ -989,9 +1017,9 @@ func TestCheckRunImage_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CheckRunImage{}, "{}")
 
 	u := &CheckRunImage{
-		Alt:      String("a"),
-		ImageURL: String("i"),
-		Caption:  String("c"),
+		Alt:      Ptr("a"),
+		ImageURL: Ptr("i"),
+		Caption:  Ptr("c"),
 	}
 
 	want := `{
@@ -1025,8 +1053,8 @@ func TestAutoTriggerCheck_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AutoTriggerCheck{}, "{}")
 
 	u := &AutoTriggerCheck{
-		AppID:   Int64(1),
-		Setting: Bool(false),
+		AppID:   Ptr(int64(1)),
+		Setting: Ptr(false),
 	}
 
 	want := `{
@@ -1042,7 +1070,7 @@ func TestCreateCheckSuiteOptions_Marshal(t *testing.T) {
 
 	u := &CreateCheckSuiteOptions{
 		HeadSHA:    "hsha",
-		HeadBranch: String("hb"),
+		HeadBranch: Ptr("hb"),
 	}
 
 	want := `{
@@ -1057,29 +1085,29 @@ func TestCheckRunOutput_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CheckRunOutput{}, "{}")
 
 	u := &CheckRunOutput{
-		Title:            String("ti"),
-		Summary:          String("s"),
-		Text:             String("t"),
-		AnnotationsCount: Int(1),
-		AnnotationsURL:   String("au"),
+		Title:            Ptr("ti"),
+		Summary:          Ptr("s"),
+		Text:             Ptr("t"),
+		AnnotationsCount: Ptr(1),
+		AnnotationsURL:   Ptr("au"),
 		Annotations: []*CheckRunAnnotation{
 			{
-				Path:            String("p"),
-				StartLine:       Int(1),
-				EndLine:         Int(1),
-				StartColumn:     Int(1),
-				EndColumn:       Int(1),
-				AnnotationLevel: String("al"),
-				Message:         String("m"),
-				Title:           String("t"),
-				RawDetails:      String("rd"),
+				Path:            Ptr("p"),
+				StartLine:       Ptr(1),
+				EndLine:         Ptr(1),
+				StartColumn:     Ptr(1),
+				EndColumn:       Ptr(1),
+				AnnotationLevel: Ptr("al"),
+				Message:         Ptr("m"),
+				Title:           Ptr("t"),
+				RawDetails:      Ptr("rd"),
 			},
 		},
 		Images: []*CheckRunImage{
 			{
-				Alt:      String("a"),
-				ImageURL: String("i"),
-				Caption:  String("c"),
+				Alt:      Ptr("a"),
+				ImageURL: Ptr("i"),
+				Caption:  Ptr("c"),
 			},
 		},
 	}
@@ -1121,36 +1149,36 @@ func TestCreateCheckRunOptions_Marshal(t *testing.T) {
 	u := &CreateCheckRunOptions{
 		Name:        "n",
 		HeadSHA:     "hsha",
-		DetailsURL:  String("durl"),
-		ExternalID:  String("eid"),
-		Status:      String("s"),
-		Conclusion:  String("c"),
+		DetailsURL:  Ptr("durl"),
+		ExternalID:  Ptr("eid"),
+		Status:      Ptr("s"),
+		Conclusion:  Ptr("c"),
 		StartedAt:   &Timestamp{referenceTime},
 		CompletedAt: &Timestamp{referenceTime},
 		Output: &CheckRunOutput{
-			Title:            String("ti"),
-			Summary:          String("s"),
-			Text:             String("t"),
-			AnnotationsCount: Int(1),
-			AnnotationsURL:   String("au"),
+			Title:            Ptr("ti"),
+			Summary:          Ptr("s"),
+			Text:             Ptr("t"),
+			AnnotationsCount: Ptr(1),
+			AnnotationsURL:   Ptr("au"),
 			Annotations: []*CheckRunAnnotation{
 				{
-					Path:            String("p"),
-					StartLine:       Int(1),
-					EndLine:         Int(1),
-					StartColumn:     Int(1),
-					EndColumn:       Int(1),
-					AnnotationLevel: String("al"),
-					Message:         String("m"),
-					Title:           String("t"),
-					RawDetails:      String("rd"),
+					Path:            Ptr("p"),
+					StartLine:       Ptr(1),
+					EndLine:         Ptr(1),
+					StartColumn:     Ptr(1),
+					EndColumn:       Ptr(1),
+					AnnotationLevel: Ptr("al"),
+					Message:         Ptr("m"),
+					Title:           Ptr("t"),
+					RawDetails:      Ptr("rd"),
 				},
 			},
 			Images: []*CheckRunImage{
 				{
-					Alt:      String("a"),
-					ImageURL: String("i"),
-					Caption:  String("c"),
+					Alt:      Ptr("a"),
+					ImageURL: Ptr("i"),
+					Caption:  Ptr("c"),
 				},
 			},
 		},
@@ -1216,35 +1244,36 @@ func TestUpdateCheckRunOptions_Marshal(t *testing.T) {
 
 	u := &UpdateCheckRunOptions{
 		Name:        "n",
-		DetailsURL:  String("durl"),
-		ExternalID:  String("eid"),
-		Status:      String("s"),
-		Conclusion:  String("c"),
+		DetailsURL:  Ptr("durl"),
+		ExternalID:  Ptr("eid"),
+		Status:      Ptr("s"),
+		Conclusion:  Ptr("c"),
+		StartedAt:   &Timestamp{referenceTime},
 		CompletedAt: &Timestamp{referenceTime},
 		Output: &CheckRunOutput{
-			Title:            String("ti"),
-			Summary:          String("s"),
-			Text:             String("t"),
-			AnnotationsCount: Int(1),
-			AnnotationsURL:   String("au"),
+			Title:            Ptr("ti"),
+			Summary:          Ptr("s"),
+			Text:             Ptr("t"),
+			AnnotationsCount: Ptr(1),
+			AnnotationsURL:   Ptr("au"),
 			Annotations: []*CheckRunAnnotation{
 				{
-					Path:            String("p"),
-					StartLine:       Int(1),
-					EndLine:         Int(1),
-					StartColumn:     Int(1),
-					EndColumn:       Int(1),
-					AnnotationLevel: String("al"),
-					Message:         String("m"),
-					Title:           String("t"),
-					RawDetails:      String("rd"),
+					Path:            Ptr("p"),
+					StartLine:       Ptr(1),
+					EndLine:         Ptr(1),
+					StartColumn:     Ptr(1),
+					EndColumn:       Ptr(1),
+					AnnotationLevel: Ptr("al"),
+					Message:         Ptr("m"),
+					Title:           Ptr("t"),
+					RawDetails:      Ptr("rd"),
 				},
 			},
 			Images: []*CheckRunImage{
 				{
-					Alt:      String("a"),
-					ImageURL: String("i"),
-					Caption:  String("c"),
+					Alt:      Ptr("a"),
+					ImageURL: Ptr("i"),
+					Caption:  Ptr("c"),
 				},
 			},
 		},
@@ -1263,6 +1292,7 @@ func TestUpdateCheckRunOptions_Marshal(t *testing.T) {
 		"external_id": "eid",
 		"status": "s",
 		"conclusion": "c",
+		"started_at": ` + referenceTimeStr + `,
 		"completed_at": ` + referenceTimeStr + `,
 		"output": {
 			"title": "ti",
@@ -1307,89 +1337,89 @@ func TestListCheckRunsResults_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ListCheckRunsResults{}, "{}")
 
 	l := &ListCheckRunsResults{
-		Total: Int(1),
+		Total: Ptr(1),
 		CheckRuns: []*CheckRun{
 			{
-				ID:          Int64(1),
-				NodeID:      String("n"),
-				HeadSHA:     String("h"),
-				ExternalID:  String("1"),
-				URL:         String("u"),
-				HTMLURL:     String("u"),
-				DetailsURL:  String("u"),
-				Status:      String("s"),
-				Conclusion:  String("c"),
+				ID:          Ptr(int64(1)),
+				NodeID:      Ptr("n"),
+				HeadSHA:     Ptr("h"),
+				ExternalID:  Ptr("1"),
+				URL:         Ptr("u"),
+				HTMLURL:     Ptr("u"),
+				DetailsURL:  Ptr("u"),
+				Status:      Ptr("s"),
+				Conclusion:  Ptr("c"),
 				StartedAt:   &Timestamp{referenceTime},
 				CompletedAt: &Timestamp{referenceTime},
 				Output: &CheckRunOutput{
 					Annotations: []*CheckRunAnnotation{
 						{
-							AnnotationLevel: String("a"),
-							EndLine:         Int(1),
-							Message:         String("m"),
-							Path:            String("p"),
-							RawDetails:      String("r"),
-							StartLine:       Int(1),
-							Title:           String("t"),
+							AnnotationLevel: Ptr("a"),
+							EndLine:         Ptr(1),
+							Message:         Ptr("m"),
+							Path:            Ptr("p"),
+							RawDetails:      Ptr("r"),
+							StartLine:       Ptr(1),
+							Title:           Ptr("t"),
 						},
 					},
-					AnnotationsCount: Int(1),
-					AnnotationsURL:   String("a"),
+					AnnotationsCount: Ptr(1),
+					AnnotationsURL:   Ptr("a"),
 					Images: []*CheckRunImage{
 						{
-							Alt:      String("a"),
-							ImageURL: String("i"),
-							Caption:  String("c"),
+							Alt:      Ptr("a"),
+							ImageURL: Ptr("i"),
+							Caption:  Ptr("c"),
 						},
 					},
-					Title:   String("t"),
-					Summary: String("s"),
-					Text:    String("t"),
+					Title:   Ptr("t"),
+					Summary: Ptr("s"),
+					Text:    Ptr("t"),
 				},
-				Name: String("n"),
+				Name: Ptr("n"),
 				CheckSuite: &CheckSuite{
-					ID: Int64(1),
+					ID: Ptr(int64(1)),
 				},
 				App: &App{
-					ID:     Int64(1),
-					NodeID: String("n"),
+					ID:     Ptr(int64(1)),
+					NodeID: Ptr("n"),
 					Owner: &User{
-						Login:     String("l"),
-						ID:        Int64(1),
-						NodeID:    String("n"),
-						URL:       String("u"),
-						ReposURL:  String("r"),
-						EventsURL: String("e"),
-						AvatarURL: String("a"),
+						Login:     Ptr("l"),
+						ID:        Ptr(int64(1)),
+						NodeID:    Ptr("n"),
+						URL:       Ptr("u"),
+						ReposURL:  Ptr("r"),
+						EventsURL: Ptr("e"),
+						AvatarURL: Ptr("a"),
 					},
-					Name:        String("n"),
-					Description: String("d"),
-					HTMLURL:     String("h"),
-					ExternalURL: String("u"),
+					Name:        Ptr("n"),
+					Description: Ptr("d"),
+					HTMLURL:     Ptr("h"),
+					ExternalURL: Ptr("u"),
 					CreatedAt:   &Timestamp{referenceTime},
 					UpdatedAt:   &Timestamp{referenceTime},
 				},
 				PullRequests: []*PullRequest{
 					{
-						URL:    String("u"),
-						ID:     Int64(1),
-						Number: Int(1),
+						URL:    Ptr("u"),
+						ID:     Ptr(int64(1)),
+						Number: Ptr(1),
 						Head: &PullRequestBranch{
-							Ref: String("r"),
-							SHA: String("s"),
+							Ref: Ptr("r"),
+							SHA: Ptr("s"),
 							Repo: &Repository{
-								ID:   Int64(1),
-								URL:  String("s"),
-								Name: String("n"),
+								ID:   Ptr(int64(1)),
+								URL:  Ptr("s"),
+								Name: Ptr("n"),
 							},
 						},
 						Base: &PullRequestBranch{
-							Ref: String("r"),
-							SHA: String("s"),
+							Ref: Ptr("r"),
+							SHA: Ptr("s"),
 							Repo: &Repository{
-								ID:   Int64(1),
-								URL:  String("u"),
-								Name: String("n"),
+								ID:   Ptr(int64(1)),
+								URL:  Ptr("u"),
+								Name: Ptr("n"),
 							},
 						},
 					},
@@ -1497,67 +1527,67 @@ func TestListCheckSuiteResults_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ListCheckSuiteResults{}, "{}")
 
 	l := &ListCheckSuiteResults{
-		Total: Int(1),
+		Total: Ptr(1),
 		CheckSuites: []*CheckSuite{
 			{
-				ID:         Int64(1),
-				NodeID:     String("n"),
-				HeadBranch: String("h"),
-				HeadSHA:    String("h"),
-				URL:        String("u"),
-				BeforeSHA:  String("b"),
-				AfterSHA:   String("a"),
-				Status:     String("s"),
-				Conclusion: String("c"),
+				ID:         Ptr(int64(1)),
+				NodeID:     Ptr("n"),
+				HeadBranch: Ptr("h"),
+				HeadSHA:    Ptr("h"),
+				URL:        Ptr("u"),
+				BeforeSHA:  Ptr("b"),
+				AfterSHA:   Ptr("a"),
+				Status:     Ptr("s"),
+				Conclusion: Ptr("c"),
 				App: &App{
-					ID:     Int64(1),
-					NodeID: String("n"),
+					ID:     Ptr(int64(1)),
+					NodeID: Ptr("n"),
 					Owner: &User{
-						Login:     String("l"),
-						ID:        Int64(1),
-						NodeID:    String("n"),
-						URL:       String("u"),
-						ReposURL:  String("r"),
-						EventsURL: String("e"),
-						AvatarURL: String("a"),
+						Login:     Ptr("l"),
+						ID:        Ptr(int64(1)),
+						NodeID:    Ptr("n"),
+						URL:       Ptr("u"),
+						ReposURL:  Ptr("r"),
+						EventsURL: Ptr("e"),
+						AvatarURL: Ptr("a"),
 					},
-					Name:        String("n"),
-					Description: String("d"),
-					HTMLURL:     String("h"),
-					ExternalURL: String("u"),
+					Name:        Ptr("n"),
+					Description: Ptr("d"),
+					HTMLURL:     Ptr("h"),
+					ExternalURL: Ptr("u"),
 					CreatedAt:   &Timestamp{referenceTime},
 					UpdatedAt:   &Timestamp{referenceTime},
 				},
 				Repository: &Repository{
-					ID: Int64(1),
+					ID: Ptr(int64(1)),
 				},
 				PullRequests: []*PullRequest{
 					{
-						URL:    String("u"),
-						ID:     Int64(1),
-						Number: Int(1),
+						URL:    Ptr("u"),
+						ID:     Ptr(int64(1)),
+						Number: Ptr(1),
 						Head: &PullRequestBranch{
-							Ref: String("r"),
-							SHA: String("s"),
+							Ref: Ptr("r"),
+							SHA: Ptr("s"),
 							Repo: &Repository{
-								ID:   Int64(1),
-								URL:  String("s"),
-								Name: String("n"),
+								ID:   Ptr(int64(1)),
+								URL:  Ptr("s"),
+								Name: Ptr("n"),
 							},
 						},
 						Base: &PullRequestBranch{
-							Ref: String("r"),
-							SHA: String("s"),
+							Ref: Ptr("r"),
+							SHA: Ptr("s"),
 							Repo: &Repository{
-								ID:   Int64(1),
-								URL:  String("u"),
-								Name: String("n"),
+								ID:   Ptr(int64(1)),
+								URL:  Ptr("u"),
+								Name: Ptr("n"),
 							},
 						},
 					},
 				},
 				HeadCommit: &Commit{
-					SHA: String("s"),
+					SHA: Ptr("s"),
 				},
 			},
 		},
@@ -1639,8 +1669,8 @@ func TestCheckSuitePreferenceOptions_Marshal(t *testing.T) {
 	u := &CheckSuitePreferenceOptions{
 		AutoTriggerChecks: []*AutoTriggerCheck{
 			{
-				AppID:   Int64(1),
-				Setting: Bool(false),
+				AppID:   Ptr(int64(1)),
+				Setting: Ptr(false),
 			},
 		},
 	}
@@ -1663,8 +1693,8 @@ func TestPreferenceList_Marshal(t *testing.T) {
 	u := &PreferenceList{
 		AutoTriggerChecks: []*AutoTriggerCheck{
 			{
-				AppID:   Int64(1),
-				Setting: Bool(false),
+				AppID:   Ptr(int64(1)),
+				Setting: Ptr(false),
 			},
 		},
 	}
@@ -1688,15 +1718,15 @@ func TestCheckSuitePreferenceResults_Marshal(t *testing.T) {
 		Preferences: &PreferenceList{
 			AutoTriggerChecks: []*AutoTriggerCheck{
 				{
-					AppID:   Int64(1),
-					Setting: Bool(false),
+					AppID:   Ptr(int64(1)),
+					Setting: Ptr(false),
 				},
 			},
 		},
 		Repository: &Repository{
-			ID:   Int64(1),
-			URL:  String("u"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("u"),
+			Name: Ptr("n"),
 		},
 	}
 
@@ -1743,3 +1773,118 @@ func TestChecksService_ReRequestCheckRun(t *testing.T) {
 		return err
 	})
 }
+
+func TestChecksService_UpdateCheckRunWithAnnotations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var batches []*UpdateCheckRunOptions
+	mux.HandleFunc("/repos/o/r/check-runs/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		v := new(UpdateCheckRunOptions)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		batches = append(batches, v)
+		fmt.Fprintf(w, `{"id": 1, "output": {"annotations_count": %v}}`, len(batches))
+	})
+
+	annotations := make([]*CheckRunAnnotation, 120)
+	for i := range annotations {
+		annotations[i] = &CheckRunAnnotation{Path: Ptr("p"), StartLine: Ptr(i)}
+	}
+
+	var progress []int
+	ctx := context.Background()
+	checkRun, _, err := client.Checks.UpdateCheckRunWithAnnotations(ctx, "o", "r", 1, UpdateCheckRunOptions{
+		Status:     Ptr("completed"),
+		Conclusion: Ptr("failure"),
+		Output: &CheckRunOutput{
+			Title:   Ptr("t"),
+			Summary: Ptr("s"),
+			Images:  []*CheckRunImage{{Alt: Ptr("a")}},
+		},
+	}, annotations, func(uploaded, total int) {
+		if total != 120 {
+			t.Errorf("progress total = %v, want 120", total)
+		}
+		progress = append(progress, uploaded)
+	})
+	if err != nil {
+		t.Fatalf("Checks.UpdateCheckRunWithAnnotations returned error: %v", err)
+	}
+
+	want := &CheckRun{ID: Ptr(int64(1)), Output: &CheckRunOutput{AnnotationsCount: Ptr(3)}}
+	if !cmp.Equal(checkRun, want) {
+		t.Errorf("Checks.UpdateCheckRunWithAnnotations returned %+v, want %+v", checkRun, want)
+	}
+	if want := []int{50, 100, 120}; !cmp.Equal(progress, want) {
+		t.Errorf("progress = %v, want %v", progress, want)
+	}
+
+	if len(batches) != 3 {
+		t.Fatalf("got %v requests, want 3", len(batches))
+	}
+	for i, b := range batches {
+		last := i == len(batches)-1
+		if got, want := len(b.Output.Annotations), []int{50, 50, 20}[i]; got != want {
+			t.Errorf("batch %v has %v annotations, want %v", i, got, want)
+		}
+		if got := b.Output.Annotations[0].GetStartLine(); got != i*50 {
+			t.Errorf("batch %v starts at annotation %v, want %v", i, got, i*50)
+		}
+		if b.Output.GetTitle() != "t" || b.Output.GetSummary() != "s" {
+			t.Errorf("batch %v output = %+v, want title and summary", i, b.Output)
+		}
+		if got := b.Status != nil; got != last {
+			t.Errorf("batch %v has status set = %v, want %v", i, got, last)
+		}
+		if got := len(b.Output.Images) > 0; got != last {
+			t.Errorf("batch %v has images set = %v, want %v", i, got, last)
+		}
+	}
+}
+
+func TestChecksService_UpdateCheckRunWithAnnotations_partialFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/check-runs/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	annotations := make([]*CheckRunAnnotation, 70)
+	for i := range annotations {
+		annotations[i] = &CheckRunAnnotation{Path: Ptr("p")}
+	}
+
+	ctx := context.Background()
+	opts := UpdateCheckRunOptions{Output: &CheckRunOutput{Title: Ptr("t"), Summary: Ptr("s")}}
+	_, resp, err := client.Checks.UpdateCheckRunWithAnnotations(ctx, "o", "r", 1, opts, annotations, nil)
+
+	var aerr *CheckRunAnnotationsError
+	if !errors.As(err, &aerr) {
+		t.Fatalf("Checks.UpdateCheckRunWithAnnotations returned error %v, want *CheckRunAnnotationsError", err)
+	}
+	if aerr.Uploaded != 50 || aerr.Total != 70 {
+		t.Errorf("CheckRunAnnotationsError = %+v, want Uploaded 50 and Total 70", aerr)
+	}
+	var rerr *ErrorResponse
+	if !errors.As(err, &rerr) {
+		t.Errorf("CheckRunAnnotationsError does not unwrap to *ErrorResponse: %v", aerr.Err)
+	}
+	if got, want := resp.StatusCode, http.StatusUnprocessableEntity; got != want {
+		t.Errorf("response status = %v, want %v", got, want)
+	}
+	if want := "uploaded 50 of 70 check run annotations: "; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("Error() = %q, want prefix %q", err.Error(), want)
+	}
+
+	if _, _, err := client.Checks.UpdateCheckRunWithAnnotations(ctx, "o", "r", 1, UpdateCheckRunOptions{}, annotations, nil); err == nil {
+		t.Error("Checks.UpdateCheckRunWithAnnotations without Output returned nil error, want error")
+	}
+}
@@ -27,7 +27,7 @@ func TestCodeScanningService_Alert_ID(t *testing.T) {
 
 	// Test: Valid HTMLURL
 	a = &Alert{
-		HTMLURL: String("https://github.com/o/r/security/code-scanning/88"),
+		HTMLURL: Ptr("https://github.com/o/r/security/code-scanning/88"),
 	}
 	id = a.ID()
 	want = 88
@@ -45,7 +45,7 @@ func TestCodeScanningService_Alert_ID(t *testing.T) {
 
 	// Test: ID can't be parsed as an int
 	a = &Alert{
-		HTMLURL: String("https://github.com/o/r/security/code-scanning/bad88"),
+		HTMLURL: Ptr("https://github.com/o/r/security/code-scanning/bad88"),
 	}
 	id = a.ID()
 	want = 0
@@ -59,15 +59,15 @@ func TestCodeScanningService_UploadSarif(t *testing.T) {
 	defer teardown()
 
 	expectedSarifID := &SarifID{
-		ID:  String("testid"),
-		URL: String("https://example.com/testurl"),
+		ID:  Ptr("testid"),
+		URL: Ptr("https://example.com/testurl"),
 	}
 
 	mux.HandleFunc("/repos/o/r/code-scanning/sarifs", func(w http.ResponseWriter, r *http.Request) {
 		v := new(SarifAnalysis)
 		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
 		testMethod(t, r, "POST")
-		want := &SarifAnalysis{CommitSHA: String("abc"), Ref: String("ref/head/main"), Sarif: String("abc"), CheckoutURI: String("uri"), StartedAt: &Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, ToolName: String("codeql-cli")}
+		want := &SarifAnalysis{CommitSHA: Ptr("abc"), Ref: Ptr("ref/head/main"), Sarif: Ptr("abc"), CheckoutURI: Ptr("uri"), StartedAt: &Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, ToolName: Ptr("codeql-cli")}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -78,7 +78,7 @@ func TestCodeScanningService_UploadSarif(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	sarifAnalysis := &SarifAnalysis{CommitSHA: String("abc"), Ref: String("ref/head/main"), Sarif: String("abc"), CheckoutURI: String("uri"), StartedAt: &Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, ToolName: String("codeql-cli")}
+	sarifAnalysis := &SarifAnalysis{CommitSHA: Ptr("abc"), Ref: Ptr("ref/head/main"), Sarif: Ptr("abc"), CheckoutURI: Ptr("uri"), StartedAt: &Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, ToolName: Ptr("codeql-cli")}
 	respSarifID, _, err := client.CodeScanning.UploadSarif(ctx, "o", "r", sarifAnalysis)
 	if err != nil {
 		t.Errorf("CodeScanning.UploadSarif returned error: %v", err)
@@ -118,8 +118,8 @@ func TestCodeScanningService_GetSARIF(t *testing.T) {
 	}
 
 	want := &SARIFUpload{
-		ProcessingStatus: String("s"),
-		AnalysesURL:      String("u"),
+		ProcessingStatus: Ptr("s"),
+		AnalysesURL:      Ptr("u"),
 	}
 	if !cmp.Equal(sarifUpload, want) {
 		t.Errorf("CodeScanning.GetSARIF returned %+v, want %+v", sarifUpload, want)
@@ -249,77 +249,77 @@ func TestCodeScanningService_ListAlertsForOrg(t *testing.T) {
 	want := []*Alert{
 		{
 			Repository: &Repository{
-				ID:   Int64(1),
-				URL:  String("url"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("url"),
+				Name: Ptr("n"),
 			},
-			RuleID:          String("js/trivial-conditional"),
-			RuleSeverity:    String("warning"),
-			RuleDescription: String("Useless conditional"),
-			Tool:            &Tool{Name: String("CodeQL"), GUID: nil, Version: String("1.4.0")},
+			RuleID:          Ptr("js/trivial-conditional"),
+			RuleSeverity:    Ptr("warning"),
+			RuleDescription: Ptr("Useless conditional"),
+			Tool:            &Tool{Name: Ptr("CodeQL"), GUID: nil, Version: Ptr("1.4.0")},
 			Rule: &Rule{
-				ID:              String("js/trivial-conditional"),
-				Severity:        String("warning"),
-				Description:     String("Useless conditional"),
-				Name:            String("js/trivial-conditional"),
-				FullDescription: String("Expression has no effect"),
-				Help:            String("Expression has no effect"),
+				ID:              Ptr("js/trivial-conditional"),
+				Severity:        Ptr("warning"),
+				Description:     Ptr("Useless conditional"),
+				Name:            Ptr("js/trivial-conditional"),
+				FullDescription: Ptr("Expression has no effect"),
+				Help:            Ptr("Expression has no effect"),
 			},
 			CreatedAt: &date,
-			State:     String("open"),
+			State:     Ptr("open"),
 			ClosedBy:  nil,
 			ClosedAt:  nil,
-			URL:       String("https://api.github.com/repos/o/r/code-scanning/alerts/25"),
-			HTMLURL:   String("https://github.com/o/r/security/code-scanning/25"),
+			URL:       Ptr("https://api.github.com/repos/o/r/code-scanning/alerts/25"),
+			HTMLURL:   Ptr("https://github.com/o/r/security/code-scanning/25"),
 			MostRecentInstance: &MostRecentInstance{
-				Ref:       String("refs/heads/main"),
-				State:     String("open"),
-				CommitSHA: String("abcdefg12345"),
+				Ref:       Ptr("refs/heads/main"),
+				State:     Ptr("open"),
+				CommitSHA: Ptr("abcdefg12345"),
 				Message: &Message{
-					Text: String("This path depends on a user-provided value."),
+					Text: Ptr("This path depends on a user-provided value."),
 				},
 				Location: &Location{
-					Path:        String("spec-main/api-session-spec.ts"),
-					StartLine:   Int(917),
-					EndLine:     Int(917),
-					StartColumn: Int(7),
-					EndColumn:   Int(18),
+					Path:        Ptr("spec-main/api-session-spec.ts"),
+					StartLine:   Ptr(917),
+					EndLine:     Ptr(917),
+					StartColumn: Ptr(7),
+					EndColumn:   Ptr(18),
 				},
 				Classifications: []string{"test"},
 			},
 		},
 		{
-			RuleID:          String("js/useless-expression"),
-			RuleSeverity:    String("warning"),
-			RuleDescription: String("Expression has no effect"),
-			Tool:            &Tool{Name: String("CodeQL"), GUID: nil, Version: String("1.4.0")},
+			RuleID:          Ptr("js/useless-expression"),
+			RuleSeverity:    Ptr("warning"),
+			RuleDescription: Ptr("Expression has no effect"),
+			Tool:            &Tool{Name: Ptr("CodeQL"), GUID: nil, Version: Ptr("1.4.0")},
 			Rule: &Rule{
-				ID:              String("js/useless-expression"),
-				Severity:        String("warning"),
-				Description:     String("Expression has no effect"),
-				Name:            String("js/useless-expression"),
-				FullDescription: String("Expression has no effect"),
-				Help:            String("Expression has no effect"),
+				ID:              Ptr("js/useless-expression"),
+				Severity:        Ptr("warning"),
+				Description:     Ptr("Expression has no effect"),
+				Name:            Ptr("js/useless-expression"),
+				FullDescription: Ptr("Expression has no effect"),
+				Help:            Ptr("Expression has no effect"),
 			},
 			CreatedAt: &date,
-			State:     String("open"),
+			State:     Ptr("open"),
 			ClosedBy:  nil,
 			ClosedAt:  nil,
-			URL:       String("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
-			HTMLURL:   String("https://github.com/o/r/security/code-scanning/88"),
+			URL:       Ptr("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
+			HTMLURL:   Ptr("https://github.com/o/r/security/code-scanning/88"),
 			MostRecentInstance: &MostRecentInstance{
-				Ref:       String("refs/heads/main"),
-				State:     String("open"),
-				CommitSHA: String("abcdefg12345"),
+				Ref:       Ptr("refs/heads/main"),
+				State:     Ptr("open"),
+				CommitSHA: Ptr("abcdefg12345"),
 				Message: &Message{
-					Text: String("This path depends on a user-provided value."),
+					Text: Ptr("This path depends on a user-provided value."),
 				},
 				Location: &Location{
-					Path:        String("spec-main/api-session-spec.ts"),
-					StartLine:   Int(917),
-					EndLine:     Int(917),
-					StartColumn: Int(7),
-					EndColumn:   Int(18),
+					Path:        Ptr("spec-main/api-session-spec.ts"),
+					StartLine:   Ptr(917),
+					EndLine:     Ptr(917),
+					StartColumn: Ptr(7),
+					EndColumn:   Ptr(18),
 				},
 				Classifications: []string{"test"},
 			},
@@ -411,41 +411,41 @@ func TestCodeScanningService_ListAlertsForOrgLisCursorOptions(t *testing.T) {
 	want := []*Alert{
 		{
 			Repository: &Repository{
-				ID:   Int64(1),
-				URL:  String("url"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("url"),
+				Name: Ptr("n"),
 			},
-			RuleID:          String("js/trivial-conditional"),
-			RuleSeverity:    String("warning"),
-			RuleDescription: String("Useless conditional"),
-			Tool:            &Tool{Name: String("CodeQL"), GUID: nil, Version: String("1.4.0")},
+			RuleID:          Ptr("js/trivial-conditional"),
+			RuleSeverity:    Ptr("warning"),
+			RuleDescription: Ptr("Useless conditional"),
+			Tool:            &Tool{Name: Ptr("CodeQL"), GUID: nil, Version: Ptr("1.4.0")},
 			Rule: &Rule{
-				ID:              String("js/trivial-conditional"),
-				Severity:        String("warning"),
-				Description:     String("Useless conditional"),
-				Name:            String("js/trivial-conditional"),
-				FullDescription: String("Expression has no effect"),
-				Help:            String("Expression has no effect"),
+				ID:              Ptr("js/trivial-conditional"),
+				Severity:        Ptr("warning"),
+				Description:     Ptr("Useless conditional"),
+				Name:            Ptr("js/trivial-conditional"),
+				FullDescription: Ptr("Expression has no effect"),
+				Help:            Ptr("Expression has no effect"),
 			},
 			CreatedAt: &date,
-			State:     String("open"),
+			State:     Ptr("open"),
 			ClosedBy:  nil,
 			ClosedAt:  nil,
-			URL:       String("https://api.github.com/repos/o/r/code-scanning/alerts/25"),
-			HTMLURL:   String("https://github.com/o/r/security/code-scanning/25"),
+			URL:       Ptr("https://api.github.com/repos/o/r/code-scanning/alerts/25"),
+			HTMLURL:   Ptr("https://github.com/o/r/security/code-scanning/25"),
 			MostRecentInstance: &MostRecentInstance{
-				Ref:       String("refs/heads/main"),
-				State:     String("open"),
-				CommitSHA: String("abcdefg12345"),
+				Ref:       Ptr("refs/heads/main"),
+				State:     Ptr("open"),
+				CommitSHA: Ptr("abcdefg12345"),
 				Message: &Message{
-					Text: String("This path depends on a user-provided value."),
+					Text: Ptr("This path depends on a user-provided value."),
 				},
 				Location: &Location{
-					Path:        String("spec-main/api-session-spec.ts"),
-					StartLine:   Int(917),
-					EndLine:     Int(917),
-					StartColumn: Int(7),
-					EndColumn:   Int(18),
+					Path:        Ptr("spec-main/api-session-spec.ts"),
+					StartLine:   Ptr(917),
+					EndLine:     Ptr(917),
+					StartColumn: Ptr(7),
+					EndColumn:   Ptr(18),
 				},
 				Classifications: []string{"test"},
 			},
@@ -573,73 +573,73 @@ func TestCodeScanningService_ListAlertsForRepo(t *testing.T) {
 	date := Timestamp{time.Date(2020, time.May, 06, 12, 00, 00, 0, time.UTC)}
 	want := []*Alert{
 		{
-			RuleID:          String("js/trivial-conditional"),
-			RuleSeverity:    String("warning"),
-			RuleDescription: String("Useless conditional"),
-			Tool:            &Tool{Name: String("CodeQL"), GUID: nil, Version: String("1.4.0")},
+			RuleID:          Ptr("js/trivial-conditional"),
+			RuleSeverity:    Ptr("warning"),
+			RuleDescription: Ptr("Useless conditional"),
+			Tool:            &Tool{Name: Ptr("CodeQL"), GUID: nil, Version: Ptr("1.4.0")},
 			Rule: &Rule{
-				ID:              String("js/trivial-conditional"),
-				Severity:        String("warning"),
-				Description:     String("Useless conditional"),
-				Name:            String("js/trivial-conditional"),
-				FullDescription: String("Expression has no effect"),
-				Help:            String("Expression has no effect"),
+				ID:              Ptr("js/trivial-conditional"),
+				Severity:        Ptr("warning"),
+				Description:     Ptr("Useless conditional"),
+				Name:            Ptr("js/trivial-conditional"),
+				FullDescription: Ptr("Expression has no effect"),
+				Help:            Ptr("Expression has no effect"),
 			},
 			CreatedAt: &date,
-			State:     String("open"),
+			State:     Ptr("open"),
 			ClosedBy:  nil,
 			ClosedAt:  nil,
-			URL:       String("https://api.github.com/repos/o/r/code-scanning/alerts/25"),
-			HTMLURL:   String("https://github.com/o/r/security/code-scanning/25"),
+			URL:       Ptr("https://api.github.com/repos/o/r/code-scanning/alerts/25"),
+			HTMLURL:   Ptr("https://github.com/o/r/security/code-scanning/25"),
 			MostRecentInstance: &MostRecentInstance{
-				Ref:       String("refs/heads/main"),
-				State:     String("open"),
-				CommitSHA: String("abcdefg12345"),
+				Ref:       Ptr("refs/heads/main"),
+				State:     Ptr("open"),
+				CommitSHA: Ptr("abcdefg12345"),
 				Message: &Message{
-					Text: String("This path depends on a user-provided value."),
+					Text: Ptr("This path depends on a user-provided value."),
 				},
 				Location: &Location{
-					Path:        String("spec-main/api-session-spec.ts"),
-					StartLine:   Int(917),
-					EndLine:     Int(917),
-					StartColumn: Int(7),
-					EndColumn:   Int(18),
+					Path:        Ptr("spec-main/api-session-spec.ts"),
+					StartLine:   Ptr(917),
+					EndLine:     Ptr(917),
+					StartColumn: Ptr(7),
+					EndColumn:   Ptr(18),
 				},
 				Classifications: []string{"test"},
 			},
 		},
 		{
-			RuleID:          String("js/useless-expression"),
-			RuleSeverity:    String("warning"),
-			RuleDescription: String("Expression has no effect"),
-			Tool:            &Tool{Name: String("CodeQL"), GUID: nil, Version: String("1.4.0")},
+			RuleID:          Ptr("js/useless-expression"),
+			RuleSeverity:    Ptr("warning"),
+			RuleDescription: Ptr("Expression has no effect"),
+			Tool:            &Tool{Name: Ptr("CodeQL"), GUID: nil, Version: Ptr("1.4.0")},
 			Rule: &Rule{
-				ID:              String("js/useless-expression"),
-				Severity:        String("warning"),
-				Description:     String("Expression has no effect"),
-				Name:            String("js/useless-expression"),
-				FullDescription: String("Expression has no effect"),
-				Help:            String("Expression has no effect"),
+				ID:              Ptr("js/useless-expression"),
+				Severity:        Ptr("warning"),
+				Description:     Ptr("Expression has no effect"),
+				Name:            Ptr("js/useless-expression"),
+				FullDescription: Ptr("Expression has no effect"),
+				Help:            Ptr("Expression has no effect"),
 			},
 			CreatedAt: &date,
-			State:     String("open"),
+			State:     Ptr("open"),
 			ClosedBy:  nil,
 			ClosedAt:  nil,
-			URL:       String("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
-			HTMLURL:   String("https://github.com/o/r/security/code-scanning/88"),
+			URL:       Ptr("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
+			HTMLURL:   Ptr("https://github.com/o/r/security/code-scanning/88"),
 			MostRecentInstance: &MostRecentInstance{
-				Ref:       String("refs/heads/main"),
-				State:     String("open"),
-				CommitSHA: String("abcdefg12345"),
+				Ref:       Ptr("refs/heads/main"),
+				State:     Ptr("open"),
+				CommitSHA: Ptr("abcdefg12345"),
 				Message: &Message{
-					Text: String("This path depends on a user-provided value."),
+					Text: Ptr("This path depends on a user-provided value."),
 				},
 				Location: &Location{
-					Path:        String("spec-main/api-session-spec.ts"),
-					StartLine:   Int(917),
-					EndLine:     Int(917),
-					StartColumn: Int(7),
-					EndColumn:   Int(18),
+					Path:        Ptr("spec-main/api-session-spec.ts"),
+					StartLine:   Ptr(917),
+					EndLine:     Ptr(917),
+					StartColumn: Ptr(7),
+					EndColumn:   Ptr(18),
 				},
 				Classifications: []string{"test"},
 			},
@@ -714,9 +714,9 @@ func TestCodeScanningService_UpdateAlert(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	dismissedComment := String("This alert is not actually correct as sanitizer is used")
-	dismissedReason := String("false positive")
-	state := String("dismissed")
+	dismissedComment := Ptr("This alert is not actually correct as sanitizer is used")
+	dismissedReason := Ptr("false positive")
+	state := Ptr("dismissed")
 	stateInfo := &CodeScanningAlertState{State: *state, DismissedReason: dismissedReason, DismissedComment: dismissedComment}
 	alert, _, err := client.CodeScanning.UpdateAlert(ctx, "o", "r", 88, stateInfo)
 	if err != nil {
@@ -725,17 +725,17 @@ func TestCodeScanningService_UpdateAlert(t *testing.T) {
 
 	date := Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}
 	want := &Alert{
-		RuleID:          String("js/useless-expression"),
-		RuleSeverity:    String("warning"),
-		RuleDescription: String("Expression has no effect"),
-		Tool:            &Tool{Name: String("CodeQL"), GUID: nil, Version: String("1.4.0")},
+		RuleID:          Ptr("js/useless-expression"),
+		RuleSeverity:    Ptr("warning"),
+		RuleDescription: Ptr("Expression has no effect"),
+		Tool:            &Tool{Name: Ptr("CodeQL"), GUID: nil, Version: Ptr("1.4.0")},
 		Rule: &Rule{
-			ID:              String("useless expression"),
-			Severity:        String("warning"),
-			Description:     String("Expression has no effect"),
-			Name:            String("useless expression"),
-			FullDescription: String("Expression has no effect"),
-			Help:            String("Expression has no effect"),
+			ID:              Ptr("useless expression"),
+			Severity:        Ptr("warning"),
+			Description:     Ptr("Expression has no effect"),
+			Name:            Ptr("useless expression"),
+			FullDescription: Ptr("Expression has no effect"),
+			Help:            Ptr("Expression has no effect"),
 		},
 		CreatedAt:        &date,
 		State:            state,
@@ -743,21 +743,21 @@ func TestCodeScanningService_UpdateAlert(t *testing.T) {
 		DismissedComment: dismissedComment,
 		ClosedBy:         nil,
 		ClosedAt:         nil,
-		URL:              String("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
-		HTMLURL:          String("https://github.com/o/r/security/code-scanning/88"),
+		URL:              Ptr("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
+		HTMLURL:          Ptr("https://github.com/o/r/security/code-scanning/88"),
 		MostRecentInstance: &MostRecentInstance{
-			Ref:       String("refs/heads/main"),
-			State:     String("dismissed"),
-			CommitSHA: String("abcdefg12345"),
+			Ref:       Ptr("refs/heads/main"),
+			State:     Ptr("dismissed"),
+			CommitSHA: Ptr("abcdefg12345"),
 			Message: &Message{
-				Text: String("This path depends on a user-provided value."),
+				Text: Ptr("This path depends on a user-provided value."),
 			},
 			Location: &Location{
-				Path:        String("spec-main/api-session-spec.ts"),
-				StartLine:   Int(917),
-				EndLine:     Int(917),
-				StartColumn: Int(7),
-				EndColumn:   Int(18),
+				Path:        Ptr("spec-main/api-session-spec.ts"),
+				StartLine:   Ptr(917),
+				EndLine:     Ptr(917),
+				StartColumn: Ptr(7),
+				EndColumn:   Ptr(18),
 			},
 			Classifications: []string{"test"},
 		},
@@ -822,21 +822,21 @@ func TestCodeScanningService_ListAlertInstances(t *testing.T) {
 
 	want := []*MostRecentInstance{
 		{
-			Ref:         String("refs/heads/main"),
-			AnalysisKey: String(".github/workflows/codeql-analysis.yml:analyze"),
-			Category:    String(".github/workflows/codeql-analysis.yml:analyze"),
-			Environment: String(""),
-			State:       String("open"),
-			CommitSHA:   String("abcdefg12345"),
+			Ref:         Ptr("refs/heads/main"),
+			AnalysisKey: Ptr(".github/workflows/codeql-analysis.yml:analyze"),
+			Category:    Ptr(".github/workflows/codeql-analysis.yml:analyze"),
+			Environment: Ptr(""),
+			State:       Ptr("open"),
+			CommitSHA:   Ptr("abcdefg12345"),
 			Message: &Message{
-				Text: String("This path depends on a user-provided value."),
+				Text: Ptr("This path depends on a user-provided value."),
 			},
 			Location: &Location{
-				Path:        String("spec-main/api-session-spec.ts"),
-				StartLine:   Int(917),
-				EndLine:     Int(917),
-				StartColumn: Int(7),
-				EndColumn:   Int(18),
+				Path:        Ptr("spec-main/api-session-spec.ts"),
+				StartLine:   Ptr(917),
+				EndLine:     Ptr(917),
+				StartColumn: Ptr(7),
+				EndColumn:   Ptr(18),
 			},
 			Classifications: []string{"test"},
 		},
@@ -918,37 +918,37 @@ func TestCodeScanningService_GetAlert(t *testing.T) {
 
 	date := Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}
 	want := &Alert{
-		RuleID:          String("js/useless-expression"),
-		RuleSeverity:    String("warning"),
-		RuleDescription: String("Expression has no effect"),
-		Tool:            &Tool{Name: String("CodeQL"), GUID: nil, Version: String("1.4.0")},
+		RuleID:          Ptr("js/useless-expression"),
+		RuleSeverity:    Ptr("warning"),
+		RuleDescription: Ptr("Expression has no effect"),
+		Tool:            &Tool{Name: Ptr("CodeQL"), GUID: nil, Version: Ptr("1.4.0")},
 		Rule: &Rule{
-			ID:              String("useless expression"),
-			Severity:        String("warning"),
-			Description:     String("Expression has no effect"),
-			Name:            String("useless expression"),
-			FullDescription: String("Expression has no effect"),
-			Help:            String("Expression has no effect"),
+			ID:              Ptr("useless expression"),
+			Severity:        Ptr("warning"),
+			Description:     Ptr("Expression has no effect"),
+			Name:            Ptr("useless expression"),
+			FullDescription: Ptr("Expression has no effect"),
+			Help:            Ptr("Expression has no effect"),
 		},
 		CreatedAt: &date,
-		State:     String("open"),
+		State:     Ptr("open"),
 		ClosedBy:  nil,
 		ClosedAt:  nil,
-		URL:       String("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
-		HTMLURL:   String("https://github.com/o/r/security/code-scanning/88"),
+		URL:       Ptr("https://api.github.com/repos/o/r/code-scanning/alerts/88"),
+		HTMLURL:   Ptr("https://github.com/o/r/security/code-scanning/88"),
 		MostRecentInstance: &MostRecentInstance{
-			Ref:       String("refs/heads/main"),
-			State:     String("open"),
-			CommitSHA: String("abcdefg12345"),
+			Ref:       Ptr("refs/heads/main"),
+			State:     Ptr("open"),
+			CommitSHA: Ptr("abcdefg12345"),
 			Message: &Message{
-				Text: String("This path depends on a user-provided value."),
+				Text: Ptr("This path depends on a user-provided value."),
 			},
 			Location: &Location{
-				Path:        String("spec-main/api-session-spec.ts"),
-				StartLine:   Int(917),
-				EndLine:     Int(917),
-				StartColumn: Int(7),
-				EndColumn:   Int(18),
+				Path:        Ptr("spec-main/api-session-spec.ts"),
+				StartLine:   Ptr(917),
+				EndLine:     Ptr(917),
+				StartColumn: Ptr(7),
+				EndColumn:   Ptr(18),
 			},
 			Classifications: []string{"test"},
 		},
@@ -976,28 +976,28 @@ func TestAlert_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Alert{}, "{}")
 
 	u := &Alert{
-		RuleID:          String("rid"),
-		RuleSeverity:    String("rs"),
-		RuleDescription: String("rd"),
+		RuleID:          Ptr("rid"),
+		RuleSeverity:    Ptr("rs"),
+		RuleDescription: Ptr("rd"),
 		Tool: &Tool{
-			Name:    String("n"),
-			GUID:    String("g"),
-			Version: String("v"),
+			Name:    Ptr("n"),
+			GUID:    Ptr("g"),
+			Version: Ptr("v"),
 		},
 		CreatedAt: &Timestamp{referenceTime},
-		State:     String("fixed"),
+		State:     Ptr("fixed"),
 		ClosedBy: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		ClosedAt: &Timestamp{referenceTime},
-		URL:      String("url"),
-		HTMLURL:  String("hurl"),
+		URL:      Ptr("url"),
+		HTMLURL:  Ptr("hurl"),
 	}
 
 	want := `{
@@ -1032,11 +1032,11 @@ func TestLocation_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Location{}, "{}")
 
 	u := &Location{
-		Path:        String("path"),
-		StartLine:   Int(1),
-		EndLine:     Int(2),
-		StartColumn: Int(3),
-		EndColumn:   Int(4),
+		Path:        Ptr("path"),
+		StartLine:   Ptr(1),
+		EndLine:     Ptr(2),
+		StartColumn: Ptr(3),
+		EndColumn:   Ptr(4),
 	}
 
 	want := `{
@@ -1054,14 +1054,14 @@ func TestRule_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Rule{}, "{}")
 
 	u := &Rule{
-		ID:                    String("1"),
-		Severity:              String("3"),
-		Description:           String("description"),
-		Name:                  String("first"),
-		SecuritySeverityLevel: String("2"),
-		FullDescription:       String("summary"),
+		ID:                    Ptr("1"),
+		Severity:              Ptr("3"),
+		Description:           Ptr("description"),
+		Name:                  Ptr("first"),
+		SecuritySeverityLevel: Ptr("2"),
+		FullDescription:       Ptr("summary"),
 		Tags:                  []string{"tag1", "tag2"},
-		Help:                  String("Help Text"),
+		Help:                  Ptr("Help Text"),
 	}
 
 	want := `{
@@ -1082,9 +1082,9 @@ func TestTool_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Tool{}, "{}")
 
 	u := &Tool{
-		Name:    String("name"),
-		GUID:    String("guid"),
-		Version: String("ver"),
+		Name:    Ptr("name"),
+		GUID:    Ptr("guid"),
+		Version: Ptr("ver"),
 	}
 
 	want := `{
@@ -1100,7 +1100,7 @@ func TestMessage_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Message{}, "{}")
 
 	u := &Message{
-		Text: String("text"),
+		Text: Ptr("text"),
 	}
 
 	want := `{
@@ -1163,7 +1163,7 @@ func TestCodeScanningService_ListAnalysesForRepo(t *testing.T) {
 			]`)
 	})
 
-	opts := &AnalysesListOptions{SarifID: String("8981cd8e-b078-4ac3-a3be-1dad7dbd0b582"), Ref: String("heads/master")}
+	opts := &AnalysesListOptions{SarifID: Ptr("8981cd8e-b078-4ac3-a3be-1dad7dbd0b582"), Ref: Ptr("heads/master")}
 	ctx := context.Background()
 	analyses, _, err := client.CodeScanning.ListAnalysesForRepo(ctx, "o", "r", opts)
 	if err != nil {
@@ -1173,46 +1173,46 @@ func TestCodeScanningService_ListAnalysesForRepo(t *testing.T) {
 	date := &Timestamp{time.Date(2020, time.August, 27, 15, 05, 21, 0, time.UTC)}
 	want := []*ScanningAnalysis{
 		{
-			ID:           Int64(201),
-			Ref:          String("refs/heads/main"),
-			CommitSHA:    String("d99612c3e1f2970085cfbaeadf8f010ef69bad83"),
-			AnalysisKey:  String(".github/workflows/codeql-analysis.yml:analyze"),
-			Environment:  String("{\"language\":\"python\"}"),
-			Error:        String(""),
-			Category:     String(".github/workflows/codeql-analysis.yml:analyze/language:python"),
+			ID:           Ptr(int64(201)),
+			Ref:          Ptr("refs/heads/main"),
+			CommitSHA:    Ptr("d99612c3e1f2970085cfbaeadf8f010ef69bad83"),
+			AnalysisKey:  Ptr(".github/workflows/codeql-analysis.yml:analyze"),
+			Environment:  Ptr("{\"language\":\"python\"}"),
+			Error:        Ptr(""),
+			Category:     Ptr(".github/workflows/codeql-analysis.yml:analyze/language:python"),
 			CreatedAt:    date,
-			ResultsCount: Int(17),
-			RulesCount:   Int(49),
-			URL:          String("https://api.github.com/repos/o/r/code-scanning/analyses/201"),
-			SarifID:      String("8981cd8e-b078-4ac3-a3be-1dad7dbd0b582"),
+			ResultsCount: Ptr(17),
+			RulesCount:   Ptr(49),
+			URL:          Ptr("https://api.github.com/repos/o/r/code-scanning/analyses/201"),
+			SarifID:      Ptr("8981cd8e-b078-4ac3-a3be-1dad7dbd0b582"),
 			Tool: &Tool{
-				Name:    String("CodeQL"),
+				Name:    Ptr("CodeQL"),
 				GUID:    nil,
-				Version: String("2.4.0"),
+				Version: Ptr("2.4.0"),
 			},
-			Deletable: Bool(true),
-			Warning:   String(""),
+			Deletable: Ptr(true),
+			Warning:   Ptr(""),
 		},
 		{
-			ID:           Int64(200),
-			Ref:          String("refs/heads/my-branch"),
-			CommitSHA:    String("c8cff6510d4d084fb1b4aa13b64b97ca12b07321"),
-			AnalysisKey:  String(".github/workflows/shiftleft.yml:build"),
-			Environment:  String("{}"),
-			Error:        String(""),
-			Category:     String(".github/workflows/shiftleft.yml:build/"),
+			ID:           Ptr(int64(200)),
+			Ref:          Ptr("refs/heads/my-branch"),
+			CommitSHA:    Ptr("c8cff6510d4d084fb1b4aa13b64b97ca12b07321"),
+			AnalysisKey:  Ptr(".github/workflows/shiftleft.yml:build"),
+			Environment:  Ptr("{}"),
+			Error:        Ptr(""),
+			Category:     Ptr(".github/workflows/shiftleft.yml:build/"),
 			CreatedAt:    date,
-			ResultsCount: Int(17),
-			RulesCount:   Int(32),
-			URL:          String("https://api.github.com/repos/o/r/code-scanning/analyses/200"),
-			SarifID:      String("8981cd8e-b078-4ac3-a3be-1dad7dbd0b582"),
+			ResultsCount: Ptr(17),
+			RulesCount:   Ptr(32),
+			URL:          Ptr("https://api.github.com/repos/o/r/code-scanning/analyses/200"),
+			SarifID:      Ptr("8981cd8e-b078-4ac3-a3be-1dad7dbd0b582"),
 			Tool: &Tool{
-				Name:    String("Python Security ScanningAnalysis"),
+				Name:    Ptr("Python Security ScanningAnalysis"),
 				GUID:    nil,
-				Version: String("1.2.0"),
+				Version: Ptr("1.2.0"),
 			},
-			Deletable: Bool(true),
-			Warning:   String(""),
+			Deletable: Ptr(true),
+			Warning:   Ptr(""),
 		},
 	}
 	if !cmp.Equal(analyses, want) {
@@ -1271,25 +1271,25 @@ func TestCodeScanningService_GetAnalysis(t *testing.T) {
 
 	date := &Timestamp{time.Date(2021, time.January, 13, 11, 55, 49, 0, time.UTC)}
 	want := &ScanningAnalysis{
-		ID:           Int64(3602840),
-		Ref:          String("refs/heads/main"),
-		CommitSHA:    String("c18c69115654ff0166991962832dc2bd7756e655"),
-		AnalysisKey:  String(".github/workflows/codeql-analysis.yml:analyze"),
-		Environment:  String("{\"language\":\"javascript\"}"),
-		Error:        String(""),
-		Category:     String(".github/workflows/codeql-analysis.yml:analyze/language:javascript"),
+		ID:           Ptr(int64(3602840)),
+		Ref:          Ptr("refs/heads/main"),
+		CommitSHA:    Ptr("c18c69115654ff0166991962832dc2bd7756e655"),
+		AnalysisKey:  Ptr(".github/workflows/codeql-analysis.yml:analyze"),
+		Environment:  Ptr("{\"language\":\"javascript\"}"),
+		Error:        Ptr(""),
+		Category:     Ptr(".github/workflows/codeql-analysis.yml:analyze/language:javascript"),
 		CreatedAt:    date,
-		ResultsCount: Int(3),
-		RulesCount:   Int(67),
-		URL:          String("https://api.github.com/repos/o/r/code-scanning/analyses/201"),
-		SarifID:      String("47177e22-5596-11eb-80a1-c1e54ef945c6"),
+		ResultsCount: Ptr(3),
+		RulesCount:   Ptr(67),
+		URL:          Ptr("https://api.github.com/repos/o/r/code-scanning/analyses/201"),
+		SarifID:      Ptr("47177e22-5596-11eb-80a1-c1e54ef945c6"),
 		Tool: &Tool{
-			Name:    String("CodeQL"),
+			Name:    Ptr("CodeQL"),
 			GUID:    nil,
-			Version: String("2.4.0"),
+			Version: Ptr("2.4.0"),
 		},
-		Deletable: Bool(true),
-		Warning:   String(""),
+		Deletable: Ptr(true),
+		Warning:   Ptr(""),
 	}
 	if !cmp.Equal(analysis, want) {
 		t.Errorf("CodeScanning.GetAnalysis returned %+v, want %+v", analysis, want)
@@ -1329,8 +1329,8 @@ func TestCodeScanningService_DeleteAnalysis(t *testing.T) {
 	}
 
 	want := &DeleteAnalysis{
-		NextAnalysisURL:  String("a"),
-		ConfirmDeleteURL: String("b"),
+		NextAnalysisURL:  Ptr("a"),
+		ConfirmDeleteURL: Ptr("b"),
 	}
 	if !cmp.Equal(analysis, want) {
 		t.Errorf("CodeScanning.DeleteAnalysis returned %+v, want %+v", analysis, want)
@@ -1400,34 +1400,34 @@ func TestCodeScanningService_ListCodeQLDatabases(t *testing.T) {
 	date := &Timestamp{time.Date(2021, time.January, 13, 11, 55, 49, 0, time.UTC)}
 	want := []*CodeQLDatabase{
 		{
-			ID:       Int64(1),
-			Name:     String("name"),
-			Language: String("language"),
+			ID:       Ptr(int64(1)),
+			Name:     Ptr("name"),
+			Language: Ptr("language"),
 			Uploader: &User{
-				Login:             String("a"),
-				ID:                Int64(1),
-				NodeID:            String("b"),
-				AvatarURL:         String("c"),
-				GravatarID:        String("d"),
-				URL:               String("e"),
-				HTMLURL:           String("f"),
-				FollowersURL:      String("g"),
-				FollowingURL:      String("h"),
-				GistsURL:          String("i"),
-				StarredURL:        String("j"),
-				SubscriptionsURL:  String("k"),
-				OrganizationsURL:  String("l"),
-				ReposURL:          String("m"),
-				EventsURL:         String("n"),
-				ReceivedEventsURL: String("o"),
-				Type:              String("p"),
-				SiteAdmin:         Bool(false),
+				Login:             Ptr("a"),
+				ID:                Ptr(int64(1)),
+				NodeID:            Ptr("b"),
+				AvatarURL:         Ptr("c"),
+				GravatarID:        Ptr("d"),
+				URL:               Ptr("e"),
+				HTMLURL:           Ptr("f"),
+				FollowersURL:      Ptr("g"),
+				FollowingURL:      Ptr("h"),
+				GistsURL:          Ptr("i"),
+				StarredURL:        Ptr("j"),
+				SubscriptionsURL:  Ptr("k"),
+				OrganizationsURL:  Ptr("l"),
+				ReposURL:          Ptr("m"),
+				EventsURL:         Ptr("n"),
+				ReceivedEventsURL: Ptr("o"),
+				Type:              Ptr("p"),
+				SiteAdmin:         Ptr(false),
 			},
-			ContentType: String("r"),
-			Size:        Int64(1024),
+			ContentType: Ptr("r"),
+			Size:        Ptr(int64(1024)),
 			CreatedAt:   date,
 			UpdatedAt:   date,
-			URL:         String("s"),
+			URL:         Ptr("s"),
 		},
 	}
 
@@ -1496,34 +1496,34 @@ func TestCodeScanningService_GetCodeQLDatabase(t *testing.T) {
 
 	date := &Timestamp{time.Date(2021, time.January, 13, 11, 55, 49, 0, time.UTC)}
 	want := &CodeQLDatabase{
-		ID:       Int64(1),
-		Name:     String("name"),
-		Language: String("language"),
+		ID:       Ptr(int64(1)),
+		Name:     Ptr("name"),
+		Language: Ptr("language"),
 		Uploader: &User{
-			Login:             String("a"),
-			ID:                Int64(1),
-			NodeID:            String("b"),
-			AvatarURL:         String("c"),
-			GravatarID:        String("d"),
-			URL:               String("e"),
-			HTMLURL:           String("f"),
-			FollowersURL:      String("g"),
-			FollowingURL:      String("h"),
-			GistsURL:          String("i"),
-			StarredURL:        String("j"),
-			SubscriptionsURL:  String("k"),
-			OrganizationsURL:  String("l"),
-			ReposURL:          String("m"),
-			EventsURL:         String("n"),
-			ReceivedEventsURL: String("o"),
-			Type:              String("p"),
-			SiteAdmin:         Bool(false),
+			Login:             Ptr("a"),
+			ID:                Ptr(int64(1)),
+			NodeID:            Ptr("b"),
+			AvatarURL:         Ptr("c"),
+			GravatarID:        Ptr("d"),
+			URL:               Ptr("e"),
+			HTMLURL:           Ptr("f"),
+			FollowersURL:      Ptr("g"),
+			FollowingURL:      Ptr("h"),
+			GistsURL:          Ptr("i"),
+			StarredURL:        Ptr("j"),
+			SubscriptionsURL:  Ptr("k"),
+			OrganizationsURL:  Ptr("l"),
+			ReposURL:          Ptr("m"),
+			EventsURL:         Ptr("n"),
+			ReceivedEventsURL: Ptr("o"),
+			Type:              Ptr("p"),
+			SiteAdmin:         Ptr(false),
 		},
-		ContentType: String("r"),
-		Size:        Int64(1024),
+		ContentType: Ptr("r"),
+		Size:        Ptr(int64(1024)),
 		CreatedAt:   date,
 		UpdatedAt:   date,
-		URL:         String("s"),
+		URL:         Ptr("s"),
 	}
 
 	if !cmp.Equal(database, want) {
@@ -1574,9 +1574,9 @@ func TestCodeScanningService_GetDefaultSetupConfiguration(t *testing.T) {
 
 	date := &Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}
 	want := &DefaultSetupConfiguration{
-		State:      String("configured"),
+		State:      Ptr("configured"),
 		Languages:  []string{"javascript", "javascript-typescript", "typescript"},
-		QuerySuite: String("default"),
+		QuerySuite: Ptr("default"),
 		UpdatedAt:  date,
 	}
 	if !cmp.Equal(cfg, want) {
@@ -1617,7 +1617,7 @@ func TestCodeScanningService_UpdateDefaultSetupConfiguration(t *testing.T) {
 	options := &UpdateDefaultSetupConfigurationOptions{
 		State:      "configured",
 		Languages:  []string{"go"},
-		QuerySuite: String("default"),
+		QuerySuite: Ptr("default"),
 	}
 	got, _, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, "o", "r", options)
 	if err != nil {
@@ -1625,8 +1625,8 @@ func TestCodeScanningService_UpdateDefaultSetupConfiguration(t *testing.T) {
 	}
 
 	want := &UpdateDefaultSetupConfigurationResponse{
-		RunID:  Int64(5301214200),
-		RunURL: String("https://api.github.com/repos/o/r/actions/runs/5301214200"),
+		RunID:  Ptr(int64(5301214200)),
+		RunURL: Ptr("https://api.github.com/repos/o/r/actions/runs/5301214200"),
 	}
 	if !cmp.Equal(got, want) {
 		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned %+v, want %+v", got, want)
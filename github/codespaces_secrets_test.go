@@ -453,7 +453,7 @@ func TestCodespacesService_GetPublicKey(t *testing.T) {
 				t.Errorf("Codespaces.%v returned error: %v", tt.methodName, err)
 			}
 
-			want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+			want := &PublicKey{KeyID: Ptr("1234"), Key: Ptr("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
 			if !cmp.Equal(key, want) {
 				t.Errorf("Codespaces.%v returned %+v, want %+v", tt.methodName, key, want)
 			}
@@ -531,9 +531,9 @@ func TestCodespacesService_ListSelectedReposForSecret(t *testing.T) {
 			}
 
 			want := &SelectedReposList{
-				TotalCount: Int(1),
+				TotalCount: Ptr(1),
 				Repositories: []*Repository{
-					{ID: Int64(1)},
+					{ID: Ptr(int64(1))},
 				},
 			}
 
@@ -637,7 +637,7 @@ func TestCodespacesService_AddSelectedReposForSecret(t *testing.T) {
 		badCall    func(context.Context, *Client) (*Response, error)
 		methodName string
 	}
-	repo := &Repository{ID: Int64(1234)}
+	repo := &Repository{ID: Ptr(int64(1234))}
 	tests := []test{
 		{
 			name: "User",
@@ -703,7 +703,7 @@ func TestCodespacesService_RemoveSelectedReposFromSecret(t *testing.T) {
 		badCall    func(context.Context, *Client) (*Response, error)
 		methodName string
 	}
-	repo := &Repository{ID: Int64(1234)}
+	repo := &Repository{ID: Ptr(int64(1234))}
 	tests := []test{
 		{
 			name: "User",
@@ -778,9 +778,9 @@ func TestCodespacesService_RemoveSelectedReposFromSecret(t *testing.T) {
 // 	}
 
 // 	want := &SelectedReposList{
-// 		TotalCount: Int(1),
+// 		TotalCount: Ptr(1),
 // 		Repositories: []*Repository{
-// 			{ID: Int64(1)},
+// 			{ID: Ptr(int64(1))},
 // 		},
 // 	}
 // 	if !cmp.Equal(repos, want) {
@@ -46,7 +46,7 @@ type CopilotSeatDetails struct {
 	// Assignee can either be a User, Team, or Organization.
 	Assignee                interface{} `json:"assignee"`
 	AssigningTeam           *Team       `json:"assigning_team,omitempty"`
-	PendingCancellationDate *string     `json:"pending_cancellation_date,omitempty"`
+	PendingCancellationDate *Timestamp  `json:"pending_cancellation_date,omitempty"`
 	LastActivityAt          *Timestamp  `json:"last_activity_at,omitempty"`
 	LastActivityEditor      *string     `json:"last_activity_editor,omitempty"`
 	CreatedAt               *Timestamp  `json:"created_at"`
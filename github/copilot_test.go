@@ -145,8 +145,8 @@ func TestCopilotService_GetSeatDetailsUser(t *testing.T) {
 	}
 
 	want := &User{
-		ID:   Int64(1),
-		Type: String("User"),
+		ID:   Ptr(int64(1)),
+		Type: Ptr("User"),
 	}
 
 	if got, ok := seatDetails.GetUser(); ok && !cmp.Equal(got, want) {
@@ -163,8 +163,8 @@ func TestCopilotService_GetSeatDetailsUser(t *testing.T) {
 			}`
 
 	bad := &Organization{
-		ID:   Int64(1),
-		Type: String("Organization"),
+		ID:   Ptr(int64(1)),
+		Type: Ptr("Organization"),
 	}
 
 	err = json.Unmarshal([]byte(data), seatDetails)
@@ -193,7 +193,7 @@ func TestCopilotService_GetSeatDetailsTeam(t *testing.T) {
 	}
 
 	want := &Team{
-		ID: Int64(1),
+		ID: Ptr(int64(1)),
 	}
 
 	if got, ok := seatDetails.GetTeam(); ok && !cmp.Equal(got, want) {
@@ -210,8 +210,8 @@ func TestCopilotService_GetSeatDetailsTeam(t *testing.T) {
 			}`
 
 	bad := &User{
-		ID:   Int64(1),
-		Type: String("User"),
+		ID:   Ptr(int64(1)),
+		Type: Ptr("User"),
 	}
 
 	err = json.Unmarshal([]byte(data), seatDetails)
@@ -240,8 +240,8 @@ func TestCopilotService_GetSeatDetailsOrganization(t *testing.T) {
 	}
 
 	want := &Organization{
-		ID:   Int64(1),
-		Type: String("Organization"),
+		ID:   Ptr(int64(1)),
+		Type: Ptr("Organization"),
 	}
 
 	if got, ok := seatDetails.GetOrganization(); ok && !cmp.Equal(got, want) {
@@ -258,7 +258,7 @@ func TestCopilotService_GetSeatDetailsOrganization(t *testing.T) {
 			}`
 
 	bad := &Team{
-		ID: Int64(1),
+		ID: Ptr(int64(1)),
 	}
 
 	err = json.Unmarshal([]byte(data), seatDetails)
@@ -488,97 +488,97 @@ func TestCopilotService_ListCopilotSeats(t *testing.T) {
 		Seats: []*CopilotSeatDetails{
 			{
 				Assignee: &User{
-					Login:             String("octocat"),
-					ID:                Int64(1),
-					NodeID:            String("MDQ6VXNlcjE="),
-					AvatarURL:         String("https://github.com/images/error/octocat_happy.gif"),
-					GravatarID:        String(""),
-					URL:               String("https://api.github.com/users/octocat"),
-					HTMLURL:           String("https://github.com/octocat"),
-					FollowersURL:      String("https://api.github.com/users/octocat/followers"),
-					FollowingURL:      String("https://api.github.com/users/octocat/following{/other_user}"),
-					GistsURL:          String("https://api.github.com/users/octocat/gists{/gist_id}"),
-					StarredURL:        String("https://api.github.com/users/octocat/starred{/owner}{/repo}"),
-					SubscriptionsURL:  String("https://api.github.com/users/octocat/subscriptions"),
-					OrganizationsURL:  String("https://api.github.com/users/octocat/orgs"),
-					ReposURL:          String("https://api.github.com/users/octocat/repos"),
-					EventsURL:         String("https://api.github.com/users/octocat/events{/privacy}"),
-					ReceivedEventsURL: String("https://api.github.com/users/octocat/received_events"),
-					Type:              String("User"),
-					SiteAdmin:         Bool(false),
+					Login:             Ptr("octocat"),
+					ID:                Ptr(int64(1)),
+					NodeID:            Ptr("MDQ6VXNlcjE="),
+					AvatarURL:         Ptr("https://github.com/images/error/octocat_happy.gif"),
+					GravatarID:        Ptr(""),
+					URL:               Ptr("https://api.github.com/users/octocat"),
+					HTMLURL:           Ptr("https://github.com/octocat"),
+					FollowersURL:      Ptr("https://api.github.com/users/octocat/followers"),
+					FollowingURL:      Ptr("https://api.github.com/users/octocat/following{/other_user}"),
+					GistsURL:          Ptr("https://api.github.com/users/octocat/gists{/gist_id}"),
+					StarredURL:        Ptr("https://api.github.com/users/octocat/starred{/owner}{/repo}"),
+					SubscriptionsURL:  Ptr("https://api.github.com/users/octocat/subscriptions"),
+					OrganizationsURL:  Ptr("https://api.github.com/users/octocat/orgs"),
+					ReposURL:          Ptr("https://api.github.com/users/octocat/repos"),
+					EventsURL:         Ptr("https://api.github.com/users/octocat/events{/privacy}"),
+					ReceivedEventsURL: Ptr("https://api.github.com/users/octocat/received_events"),
+					Type:              Ptr("User"),
+					SiteAdmin:         Ptr(false),
 				},
 				AssigningTeam: &Team{
-					ID:              Int64(1),
-					NodeID:          String("MDQ6VGVhbTE="),
-					URL:             String("https://api.github.com/teams/1"),
-					HTMLURL:         String("https://github.com/orgs/github/teams/justice-league"),
-					Name:            String("Justice League"),
-					Slug:            String("justice-league"),
-					Description:     String("A great team."),
-					Privacy:         String("closed"),
-					Permission:      String("admin"),
-					MembersURL:      String("https://api.github.com/teams/1/members{/member}"),
-					RepositoriesURL: String("https://api.github.com/teams/1/repos"),
+					ID:              Ptr(int64(1)),
+					NodeID:          Ptr("MDQ6VGVhbTE="),
+					URL:             Ptr("https://api.github.com/teams/1"),
+					HTMLURL:         Ptr("https://github.com/orgs/github/teams/justice-league"),
+					Name:            Ptr("Justice League"),
+					Slug:            Ptr("justice-league"),
+					Description:     Ptr("A great team."),
+					Privacy:         Ptr("closed"),
+					Permission:      Ptr("admin"),
+					MembersURL:      Ptr("https://api.github.com/teams/1/members{/member}"),
+					RepositoriesURL: Ptr("https://api.github.com/teams/1/repos"),
 					Parent:          nil,
 				},
 				CreatedAt:               &createdAt1,
 				UpdatedAt:               &updatedAt1,
 				PendingCancellationDate: nil,
 				LastActivityAt:          &lastActivityAt1,
-				LastActivityEditor:      String("vscode/1.77.3/copilot/1.86.82"),
+				LastActivityEditor:      Ptr("vscode/1.77.3/copilot/1.86.82"),
 			},
 			{
 				Assignee: &User{
-					Login:             String("octokitten"),
-					ID:                Int64(1),
-					NodeID:            String("MDQ76VNlcjE="),
-					AvatarURL:         String("https://github.com/images/error/octokitten_happy.gif"),
-					GravatarID:        String(""),
-					URL:               String("https://api.github.com/users/octokitten"),
-					HTMLURL:           String("https://github.com/octokitten"),
-					FollowersURL:      String("https://api.github.com/users/octokitten/followers"),
-					FollowingURL:      String("https://api.github.com/users/octokitten/following{/other_user}"),
-					GistsURL:          String("https://api.github.com/users/octokitten/gists{/gist_id}"),
-					StarredURL:        String("https://api.github.com/users/octokitten/starred{/owner}{/repo}"),
-					SubscriptionsURL:  String("https://api.github.com/users/octokitten/subscriptions"),
-					OrganizationsURL:  String("https://api.github.com/users/octokitten/orgs"),
-					ReposURL:          String("https://api.github.com/users/octokitten/repos"),
-					EventsURL:         String("https://api.github.com/users/octokitten/events{/privacy}"),
-					ReceivedEventsURL: String("https://api.github.com/users/octokitten/received_events"),
-					Type:              String("User"),
-					SiteAdmin:         Bool(false),
+					Login:             Ptr("octokitten"),
+					ID:                Ptr(int64(1)),
+					NodeID:            Ptr("MDQ76VNlcjE="),
+					AvatarURL:         Ptr("https://github.com/images/error/octokitten_happy.gif"),
+					GravatarID:        Ptr(""),
+					URL:               Ptr("https://api.github.com/users/octokitten"),
+					HTMLURL:           Ptr("https://github.com/octokitten"),
+					FollowersURL:      Ptr("https://api.github.com/users/octokitten/followers"),
+					FollowingURL:      Ptr("https://api.github.com/users/octokitten/following{/other_user}"),
+					GistsURL:          Ptr("https://api.github.com/users/octokitten/gists{/gist_id}"),
+					StarredURL:        Ptr("https://api.github.com/users/octokitten/starred{/owner}{/repo}"),
+					SubscriptionsURL:  Ptr("https://api.github.com/users/octokitten/subscriptions"),
+					OrganizationsURL:  Ptr("https://api.github.com/users/octokitten/orgs"),
+					ReposURL:          Ptr("https://api.github.com/users/octokitten/repos"),
+					EventsURL:         Ptr("https://api.github.com/users/octokitten/events{/privacy}"),
+					ReceivedEventsURL: Ptr("https://api.github.com/users/octokitten/received_events"),
+					Type:              Ptr("User"),
+					SiteAdmin:         Ptr(false),
 				},
 				AssigningTeam:           nil,
 				CreatedAt:               &createdAt2,
 				UpdatedAt:               &updatedAt2,
-				PendingCancellationDate: String("2021-11-01"),
+				PendingCancellationDate: &Timestamp{time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)},
 				LastActivityAt:          &lastActivityAt2,
-				LastActivityEditor:      String("vscode/1.77.3/copilot/1.86.82"),
+				LastActivityEditor:      Ptr("vscode/1.77.3/copilot/1.86.82"),
 			},
 			{
 				Assignee: &Team{
-					ID:   Int64(1),
-					Name: String("octokittens"),
+					ID:   Ptr(int64(1)),
+					Name: Ptr("octokittens"),
 				},
 				AssigningTeam:           nil,
 				CreatedAt:               &createdAt2,
 				UpdatedAt:               &updatedAt2,
-				PendingCancellationDate: String("2021-11-01"),
+				PendingCancellationDate: &Timestamp{time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)},
 				LastActivityAt:          &lastActivityAt2,
-				LastActivityEditor:      String("vscode/1.77.3/copilot/1.86.82"),
+				LastActivityEditor:      Ptr("vscode/1.77.3/copilot/1.86.82"),
 			},
 			{
 				Assignee: &Organization{
-					ID:   Int64(1),
-					Name: String("octocats"),
-					Type: String("Organization"),
+					ID:   Ptr(int64(1)),
+					Name: Ptr("octocats"),
+					Type: Ptr("Organization"),
 				},
 				AssigningTeam:           nil,
 				CreatedAt:               &createdAt2,
 				UpdatedAt:               &updatedAt2,
-				PendingCancellationDate: String("2021-11-01"),
+				PendingCancellationDate: &Timestamp{time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)},
 				LastActivityAt:          &lastActivityAt2,
-				LastActivityEditor:      String("vscode/1.77.3/copilot/1.86.82"),
+				LastActivityEditor:      Ptr("vscode/1.77.3/copilot/1.86.82"),
 			},
 		},
 	}
@@ -831,44 +831,44 @@ func TestCopilotService_GetSeatDetails(t *testing.T) {
 
 	want := &CopilotSeatDetails{
 		Assignee: &User{
-			Login:             String("octocat"),
-			ID:                Int64(1),
-			NodeID:            String("MDQ6VXNlcjE="),
-			AvatarURL:         String("https://github.com/images/error/octocat_happy.gif"),
-			GravatarID:        String(""),
-			URL:               String("https://api.github.com/users/octocat"),
-			HTMLURL:           String("https://github.com/octocat"),
-			FollowersURL:      String("https://api.github.com/users/octocat/followers"),
-			FollowingURL:      String("https://api.github.com/users/octocat/following{/other_user}"),
-			GistsURL:          String("https://api.github.com/users/octocat/gists{/gist_id}"),
-			StarredURL:        String("https://api.github.com/users/octocat/starred{/owner}{/repo}"),
-			SubscriptionsURL:  String("https://api.github.com/users/octocat/subscriptions"),
-			OrganizationsURL:  String("https://api.github.com/users/octocat/orgs"),
-			ReposURL:          String("https://api.github.com/users/octocat/repos"),
-			EventsURL:         String("https://api.github.com/users/octocat/events{/privacy}"),
-			ReceivedEventsURL: String("https://api.github.com/users/octocat/received_events"),
-			Type:              String("User"),
-			SiteAdmin:         Bool(false),
+			Login:             Ptr("octocat"),
+			ID:                Ptr(int64(1)),
+			NodeID:            Ptr("MDQ6VXNlcjE="),
+			AvatarURL:         Ptr("https://github.com/images/error/octocat_happy.gif"),
+			GravatarID:        Ptr(""),
+			URL:               Ptr("https://api.github.com/users/octocat"),
+			HTMLURL:           Ptr("https://github.com/octocat"),
+			FollowersURL:      Ptr("https://api.github.com/users/octocat/followers"),
+			FollowingURL:      Ptr("https://api.github.com/users/octocat/following{/other_user}"),
+			GistsURL:          Ptr("https://api.github.com/users/octocat/gists{/gist_id}"),
+			StarredURL:        Ptr("https://api.github.com/users/octocat/starred{/owner}{/repo}"),
+			SubscriptionsURL:  Ptr("https://api.github.com/users/octocat/subscriptions"),
+			OrganizationsURL:  Ptr("https://api.github.com/users/octocat/orgs"),
+			ReposURL:          Ptr("https://api.github.com/users/octocat/repos"),
+			EventsURL:         Ptr("https://api.github.com/users/octocat/events{/privacy}"),
+			ReceivedEventsURL: Ptr("https://api.github.com/users/octocat/received_events"),
+			Type:              Ptr("User"),
+			SiteAdmin:         Ptr(false),
 		},
 		AssigningTeam: &Team{
-			ID:              Int64(1),
-			NodeID:          String("MDQ6VGVhbTE="),
-			URL:             String("https://api.github.com/teams/1"),
-			HTMLURL:         String("https://github.com/orgs/github/teams/justice-league"),
-			Name:            String("Justice League"),
-			Slug:            String("justice-league"),
-			Description:     String("A great team."),
-			Privacy:         String("closed"),
-			Permission:      String("admin"),
-			MembersURL:      String("https://api.github.com/teams/1/members{/member}"),
-			RepositoriesURL: String("https://api.github.com/teams/1/repos"),
+			ID:              Ptr(int64(1)),
+			NodeID:          Ptr("MDQ6VGVhbTE="),
+			URL:             Ptr("https://api.github.com/teams/1"),
+			HTMLURL:         Ptr("https://github.com/orgs/github/teams/justice-league"),
+			Name:            Ptr("Justice League"),
+			Slug:            Ptr("justice-league"),
+			Description:     Ptr("A great team."),
+			Privacy:         Ptr("closed"),
+			Permission:      Ptr("admin"),
+			MembersURL:      Ptr("https://api.github.com/teams/1/members{/member}"),
+			RepositoriesURL: Ptr("https://api.github.com/teams/1/repos"),
 			Parent:          nil,
 		},
 		CreatedAt:               &createdAt,
 		UpdatedAt:               &updatedAt,
 		PendingCancellationDate: nil,
 		LastActivityAt:          &lastActivityAt,
-		LastActivityEditor:      String("vscode/1.77.3/copilot/1.86.82"),
+		LastActivityEditor:      Ptr("vscode/1.77.3/copilot/1.86.82"),
 	}
 
 	if !cmp.Equal(got, want) {
@@ -75,6 +75,28 @@ func (s *EnterpriseService) CreateRegistrationToken(ctx context.Context, enterpr
 	return registrationToken, resp, nil
 }
 
+// CreateRemoveToken creates a token that can be used to remove a self-hosted runner from an enterprise.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runners#create-a-remove-token-for-an-enterprise
+//
+//meta:operation POST /enterprises/{enterprise}/actions/runners/remove-token
+func (s *EnterpriseService) CreateRemoveToken(ctx context.Context, enterprise string) (*RemoveToken, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/runners/remove-token", enterprise)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removeToken := new(RemoveToken)
+	resp, err := s.client.Do(ctx, req, removeToken)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return removeToken, resp, nil
+}
+
 // ListRunners lists all the self-hosted runners for a enterprise.
 //
 // GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runners#list-self-hosted-runners-for-an-enterprise
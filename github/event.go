@@ -42,13 +42,14 @@ func (e *Event) ParsePayload() (interface{}, error) {
 // Payload returns the parsed event payload. For recognized event types,
 // a value of the corresponding struct type will be returned.
 //
+// If the raw payload cannot be unmarshaled, Payload returns nil.
+//
 // Deprecated: Use ParsePayload instead, which returns an error
-// rather than panics if JSON unmarshaling raw payload fails.
+// if JSON unmarshaling raw payload fails.
 func (e *Event) Payload() (payload interface{}) {
-	var err error
-	payload, err = e.ParsePayload()
+	payload, err := e.ParsePayload()
 	if err != nil {
-		panic(err)
+		return nil
 	}
 	return payload
 }
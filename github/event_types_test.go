@@ -15,7 +15,7 @@ func TestEditChange_Marshal_TitleChange(t *testing.T) {
 
 	u := &EditChange{
 		Title: &EditTitle{
-			From: String("TitleFrom"),
+			From: Ptr("TitleFrom"),
 		},
 		Body: nil,
 		Base: nil,
@@ -36,7 +36,7 @@ func TestEditChange_Marshal_BodyChange(t *testing.T) {
 	u := &EditChange{
 		Title: nil,
 		Body: &EditBody{
-			From: String("BodyFrom"),
+			From: Ptr("BodyFrom"),
 		},
 		Base: nil,
 	}
@@ -55,10 +55,10 @@ func TestEditChange_Marshal_BaseChange(t *testing.T) {
 
 	Base := EditBase{
 		Ref: &EditRef{
-			From: String("BaseRefFrom"),
+			From: Ptr("BaseRefFrom"),
 		},
 		SHA: &EditSHA{
-			From: String("BaseSHAFrom"),
+			From: Ptr("BaseSHAFrom"),
 		},
 	}
 
@@ -88,7 +88,7 @@ func TestEditChange_Marshal_Repo(t *testing.T) {
 	u := &EditChange{
 		Repo: &EditRepo{
 			Name: &RepoName{
-				From: String("old-repo-name"),
+				From: Ptr("old-repo-name"),
 			},
 		},
 		Topics: &EditTopics{
@@ -120,13 +120,13 @@ func TestEditChange_Marshal_TransferFromUser(t *testing.T) {
 		Owner: &EditOwner{
 			OwnerInfo: &OwnerInfo{
 				User: &User{
-					Login:     String("l"),
-					ID:        Int64(1),
-					NodeID:    String("n"),
-					URL:       String("u"),
-					ReposURL:  String("r"),
-					EventsURL: String("e"),
-					AvatarURL: String("a"),
+					Login:     Ptr("l"),
+					ID:        Ptr(int64(1)),
+					NodeID:    Ptr("n"),
+					URL:       Ptr("u"),
+					ReposURL:  Ptr("r"),
+					EventsURL: Ptr("e"),
+					AvatarURL: Ptr("a"),
 				},
 			},
 		},
@@ -158,13 +158,13 @@ func TestEditChange_Marshal_TransferFromOrg(t *testing.T) {
 		Owner: &EditOwner{
 			OwnerInfo: &OwnerInfo{
 				Org: &User{
-					Login:     String("l"),
-					ID:        Int64(1),
-					NodeID:    String("n"),
-					URL:       String("u"),
-					ReposURL:  String("r"),
-					EventsURL: String("e"),
-					AvatarURL: String("a"),
+					Login:     Ptr("l"),
+					ID:        Ptr(int64(1)),
+					NodeID:    Ptr("n"),
+					URL:       Ptr("u"),
+					ReposURL:  Ptr("r"),
+					EventsURL: Ptr("e"),
+					AvatarURL: Ptr("a"),
 				},
 			},
 		},
@@ -193,7 +193,7 @@ func TestProjectChange_Marshal_NameChange(t *testing.T) {
 	testJSONMarshal(t, &ProjectChange{}, "{}")
 
 	u := &ProjectChange{
-		Name: &ProjectName{From: String("NameFrom")},
+		Name: &ProjectName{From: Ptr("NameFrom")},
 		Body: nil,
 	}
 
@@ -211,7 +211,7 @@ func TestProjectChange_Marshal_BodyChange(t *testing.T) {
 
 	u := &ProjectChange{
 		Name: nil,
-		Body: &ProjectBody{From: String("BodyFrom")},
+		Body: &ProjectBody{From: Ptr("BodyFrom")},
 	}
 
 	want := `{
@@ -227,7 +227,7 @@ func TestProjectCardChange_Marshal_NoteChange(t *testing.T) {
 	testJSONMarshal(t, &ProjectCardChange{}, "{}")
 
 	u := &ProjectCardChange{
-		Note: &ProjectCardNote{From: String("NoteFrom")},
+		Note: &ProjectCardNote{From: Ptr("NoteFrom")},
 	}
 
 	want := `{
@@ -243,7 +243,7 @@ func TestProjectColumnChange_Marshal_NameChange(t *testing.T) {
 	testJSONMarshal(t, &ProjectColumnChange{}, "{}")
 
 	u := &ProjectColumnChange{
-		Name: &ProjectColumnName{From: String("NameFrom")},
+		Name: &ProjectColumnName{From: Ptr("NameFrom")},
 	}
 
 	want := `{
@@ -260,168 +260,168 @@ func TestTeamAddEvent_Marshal(t *testing.T) {
 
 	u := &TeamAddEvent{
 		Team: &Team{
-			ID:              Int64(1),
-			NodeID:          String("n"),
-			Name:            String("n"),
-			Description:     String("d"),
-			URL:             String("u"),
-			Slug:            String("s"),
-			Permission:      String("p"),
-			Privacy:         String("p"),
-			MembersCount:    Int(1),
-			ReposCount:      Int(1),
-			MembersURL:      String("m"),
-			RepositoriesURL: String("r"),
+			ID:              Ptr(int64(1)),
+			NodeID:          Ptr("n"),
+			Name:            Ptr("n"),
+			Description:     Ptr("d"),
+			URL:             Ptr("u"),
+			Slug:            Ptr("s"),
+			Permission:      Ptr("p"),
+			Privacy:         Ptr("p"),
+			MembersCount:    Ptr(1),
+			ReposCount:      Ptr(1),
+			MembersURL:      Ptr("m"),
+			RepositoriesURL: Ptr("r"),
 			Organization: &Organization{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				AvatarURL: String("a"),
-				HTMLURL:   String("h"),
-				Name:      String("n"),
-				Company:   String("c"),
-				Blog:      String("b"),
-				Location:  String("l"),
-				Email:     String("e"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				AvatarURL: Ptr("a"),
+				HTMLURL:   Ptr("h"),
+				Name:      Ptr("n"),
+				Company:   Ptr("c"),
+				Blog:      Ptr("b"),
+				Location:  Ptr("l"),
+				Email:     Ptr("e"),
 			},
 			Parent: &Team{
-				ID:           Int64(1),
-				NodeID:       String("n"),
-				Name:         String("n"),
-				Description:  String("d"),
-				URL:          String("u"),
-				Slug:         String("s"),
-				Permission:   String("p"),
-				Privacy:      String("p"),
-				MembersCount: Int(1),
-				ReposCount:   Int(1),
-			},
-			LDAPDN: String("l"),
+				ID:           Ptr(int64(1)),
+				NodeID:       Ptr("n"),
+				Name:         Ptr("n"),
+				Description:  Ptr("d"),
+				URL:          Ptr("u"),
+				Slug:         Ptr("s"),
+				Permission:   Ptr("p"),
+				Privacy:      Ptr("p"),
+				MembersCount: Ptr(1),
+				ReposCount:   Ptr(1),
+			},
+			LDAPDN: Ptr("l"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -611,43 +611,43 @@ func TestStarEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &StarEvent{}, "{}")
 
 	u := &StarEvent{
-		Action:    String("a"),
+		Action:    Ptr("a"),
 		StarredAt: &Timestamp{referenceTime},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -699,190 +699,190 @@ func TestTeamEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TeamEvent{}, "{}")
 
 	u := &TeamEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Team: &Team{
-			ID:              Int64(1),
-			NodeID:          String("n"),
-			Name:            String("n"),
-			Description:     String("d"),
-			URL:             String("u"),
-			Slug:            String("s"),
-			Permission:      String("p"),
-			Privacy:         String("p"),
-			MembersCount:    Int(1),
-			ReposCount:      Int(1),
-			MembersURL:      String("m"),
-			RepositoriesURL: String("r"),
+			ID:              Ptr(int64(1)),
+			NodeID:          Ptr("n"),
+			Name:            Ptr("n"),
+			Description:     Ptr("d"),
+			URL:             Ptr("u"),
+			Slug:            Ptr("s"),
+			Permission:      Ptr("p"),
+			Privacy:         Ptr("p"),
+			MembersCount:    Ptr(1),
+			ReposCount:      Ptr(1),
+			MembersURL:      Ptr("m"),
+			RepositoriesURL: Ptr("r"),
 			Organization: &Organization{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				AvatarURL: String("a"),
-				HTMLURL:   String("h"),
-				Name:      String("n"),
-				Company:   String("c"),
-				Blog:      String("b"),
-				Location:  String("l"),
-				Email:     String("e"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				AvatarURL: Ptr("a"),
+				HTMLURL:   Ptr("h"),
+				Name:      Ptr("n"),
+				Company:   Ptr("c"),
+				Blog:      Ptr("b"),
+				Location:  Ptr("l"),
+				Email:     Ptr("e"),
 			},
 			Parent: &Team{
-				ID:           Int64(1),
-				NodeID:       String("n"),
-				Name:         String("n"),
-				Description:  String("d"),
-				URL:          String("u"),
-				Slug:         String("s"),
-				Permission:   String("p"),
-				Privacy:      String("p"),
-				MembersCount: Int(1),
-				ReposCount:   Int(1),
-			},
-			LDAPDN: String("l"),
+				ID:           Ptr(int64(1)),
+				NodeID:       Ptr("n"),
+				Name:         Ptr("n"),
+				Description:  Ptr("d"),
+				URL:          Ptr("u"),
+				Slug:         Ptr("s"),
+				Permission:   Ptr("p"),
+				Privacy:      Ptr("p"),
+				MembersCount: Ptr(1),
+				ReposCount:   Ptr(1),
+			},
+			LDAPDN: Ptr("l"),
 		},
 		Changes: &TeamChange{
 			Description: &TeamDescription{
-				From: String("from"),
+				From: Ptr("from"),
 			},
 			Name: &TeamName{
-				From: String("from"),
+				From: Ptr("from"),
 			},
 			Privacy: &TeamPrivacy{
-				From: String("from"),
+				From: Ptr("from"),
 			},
 			Repository: &TeamRepository{
 				Permissions: &TeamPermissions{
 					From: &TeamPermissionsFrom{
-						Admin: Bool(true),
-						Pull:  Bool(true),
-						Push:  Bool(true),
+						Admin: Ptr(true),
+						Pull:  Ptr(true),
+						Push:  Ptr(true),
 					},
 				},
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -1093,119 +1093,119 @@ func TestInstallationRepositoriesEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &InstallationRepositoriesEvent{}, "{}")
 
 	u := &InstallationRepositoriesEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		RepositoriesAdded: []*Repository{
 			{
-				ID:   Int64(1),
-				URL:  String("s"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("s"),
+				Name: Ptr("n"),
 			},
 		},
 		RepositoriesRemoved: []*Repository{
 			{
-				ID:   Int64(1),
-				URL:  String("s"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("s"),
+				Name: Ptr("n"),
 			},
 		},
-		RepositorySelection: String("rs"),
+		RepositorySelection: Ptr("rs"),
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -1346,165 +1346,165 @@ func TestInstallationTargetEvent_Marshal(t *testing.T) {
 
 	u := &InstallationTargetEvent{
 		Account: &User{
-			Login:     String("u"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("l"),
-		},
-		Action: String("a"),
+			Login:     Ptr("u"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("l"),
+		},
+		Action: Ptr("a"),
 		Changes: &InstallationChanges{
 			Login: &InstallationLoginChange{
-				From: String("p"),
+				From: Ptr("p"),
 			},
 			Slug: &InstallationSlugChange{
-				From: String("j"),
+				From: Ptr("j"),
 			},
 		},
 		Enterprise: &Enterprise{
-			ID:          Int(1),
-			Slug:        String("s"),
-			Name:        String("n"),
-			NodeID:      String("nid"),
-			AvatarURL:   String("au"),
-			Description: String("d"),
-			WebsiteURL:  String("wu"),
-			HTMLURL:     String("hu"),
+			ID:          Ptr(1),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			NodeID:      Ptr("nid"),
+			AvatarURL:   Ptr("au"),
+			Description: Ptr("d"),
+			WebsiteURL:  Ptr("wu"),
+			HTMLURL:     Ptr("hu"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 			SuspendedAt: &Timestamp{referenceTime},
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Repository: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
-		},
-		TargetType: String("running"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
+		},
+		TargetType: Ptr("running"),
 	}
 
 	want := `{
@@ -1681,7 +1681,7 @@ func TestEditTitle_Marshal(t *testing.T) {
 	testJSONMarshal(t, &EditTitle{}, "{}")
 
 	u := &EditTitle{
-		From: String("EditTitleFrom"),
+		From: Ptr("EditTitleFrom"),
 	}
 
 	want := `{
@@ -1695,7 +1695,7 @@ func TestEditBody_Marshal(t *testing.T) {
 	testJSONMarshal(t, &EditBody{}, "{}")
 
 	u := &EditBody{
-		From: String("EditBodyFrom"),
+		From: Ptr("EditBodyFrom"),
 	}
 
 	want := `{
@@ -1710,10 +1710,10 @@ func TestEditBase_Marshal(t *testing.T) {
 
 	u := &EditBase{
 		Ref: &EditRef{
-			From: String("EditRefFrom"),
+			From: Ptr("EditRefFrom"),
 		},
 		SHA: &EditSHA{
-			From: String("EditSHAFrom"),
+			From: Ptr("EditSHAFrom"),
 		},
 	}
 
@@ -1733,7 +1733,7 @@ func TestEditRef_Marshal(t *testing.T) {
 	testJSONMarshal(t, &EditRef{}, "{}")
 
 	u := &EditRef{
-		From: String("EditRefFrom"),
+		From: Ptr("EditRefFrom"),
 	}
 
 	want := `{
@@ -1747,7 +1747,7 @@ func TestEditSHA_Marshal(t *testing.T) {
 	testJSONMarshal(t, &EditSHA{}, "{}")
 
 	u := &EditSHA{
-		From: String("EditSHAFrom"),
+		From: Ptr("EditSHAFrom"),
 	}
 
 	want := `{
@@ -1761,7 +1761,7 @@ func TestProjectName_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectName{}, "{}")
 
 	u := &ProjectName{
-		From: String("ProjectNameFrom"),
+		From: Ptr("ProjectNameFrom"),
 	}
 
 	want := `{
@@ -1775,7 +1775,7 @@ func TestProjectBody_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectBody{}, "{}")
 
 	u := &ProjectBody{
-		From: String("ProjectBodyFrom"),
+		From: Ptr("ProjectBodyFrom"),
 	}
 
 	want := `{
@@ -1789,7 +1789,7 @@ func TestProjectCardNote_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectCardNote{}, "{}")
 
 	u := &ProjectCardNote{
-		From: String("ProjectCardNoteFrom"),
+		From: Ptr("ProjectCardNoteFrom"),
 	}
 
 	want := `{
@@ -1803,7 +1803,7 @@ func TestProjectColumnName_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectColumnName{}, "{}")
 
 	u := &ProjectColumnName{
-		From: String("ProjectColumnNameFrom"),
+		From: Ptr("ProjectColumnNameFrom"),
 	}
 
 	want := `{
@@ -1817,7 +1817,7 @@ func TestTeamDescription_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TeamDescription{}, "{}")
 
 	u := &TeamDescription{
-		From: String("TeamDescriptionFrom"),
+		From: Ptr("TeamDescriptionFrom"),
 	}
 
 	want := `{
@@ -1831,7 +1831,7 @@ func TestTeamName_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TeamName{}, "{}")
 
 	u := &TeamName{
-		From: String("TeamNameFrom"),
+		From: Ptr("TeamNameFrom"),
 	}
 
 	want := `{
@@ -1845,7 +1845,7 @@ func TestTeamPrivacy_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TeamPrivacy{}, "{}")
 
 	u := &TeamPrivacy{
-		From: String("TeamPrivacyFrom"),
+		From: Ptr("TeamPrivacyFrom"),
 	}
 
 	want := `{
@@ -1861,9 +1861,9 @@ func TestTeamRepository_Marshal(t *testing.T) {
 	u := &TeamRepository{
 		Permissions: &TeamPermissions{
 			From: &TeamPermissionsFrom{
-				Admin: Bool(true),
-				Pull:  Bool(true),
-				Push:  Bool(true),
+				Admin: Ptr(true),
+				Pull:  Ptr(true),
+				Push:  Ptr(true),
 			},
 		},
 	}
@@ -1886,9 +1886,9 @@ func TestTeamPermissions_Marshal(t *testing.T) {
 
 	u := &TeamPermissions{
 		From: &TeamPermissionsFrom{
-			Admin: Bool(true),
-			Pull:  Bool(true),
-			Push:  Bool(true),
+			Admin: Ptr(true),
+			Pull:  Ptr(true),
+			Push:  Ptr(true),
 		},
 	}
 
@@ -1907,9 +1907,9 @@ func TestTeamPermissionsFrom_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TeamPermissionsFrom{}, "{}")
 
 	u := &TeamPermissionsFrom{
-		Admin: Bool(true),
-		Pull:  Bool(true),
-		Push:  Bool(true),
+		Admin: Ptr(true),
+		Pull:  Ptr(true),
+		Push:  Ptr(true),
 	}
 
 	want := `{
@@ -1925,22 +1925,22 @@ func TestRepositoryVulnerabilityAlert_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepositoryVulnerabilityAlert{}, "{}")
 
 	u := &RepositoryVulnerabilityAlert{
-		ID:                  Int64(1),
-		AffectedRange:       String("ar"),
-		AffectedPackageName: String("apn"),
-		ExternalReference:   String("er"),
-		ExternalIdentifier:  String("ei"),
-		FixedIn:             String("fi"),
+		ID:                  Ptr(int64(1)),
+		AffectedRange:       Ptr("ar"),
+		AffectedPackageName: Ptr("apn"),
+		ExternalReference:   Ptr("er"),
+		ExternalIdentifier:  Ptr("ei"),
+		FixedIn:             Ptr("fi"),
 		Dismisser: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
-		},
-		DismissReason: String("dr"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
+		},
+		DismissReason: Ptr("dr"),
 		DismissedAt:   &Timestamp{referenceTime},
 	}
 
@@ -1971,12 +1971,12 @@ func TestPage_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Page{}, "{}")
 
 	u := &Page{
-		PageName: String("p"),
-		Title:    String("t"),
-		Summary:  String("s"),
-		Action:   String("a"),
-		SHA:      String("s"),
-		HTMLURL:  String("h"),
+		PageName: Ptr("p"),
+		Title:    Ptr("t"),
+		Summary:  Ptr("s"),
+		Action:   Ptr("a"),
+		SHA:      Ptr("s"),
+		HTMLURL:  Ptr("h"),
 	}
 
 	want := `{
@@ -1996,20 +1996,20 @@ func TestTeamChange_Marshal(t *testing.T) {
 
 	u := &TeamChange{
 		Description: &TeamDescription{
-			From: String("DescriptionFrom"),
+			From: Ptr("DescriptionFrom"),
 		},
 		Name: &TeamName{
-			From: String("NameFrom"),
+			From: Ptr("NameFrom"),
 		},
 		Privacy: &TeamPrivacy{
-			From: String("PrivacyFrom"),
+			From: Ptr("PrivacyFrom"),
 		},
 		Repository: &TeamRepository{
 			Permissions: &TeamPermissions{
 				From: &TeamPermissionsFrom{
-					Admin: Bool(false),
-					Pull:  Bool(false),
-					Push:  Bool(false),
+					Admin: Ptr(false),
+					Pull:  Ptr(false),
+					Push:  Ptr(false),
 				},
 			},
 		},
@@ -2043,153 +2043,153 @@ func TestIssueCommentEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &IssueCommentEvent{}, "{}")
 
 	u := &IssueCommentEvent{
-		Action:  String("a"),
-		Issue:   &Issue{ID: Int64(1)},
-		Comment: &IssueComment{ID: Int64(1)},
+		Action:  Ptr("a"),
+		Issue:   &Issue{ID: Ptr(int64(1))},
+		Comment: &IssueComment{ID: Ptr(int64(1))},
 		Changes: &EditChange{
 			Title: &EditTitle{
-				From: String("TitleFrom"),
+				From: Ptr("TitleFrom"),
 			},
 			Body: &EditBody{
-				From: String("BodyFrom"),
+				From: Ptr("BodyFrom"),
 			},
 			Base: &EditBase{
 				Ref: &EditRef{
-					From: String("BaseRefFrom"),
+					From: Ptr("BaseRefFrom"),
 				},
 				SHA: &EditSHA{
-					From: String("BaseSHAFrom"),
+					From: Ptr("BaseSHAFrom"),
 				},
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 			SuspendedAt: &Timestamp{referenceTime},
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 	}
 
@@ -2359,136 +2359,136 @@ func TestIssuesEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &IssuesEvent{}, "{}")
 
 	u := &IssuesEvent{
-		Action: String("a"),
-		Issue:  &Issue{ID: Int64(1)},
+		Action: Ptr("a"),
+		Issue:  &Issue{ID: Ptr(int64(1))},
 		Assignee: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
-		},
-		Label: &Label{ID: Int64(1)},
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
+		},
+		Label: &Label{ID: Ptr(int64(1))},
 		Changes: &EditChange{
 			Title: &EditTitle{
-				From: String("TitleFrom"),
+				From: Ptr("TitleFrom"),
 			},
 			Body: &EditBody{
-				From: String("BodyFrom"),
+				From: Ptr("BodyFrom"),
 			},
 			Base: &EditBase{
 				Ref: &EditRef{
-					From: String("BaseRefFrom"),
+					From: Ptr("BaseRefFrom"),
 				},
 				SHA: &EditSHA{
-					From: String("BaseSHAFrom"),
+					From: Ptr("BaseSHAFrom"),
 				},
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -2649,139 +2649,139 @@ func TestLabelEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &LabelEvent{}, "{}")
 
 	u := &LabelEvent{
-		Action: String("a"),
-		Label:  &Label{ID: Int64(1)},
+		Action: Ptr("a"),
+		Label:  &Label{ID: Ptr(int64(1))},
 		Changes: &EditChange{
 			Title: &EditTitle{
-				From: String("TitleFrom"),
+				From: Ptr("TitleFrom"),
 			},
 			Body: &EditBody{
-				From: String("BodyFrom"),
+				From: Ptr("BodyFrom"),
 			},
 			Base: &EditBase{
 				Ref: &EditRef{
-					From: String("BaseRefFrom"),
+					From: Ptr("BaseRefFrom"),
 				},
 				SHA: &EditSHA{
-					From: String("BaseSHAFrom"),
+					From: Ptr("BaseSHAFrom"),
 				},
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -2943,148 +2943,148 @@ func TestMilestoneEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &MilestoneEvent{}, "{}")
 
 	u := &MilestoneEvent{
-		Action:    String("a"),
-		Milestone: &Milestone{ID: Int64(1)},
+		Action:    Ptr("a"),
+		Milestone: &Milestone{ID: Ptr(int64(1))},
 		Changes: &EditChange{
 			Title: &EditTitle{
-				From: String("TitleFrom"),
+				From: Ptr("TitleFrom"),
 			},
 			Body: &EditBody{
-				From: String("BodyFrom"),
+				From: Ptr("BodyFrom"),
 			},
 			Base: &EditBase{
 				Ref: &EditRef{
-					From: String("BaseRefFrom"),
+					From: Ptr("BaseRefFrom"),
 				},
 				SHA: &EditSHA{
-					From: String("BaseSHAFrom"),
+					From: Ptr("BaseSHAFrom"),
 				},
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -3256,107 +3256,107 @@ func TestPublicEvent_Marshal(t *testing.T) {
 
 	u := &PublicEvent{
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -3485,137 +3485,137 @@ func TestPullRequestReviewEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestReviewEvent{}, "{}")
 
 	u := &PullRequestReviewEvent{
-		Action:      String("a"),
-		Review:      &PullRequestReview{ID: Int64(1)},
-		PullRequest: &PullRequest{ID: Int64(1)},
+		Action:      Ptr("a"),
+		Review:      &PullRequestReview{ID: Ptr(int64(1))},
+		PullRequest: &PullRequest{ID: Ptr(int64(1))},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 			SuspendedAt: &Timestamp{referenceTime},
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 	}
 
@@ -3769,152 +3769,152 @@ func TestPushEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PushEvent{}, "{}")
 
 	u := &PushEvent{
-		PushID: Int64(1),
-		Head:   String("h"),
-		Ref:    String("ref"),
-		Size:   Int(1),
+		PushID: Ptr(int64(1)),
+		Head:   Ptr("h"),
+		Ref:    Ptr("ref"),
+		Size:   Ptr(1),
 		Commits: []*HeadCommit{
-			{ID: String("id")},
-		},
-		Before:       String("b"),
-		DistinctSize: Int(1),
-		After:        String("a"),
-		Created:      Bool(true),
-		Deleted:      Bool(true),
-		Forced:       Bool(true),
-		BaseRef:      String("a"),
-		Compare:      String("a"),
-		Repo:         &PushEventRepository{ID: Int64(1)},
-		HeadCommit:   &HeadCommit{ID: String("id")},
+			{ID: Ptr("id")},
+		},
+		Before:       Ptr("b"),
+		DistinctSize: Ptr(1),
+		After:        Ptr("a"),
+		Created:      Ptr(true),
+		Deleted:      Ptr(true),
+		Forced:       Ptr(true),
+		BaseRef:      Ptr("a"),
+		Compare:      Ptr("a"),
+		Repo:         &PushEventRepository{ID: Ptr(int64(1))},
+		HeadCommit:   &HeadCommit{ID: Ptr("id")},
 		Pusher: &CommitAuthor{
-			Login: String("l"),
+			Login: Ptr("l"),
 			Date:  &Timestamp{referenceTime},
-			Name:  String("n"),
-			Email: String("e"),
+			Name:  Ptr("n"),
+			Email: Ptr("e"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 			SuspendedAt: &Timestamp{referenceTime},
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 	}
 
@@ -4085,120 +4085,120 @@ func TestStatusEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &StatusEvent{}, "{}")
 
 	u := &StatusEvent{
-		SHA:         String("sha"),
-		State:       String("s"),
-		Description: String("d"),
-		TargetURL:   String("turl"),
+		SHA:         Ptr("sha"),
+		State:       Ptr("s"),
+		Description: Ptr("d"),
+		TargetURL:   Ptr("turl"),
 		Branches: []*Branch{
 			{
-				Name:      String("n"),
-				Commit:    &RepositoryCommit{NodeID: String("nid")},
-				Protected: Bool(false),
+				Name:      Ptr("n"),
+				Commit:    &RepositoryCommit{NodeID: Ptr("nid")},
+				Protected: Ptr(false),
 			},
 		},
-		ID:        Int64(1),
-		Name:      String("n"),
-		Context:   String("c"),
-		Commit:    &RepositoryCommit{NodeID: String("nid")},
+		ID:        Ptr(int64(1)),
+		Name:      Ptr("n"),
+		Context:   Ptr("c"),
+		Commit:    &RepositoryCommit{NodeID: Ptr("nid")},
 		CreatedAt: &Timestamp{referenceTime},
 		UpdatedAt: &Timestamp{referenceTime},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -4343,151 +4343,151 @@ func TestMarketplacePurchaseEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &MarketplacePurchaseEvent{}, "{}")
 
 	u := &MarketplacePurchaseEvent{
-		Action:        String("a"),
+		Action:        Ptr("a"),
 		EffectiveDate: &Timestamp{referenceTime},
 		MarketplacePurchase: &MarketplacePurchase{
-			BillingCycle:    String("bc"),
+			BillingCycle:    Ptr("bc"),
 			NextBillingDate: &Timestamp{referenceTime},
-			UnitCount:       Int(1),
+			UnitCount:       Ptr(1),
 			Plan: &MarketplacePlan{
-				URL:                 String("u"),
-				AccountsURL:         String("au"),
-				ID:                  Int64(1),
-				Number:              Int(1),
-				Name:                String("n"),
-				Description:         String("d"),
-				MonthlyPriceInCents: Int(1),
-				YearlyPriceInCents:  Int(1),
-				PriceModel:          String("pm"),
-				UnitName:            String("un"),
+				URL:                 Ptr("u"),
+				AccountsURL:         Ptr("au"),
+				ID:                  Ptr(int64(1)),
+				Number:              Ptr(1),
+				Name:                Ptr("n"),
+				Description:         Ptr("d"),
+				MonthlyPriceInCents: Ptr(1),
+				YearlyPriceInCents:  Ptr(1),
+				PriceModel:          Ptr("pm"),
+				UnitName:            Ptr("un"),
 				Bullets:             &[]string{"b"},
-				State:               String("s"),
-				HasFreeTrial:        Bool(false),
+				State:               Ptr("s"),
+				HasFreeTrial:        Ptr(false),
 			},
-			OnFreeTrial:     Bool(false),
+			OnFreeTrial:     Ptr(false),
 			FreeTrialEndsOn: &Timestamp{referenceTime},
 			UpdatedAt:       &Timestamp{referenceTime},
 		},
 		PreviousMarketplacePurchase: &MarketplacePurchase{
-			BillingCycle:    String("bc"),
+			BillingCycle:    Ptr("bc"),
 			NextBillingDate: &Timestamp{referenceTime},
-			UnitCount:       Int(1),
+			UnitCount:       Ptr(1),
 			Plan: &MarketplacePlan{
-				URL:                 String("u"),
-				AccountsURL:         String("au"),
-				ID:                  Int64(1),
-				Number:              Int(1),
-				Name:                String("n"),
-				Description:         String("d"),
-				MonthlyPriceInCents: Int(1),
-				YearlyPriceInCents:  Int(1),
-				PriceModel:          String("pm"),
-				UnitName:            String("un"),
+				URL:                 Ptr("u"),
+				AccountsURL:         Ptr("au"),
+				ID:                  Ptr(int64(1)),
+				Number:              Ptr(1),
+				Name:                Ptr("n"),
+				Description:         Ptr("d"),
+				MonthlyPriceInCents: Ptr(1),
+				YearlyPriceInCents:  Ptr(1),
+				PriceModel:          Ptr("pm"),
+				UnitName:            Ptr("un"),
 				Bullets:             &[]string{"b"},
-				State:               String("s"),
-				HasFreeTrial:        Bool(false),
+				State:               Ptr("s"),
+				HasFreeTrial:        Ptr(false),
 			},
-			OnFreeTrial:     Bool(false),
+			OnFreeTrial:     Ptr(false),
 			FreeTrialEndsOn: &Timestamp{referenceTime},
 			UpdatedAt:       &Timestamp{referenceTime},
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -4663,164 +4663,164 @@ func TestOrganizationEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &OrganizationEvent{}, "{}")
 
 	u := &OrganizationEvent{
-		Action:     String("a"),
-		Invitation: &Invitation{ID: Int64(1)},
+		Action:     Ptr("a"),
+		Invitation: &Invitation{ID: Ptr(int64(1))},
 		Membership: &Membership{
-			URL:             String("url"),
-			State:           String("s"),
-			Role:            String("r"),
-			OrganizationURL: String("ou"),
+			URL:             Ptr("url"),
+			State:           Ptr("s"),
+			Role:            Ptr("r"),
+			OrganizationURL: Ptr("ou"),
 			Organization: &Organization{
-				BillingEmail:                         String("be"),
-				Blog:                                 String("b"),
-				Company:                              String("c"),
-				Email:                                String("e"),
-				TwitterUsername:                      String("tu"),
-				Location:                             String("loc"),
-				Name:                                 String("n"),
-				Description:                          String("d"),
-				IsVerified:                           Bool(true),
-				HasOrganizationProjects:              Bool(true),
-				HasRepositoryProjects:                Bool(true),
-				DefaultRepoPermission:                String("drp"),
-				MembersCanCreateRepos:                Bool(true),
-				MembersCanCreateInternalRepos:        Bool(true),
-				MembersCanCreatePrivateRepos:         Bool(true),
-				MembersCanCreatePublicRepos:          Bool(false),
-				MembersAllowedRepositoryCreationType: String("marct"),
-				MembersCanCreatePages:                Bool(true),
-				MembersCanCreatePublicPages:          Bool(false),
-				MembersCanCreatePrivatePages:         Bool(true),
+				BillingEmail:                         Ptr("be"),
+				Blog:                                 Ptr("b"),
+				Company:                              Ptr("c"),
+				Email:                                Ptr("e"),
+				TwitterUsername:                      Ptr("tu"),
+				Location:                             Ptr("loc"),
+				Name:                                 Ptr("n"),
+				Description:                          Ptr("d"),
+				IsVerified:                           Ptr(true),
+				HasOrganizationProjects:              Ptr(true),
+				HasRepositoryProjects:                Ptr(true),
+				DefaultRepoPermission:                Ptr("drp"),
+				MembersCanCreateRepos:                Ptr(true),
+				MembersCanCreateInternalRepos:        Ptr(true),
+				MembersCanCreatePrivateRepos:         Ptr(true),
+				MembersCanCreatePublicRepos:          Ptr(false),
+				MembersAllowedRepositoryCreationType: Ptr("marct"),
+				MembersCanCreatePages:                Ptr(true),
+				MembersCanCreatePublicPages:          Ptr(false),
+				MembersCanCreatePrivatePages:         Ptr(true),
 			},
 			User: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -5007,110 +5007,110 @@ func TestPageBuildEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PageBuildEvent{}, "{}")
 
 	u := &PageBuildEvent{
-		Build: &PagesBuild{URL: String("url")},
-		ID:    Int64(1),
+		Build: &PagesBuild{URL: Ptr("url")},
+		ID:    Ptr(int64(1)),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -5244,141 +5244,141 @@ func TestCommitCommentEvent_Marshal(t *testing.T) {
 
 	u := &CommitCommentEvent{
 		Comment: &RepositoryComment{
-			HTMLURL:  String("hurl"),
-			URL:      String("url"),
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			CommitID: String("cid"),
+			HTMLURL:  Ptr("hurl"),
+			URL:      Ptr("url"),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			CommitID: Ptr("cid"),
 			User: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 			Reactions: &Reactions{
-				TotalCount: Int(1),
-				PlusOne:    Int(1),
-				MinusOne:   Int(1),
-				Laugh:      Int(1),
-				Confused:   Int(1),
-				Heart:      Int(1),
-				Hooray:     Int(1),
-				Rocket:     Int(1),
-				Eyes:       Int(1),
-				URL:        String("url"),
+				TotalCount: Ptr(1),
+				PlusOne:    Ptr(1),
+				MinusOne:   Ptr(1),
+				Laugh:      Ptr(1),
+				Confused:   Ptr(1),
+				Heart:      Ptr(1),
+				Hooray:     Ptr(1),
+				Rocket:     Ptr(1),
+				Eyes:       Ptr(1),
+				URL:        Ptr("url"),
 			},
 			CreatedAt: &Timestamp{referenceTime},
 			UpdatedAt: &Timestamp{referenceTime},
-			Body:      String("b"),
-			Path:      String("path"),
-			Position:  Int(1),
+			Body:      Ptr("b"),
+			Path:      Ptr("path"),
+			Position:  Ptr(1),
 		},
-		Action: String("a"),
+		Action: Ptr("a"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -5547,183 +5547,183 @@ func TestDeploymentEvent_Marshal(t *testing.T) {
 
 	u := &DeploymentEvent{
 		Deployment: &Deployment{
-			URL:         String("url"),
-			ID:          Int64(1),
-			SHA:         String("sha"),
-			Ref:         String("ref"),
-			Task:        String("t"),
+			URL:         Ptr("url"),
+			ID:          Ptr(int64(1)),
+			SHA:         Ptr("sha"),
+			Ref:         Ptr("ref"),
+			Task:        Ptr("t"),
 			Payload:     jsonMsg,
-			Environment: String("e"),
-			Description: String("d"),
+			Environment: Ptr("e"),
+			Description: Ptr("d"),
 			Creator: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 			CreatedAt:     &Timestamp{referenceTime},
 			UpdatedAt:     &Timestamp{referenceTime},
-			StatusesURL:   String("surl"),
-			RepositoryURL: String("rurl"),
-			NodeID:        String("nid"),
+			StatusesURL:   Ptr("surl"),
+			RepositoryURL: Ptr("rurl"),
+			NodeID:        Ptr("nid"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 			SuspendedAt: &Timestamp{referenceTime},
 		},
 		Workflow: &Workflow{
-			ID:        Int64(1),
-			NodeID:    String("nid"),
-			Name:      String("n"),
-			Path:      String("p"),
-			State:     String("s"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("nid"),
+			Name:      Ptr("n"),
+			Path:      Ptr("p"),
+			State:     Ptr("s"),
 			CreatedAt: &Timestamp{referenceTime},
 			UpdatedAt: &Timestamp{referenceTime},
-			URL:       String("u"),
-			HTMLURL:   String("h"),
-			BadgeURL:  String("b"),
+			URL:       Ptr("u"),
+			HTMLURL:   Ptr("h"),
+			BadgeURL:  Ptr("b"),
 		},
 		WorkflowRun: &WorkflowRun{
-			ID:         Int64(1),
-			Name:       String("n"),
-			NodeID:     String("nid"),
-			HeadBranch: String("hb"),
-			HeadSHA:    String("hs"),
-			RunNumber:  Int(1),
-			RunAttempt: Int(1),
-			Event:      String("e"),
-			Status:     String("s"),
-			Conclusion: String("c"),
-			WorkflowID: Int64(1),
-			URL:        String("u"),
-			HTMLURL:    String("h"),
+			ID:         Ptr(int64(1)),
+			Name:       Ptr("n"),
+			NodeID:     Ptr("nid"),
+			HeadBranch: Ptr("hb"),
+			HeadSHA:    Ptr("hs"),
+			RunNumber:  Ptr(1),
+			RunAttempt: Ptr(1),
+			Event:      Ptr("e"),
+			Status:     Ptr("s"),
+			Conclusion: Ptr("c"),
+			WorkflowID: Ptr(int64(1)),
+			URL:        Ptr("u"),
+			HTMLURL:    Ptr("h"),
 			PullRequests: []*PullRequest{
 				{
-					URL:    String("u"),
-					ID:     Int64(1),
-					Number: Int(1),
+					URL:    Ptr("u"),
+					ID:     Ptr(int64(1)),
+					Number: Ptr(1),
 					Head: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("s"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("s"),
+							Name: Ptr("n"),
 						},
 					},
 					Base: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("u"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("u"),
+							Name: Ptr("n"),
 						},
 					},
 				},
@@ -5731,42 +5731,42 @@ func TestDeploymentEvent_Marshal(t *testing.T) {
 			CreatedAt:          &Timestamp{referenceTime},
 			UpdatedAt:          &Timestamp{referenceTime},
 			RunStartedAt:       &Timestamp{referenceTime},
-			JobsURL:            String("j"),
-			LogsURL:            String("l"),
-			CheckSuiteURL:      String("c"),
-			ArtifactsURL:       String("a"),
-			CancelURL:          String("c"),
-			RerunURL:           String("r"),
-			PreviousAttemptURL: String("p"),
+			JobsURL:            Ptr("j"),
+			LogsURL:            Ptr("l"),
+			CheckSuiteURL:      Ptr("c"),
+			ArtifactsURL:       Ptr("a"),
+			CancelURL:          Ptr("c"),
+			RerunURL:           Ptr("r"),
+			PreviousAttemptURL: Ptr("p"),
 			HeadCommit: &HeadCommit{
-				Message: String("m"),
+				Message: Ptr("m"),
 				Author: &CommitAuthor{
-					Name:  String("n"),
-					Email: String("e"),
-					Login: String("l"),
+					Name:  Ptr("n"),
+					Email: Ptr("e"),
+					Login: Ptr("l"),
 				},
-				URL:       String("u"),
-				Distinct:  Bool(false),
-				SHA:       String("s"),
-				ID:        String("i"),
-				TreeID:    String("tid"),
+				URL:       Ptr("u"),
+				Distinct:  Ptr(false),
+				SHA:       Ptr("s"),
+				ID:        Ptr("i"),
+				TreeID:    Ptr("tid"),
 				Timestamp: &Timestamp{referenceTime},
 				Committer: &CommitAuthor{
-					Name:  String("n"),
-					Email: String("e"),
-					Login: String("l"),
+					Name:  Ptr("n"),
+					Email: Ptr("e"),
+					Login: Ptr("l"),
 				},
 			},
-			WorkflowURL: String("w"),
+			WorkflowURL: Ptr("w"),
 			Repository: &Repository{
-				ID:   Int64(1),
-				URL:  String("u"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("u"),
+				Name: Ptr("n"),
 			},
 			HeadRepository: &Repository{
-				ID:   Int64(1),
-				URL:  String("u"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("u"),
+				Name: Ptr("n"),
 			},
 		},
 	}
@@ -6015,182 +6015,182 @@ func TestDeploymentProtectionRuleEvent_Marshal(t *testing.T) {
 	jsonMsg, _ := json.Marshal(&l)
 
 	u := &DeploymentProtectionRuleEvent{
-		Action:                String("a"),
-		Environment:           String("e"),
-		DeploymentCallbackURL: String("b"),
+		Action:                Ptr("a"),
+		Environment:           Ptr("e"),
+		DeploymentCallbackURL: Ptr("b"),
 		Deployment: &Deployment{
-			URL:         String("url"),
-			ID:          Int64(1),
-			SHA:         String("sha"),
-			Ref:         String("ref"),
-			Task:        String("t"),
+			URL:         Ptr("url"),
+			ID:          Ptr(int64(1)),
+			SHA:         Ptr("sha"),
+			Ref:         Ptr("ref"),
+			Task:        Ptr("t"),
 			Payload:     jsonMsg,
-			Environment: String("e"),
-			Description: String("d"),
+			Environment: Ptr("e"),
+			Description: Ptr("d"),
 			Creator: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 			CreatedAt:     &Timestamp{referenceTime},
 			UpdatedAt:     &Timestamp{referenceTime},
-			StatusesURL:   String("surl"),
-			RepositoryURL: String("rurl"),
-			NodeID:        String("nid"),
+			StatusesURL:   Ptr("surl"),
+			RepositoryURL: Ptr("rurl"),
+			NodeID:        Ptr("nid"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		PullRequests: []*PullRequest{
 			{
-				URL:    String("u"),
-				ID:     Int64(1),
-				Number: Int(1),
+				URL:    Ptr("u"),
+				ID:     Ptr(int64(1)),
+				Number: Ptr(1),
 				Head: &PullRequestBranch{
-					Ref: String("r"),
-					SHA: String("s"),
+					Ref: Ptr("r"),
+					SHA: Ptr("s"),
 					Repo: &Repository{
-						ID:   Int64(1),
-						URL:  String("s"),
-						Name: String("n"),
+						ID:   Ptr(int64(1)),
+						URL:  Ptr("s"),
+						Name: Ptr("n"),
 					},
 				},
 				Base: &PullRequestBranch{
-					Ref: String("r"),
-					SHA: String("s"),
+					Ref: Ptr("r"),
+					SHA: Ptr("s"),
 					Repo: &Repository{
-						ID:   Int64(1),
-						URL:  String("u"),
-						Name: String("n"),
+						ID:   Ptr(int64(1)),
+						URL:  Ptr("u"),
+						Name: Ptr("n"),
 					},
 				},
 			},
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -6401,155 +6401,155 @@ func TestDeploymentStatusEvent_Marshal(t *testing.T) {
 
 	u := &DeploymentStatusEvent{
 		Deployment: &Deployment{
-			URL:         String("url"),
-			ID:          Int64(1),
-			SHA:         String("sha"),
-			Ref:         String("ref"),
-			Task:        String("t"),
+			URL:         Ptr("url"),
+			ID:          Ptr(int64(1)),
+			SHA:         Ptr("sha"),
+			Ref:         Ptr("ref"),
+			Task:        Ptr("t"),
 			Payload:     jsonMsg,
-			Environment: String("e"),
-			Description: String("d"),
+			Environment: Ptr("e"),
+			Description: Ptr("d"),
 			Creator: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 			CreatedAt:     &Timestamp{referenceTime},
 			UpdatedAt:     &Timestamp{referenceTime},
-			StatusesURL:   String("surl"),
-			RepositoryURL: String("rurl"),
-			NodeID:        String("nid"),
+			StatusesURL:   Ptr("surl"),
+			RepositoryURL: Ptr("rurl"),
+			NodeID:        Ptr("nid"),
 		},
 		DeploymentStatus: &DeploymentStatus{
-			ID:    Int64(1),
-			State: String("s"),
+			ID:    Ptr(int64(1)),
+			State: Ptr("s"),
 			Creator: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
-			},
-			Description:    String("s"),
-			Environment:    String("s"),
-			NodeID:         String("s"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
+			},
+			Description:    Ptr("s"),
+			Environment:    Ptr("s"),
+			NodeID:         Ptr("s"),
 			CreatedAt:      &Timestamp{referenceTime},
 			UpdatedAt:      &Timestamp{referenceTime},
-			TargetURL:      String("s"),
-			DeploymentURL:  String("s"),
-			RepositoryURL:  String("s"),
-			EnvironmentURL: String("s"),
-			LogURL:         String("s"),
-			URL:            String("s"),
+			TargetURL:      Ptr("s"),
+			DeploymentURL:  Ptr("s"),
+			RepositoryURL:  Ptr("s"),
+			EnvironmentURL: Ptr("s"),
+			LogURL:         Ptr("s"),
+			URL:            Ptr("s"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -6729,199 +6729,199 @@ func TestDiscussionCommentEvent_Marshal(t *testing.T) {
 
 	u := &DiscussionCommentEvent{
 		Comment: &CommentDiscussion{
-			AuthorAssociation: String("aa"),
-			Body:              String("bo"),
-			ChildCommentCount: Int(1),
+			AuthorAssociation: Ptr("aa"),
+			Body:              Ptr("bo"),
+			ChildCommentCount: Ptr(1),
 			CreatedAt:         &Timestamp{referenceTime},
-			DiscussionID:      Int64(1),
-			HTMLURL:           String("hurl"),
-			ID:                Int64(1),
-			NodeID:            String("nid"),
-			ParentID:          Int64(1),
+			DiscussionID:      Ptr(int64(1)),
+			HTMLURL:           Ptr("hurl"),
+			ID:                Ptr(int64(1)),
+			NodeID:            Ptr("nid"),
+			ParentID:          Ptr(int64(1)),
 			Reactions: &Reactions{
-				TotalCount: Int(1),
-				PlusOne:    Int(1),
-				MinusOne:   Int(1),
-				Laugh:      Int(1),
-				Confused:   Int(1),
-				Heart:      Int(1),
-				Hooray:     Int(1),
-				Rocket:     Int(1),
-				Eyes:       Int(1),
-				URL:        String("url"),
-			},
-			RepositoryURL: String("rurl"),
+				TotalCount: Ptr(1),
+				PlusOne:    Ptr(1),
+				MinusOne:   Ptr(1),
+				Laugh:      Ptr(1),
+				Confused:   Ptr(1),
+				Heart:      Ptr(1),
+				Hooray:     Ptr(1),
+				Rocket:     Ptr(1),
+				Eyes:       Ptr(1),
+				URL:        Ptr("url"),
+			},
+			RepositoryURL: Ptr("rurl"),
 			UpdatedAt:     &Timestamp{referenceTime},
 			User: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 		},
 		Discussion: &Discussion{
-			RepositoryURL: String("rurl"),
+			RepositoryURL: Ptr("rurl"),
 			DiscussionCategory: &DiscussionCategory{
-				ID:           Int64(1),
-				NodeID:       String("nid"),
-				RepositoryID: Int64(1),
-				Emoji:        String("emoji"),
-				Name:         String("name"),
-				Description:  String("description"),
+				ID:           Ptr(int64(1)),
+				NodeID:       Ptr("nid"),
+				RepositoryID: Ptr(int64(1)),
+				Emoji:        Ptr("emoji"),
+				Name:         Ptr("name"),
+				Description:  Ptr("description"),
 				CreatedAt:    &Timestamp{referenceTime},
 				UpdatedAt:    &Timestamp{referenceTime},
-				Slug:         String("slug"),
-				IsAnswerable: Bool(false),
-			},
-			HTMLURL: String("hurl"),
-			ID:      Int64(1),
-			NodeID:  String("nurl"),
-			Number:  Int(1),
-			Title:   String("title"),
+				Slug:         Ptr("slug"),
+				IsAnswerable: Ptr(false),
+			},
+			HTMLURL: Ptr("hurl"),
+			ID:      Ptr(int64(1)),
+			NodeID:  Ptr("nurl"),
+			Number:  Ptr(1),
+			Title:   Ptr("title"),
 			User: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
-			},
-			State:             String("st"),
-			Locked:            Bool(false),
-			Comments:          Int(1),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
+			},
+			State:             Ptr("st"),
+			Locked:            Ptr(false),
+			Comments:          Ptr(1),
 			CreatedAt:         &Timestamp{referenceTime},
 			UpdatedAt:         &Timestamp{referenceTime},
-			AuthorAssociation: String("aa"),
-			Body:              String("bo"),
+			AuthorAssociation: Ptr("aa"),
+			Body:              Ptr("bo"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -7143,165 +7143,165 @@ func TestDiscussionEvent_Marshal(t *testing.T) {
 
 	u := &DiscussionEvent{
 		Discussion: &Discussion{
-			RepositoryURL: String("rurl"),
+			RepositoryURL: Ptr("rurl"),
 			DiscussionCategory: &DiscussionCategory{
-				ID:           Int64(1),
-				NodeID:       String("nid"),
-				RepositoryID: Int64(1),
-				Emoji:        String("emoji"),
-				Name:         String("name"),
-				Description:  String("description"),
+				ID:           Ptr(int64(1)),
+				NodeID:       Ptr("nid"),
+				RepositoryID: Ptr(int64(1)),
+				Emoji:        Ptr("emoji"),
+				Name:         Ptr("name"),
+				Description:  Ptr("description"),
 				CreatedAt:    &Timestamp{referenceTime},
 				UpdatedAt:    &Timestamp{referenceTime},
-				Slug:         String("slug"),
-				IsAnswerable: Bool(false),
-			},
-			HTMLURL: String("hurl"),
-			ID:      Int64(1),
-			NodeID:  String("nurl"),
-			Number:  Int(1),
-			Title:   String("title"),
+				Slug:         Ptr("slug"),
+				IsAnswerable: Ptr(false),
+			},
+			HTMLURL: Ptr("hurl"),
+			ID:      Ptr(int64(1)),
+			NodeID:  Ptr("nurl"),
+			Number:  Ptr(1),
+			Title:   Ptr("title"),
 			User: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
-			},
-			State:             String("st"),
-			Locked:            Bool(false),
-			Comments:          Int(1),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
+			},
+			State:             Ptr("st"),
+			Locked:            Ptr(false),
+			Comments:          Ptr(1),
 			CreatedAt:         &Timestamp{referenceTime},
 			UpdatedAt:         &Timestamp{referenceTime},
-			AuthorAssociation: String("aa"),
-			Body:              String("bo"),
+			AuthorAssociation: Ptr("aa"),
+			Body:              Ptr("bo"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -7488,61 +7488,61 @@ func TestPackageEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PackageEvent{}, "{}")
 
 	u := &PackageEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Package: &Package{
-			ID:          Int64(1),
-			Name:        String("n"),
-			PackageType: String("pt"),
-			HTMLURL:     String("hurl"),
+			ID:          Ptr(int64(1)),
+			Name:        Ptr("n"),
+			PackageType: Ptr("pt"),
+			HTMLURL:     Ptr("hurl"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 			Owner: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
-			PackageVersion: &PackageVersion{ID: Int64(1)},
-			Registry:       &PackageRegistry{Name: String("n")},
+			PackageVersion: &PackageVersion{ID: Ptr(int64(1))},
+			Registry:       &PackageRegistry{Name: Ptr("n")},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -7616,32 +7616,32 @@ func TestPersonalAccessTokenRequestEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PersonalAccessTokenRequestEvent{}, "{}")
 
 	event := &PersonalAccessTokenRequestEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		PersonalAccessTokenRequest: &PersonalAccessTokenRequest{
-			ID:    Int64(1),
-			Owner: &User{Login: String("l")},
+			ID:    Ptr(int64(1)),
+			Owner: &User{Login: Ptr("l")},
 			PermissionsAdded: &PersonalAccessTokenPermissions{
 				Org:  map[string]string{"organization_events": "read"},
 				Repo: map[string]string{"security_events": "write"},
 			},
 			CreatedAt:           &Timestamp{referenceTime},
-			TokenExpired:        Bool(false),
+			TokenExpired:        Ptr(false),
 			TokenExpiresAt:      &Timestamp{referenceTime},
 			TokenLastUsedAt:     &Timestamp{referenceTime},
-			RepositoryCount:     Int64(1),
-			RepositorySelection: String("rs"),
+			RepositoryCount:     Ptr(int64(1)),
+			RepositorySelection: Ptr("rs"),
 			Repositories: []*Repository{
 				{
-					Name: String("n"),
+					Name: Ptr("n"),
 				},
 			},
 		},
-		Org: &Organization{Name: String("n")},
+		Org: &Organization{Name: Ptr("n")},
 		Sender: &User{
-			Login: String("l"),
+			Login: Ptr("l"),
 		},
 		Installation: &Installation{
-			ID: Int64(1),
+			ID: Ptr(int64(1)),
 		},
 	}
 
@@ -7689,115 +7689,114 @@ func TestPersonalAccessTokenRequestEvent_Marshal(t *testing.T) {
 func TestPingEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PingEvent{}, "{}")
 
-	l := make(map[string]interface{})
-	l["key"] = "value"
+	l := &HookLastResponse{Code: Ptr(200), Status: Ptr("active"), Message: Ptr("OK")}
 	hookConfig := new(HookConfig)
 
 	u := &PingEvent{
-		Zen:    String("z"),
-		HookID: Int64(1),
+		Zen:    Ptr("z"),
+		HookID: Ptr(int64(1)),
 		Hook: &Hook{
 			CreatedAt:    &Timestamp{referenceTime},
 			UpdatedAt:    &Timestamp{referenceTime},
-			URL:          String("url"),
-			ID:           Int64(1),
-			Type:         String("t"),
-			Name:         String("n"),
-			TestURL:      String("tu"),
-			PingURL:      String("pu"),
+			URL:          Ptr("url"),
+			ID:           Ptr(int64(1)),
+			Type:         Ptr("t"),
+			Name:         Ptr("n"),
+			TestURL:      Ptr("tu"),
+			PingURL:      Ptr("pu"),
 			LastResponse: l,
 			Config:       hookConfig,
 			Events:       []string{"a"},
-			Active:       Bool(true),
+			Active:       Ptr(true),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -7818,7 +7817,9 @@ func TestPingEvent_Marshal(t *testing.T) {
 			"test_url": "tu",
 			"ping_url": "pu",
 			"last_response": {
-				"key": "value"
+				"code": 200,
+				"status": "active",
+				"message": "OK"
 			},
 			"config": {
 				"key": "value"
@@ -7939,134 +7940,134 @@ func TestRepositoryDispatchEvent_Marshal(t *testing.T) {
 	jsonMsg, _ := json.Marshal(&l)
 
 	u := &RepositoryDispatchEvent{
-		Action:        String("a"),
-		Branch:        String("b"),
+		Action:        Ptr("a"),
+		Branch:        Ptr("b"),
 		ClientPayload: jsonMsg,
 		Repo: &Repository{
 
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -8222,42 +8223,42 @@ func TestRepositoryImportEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepositoryImportEvent{}, "{}")
 
 	u := &RepositoryImportEvent{
-		Status: String("success"),
+		Status: Ptr("success"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -8308,131 +8309,131 @@ func TestRepositoryEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepositoryEvent{}, "{}")
 
 	u := &RepositoryEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -8584,125 +8585,125 @@ func TestReleaseEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ReleaseEvent{}, "{}")
 
 	u := &ReleaseEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Release: &RepositoryRelease{
-			Name:                   String("n"),
-			DiscussionCategoryName: String("dcn"),
-			ID:                     Int64(2),
+			Name:                   Ptr("n"),
+			DiscussionCategoryName: Ptr("dcn"),
+			ID:                     Ptr(int64(2)),
 			CreatedAt:              &Timestamp{referenceTime},
 			PublishedAt:            &Timestamp{referenceTime},
-			URL:                    String("url"),
-			HTMLURL:                String("htmlurl"),
-			AssetsURL:              String("assetsurl"),
-			Assets:                 []*ReleaseAsset{{ID: Int64(1)}},
-			UploadURL:              String("uploadurl"),
-			ZipballURL:             String("zipballurl"),
-			TarballURL:             String("tarballurl"),
-			Author:                 &User{Name: String("octocat")},
-			NodeID:                 String("nid"),
+			URL:                    Ptr("url"),
+			HTMLURL:                Ptr("htmlurl"),
+			AssetsURL:              Ptr("assetsurl"),
+			Assets:                 []*ReleaseAsset{{ID: Ptr(int64(1))}},
+			UploadURL:              Ptr("uploadurl"),
+			ZipballURL:             Ptr("zipballurl"),
+			TarballURL:             Ptr("tarballurl"),
+			Author:                 &User{Name: Ptr("octocat")},
+			NodeID:                 Ptr("nid"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -8854,114 +8855,114 @@ func TestContentReferenceEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ContentReferenceEvent{}, "{}")
 
 	u := &ContentReferenceEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		ContentReference: &ContentReference{
-			ID:        Int64(1),
-			NodeID:    String("nid"),
-			Reference: String("ref"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("nid"),
+			Reference: Ptr("ref"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -9096,128 +9097,128 @@ func TestMemberEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &MemberEvent{}, "{}")
 
 	u := &MemberEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Member: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Changes: &MemberChanges{
 			Permission: &MemberChangesPermission{
-				From: String("f"),
-				To:   String("t"),
+				From: Ptr("f"),
+				To:   Ptr("t"),
 			},
 			RoleName: &MemberChangesRoleName{
-				From: String("f"),
-				To:   String("t"),
+				From: Ptr("f"),
+				To:   Ptr("t"),
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -9366,175 +9367,175 @@ func TestMembershipEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &MembershipEvent{}, "{}")
 
 	u := &MembershipEvent{
-		Action: String("a"),
-		Scope:  String("s"),
+		Action: Ptr("a"),
+		Scope:  Ptr("s"),
 		Member: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Team: &Team{
-			ID:              Int64(1),
-			NodeID:          String("n"),
-			Name:            String("n"),
-			Description:     String("d"),
-			URL:             String("u"),
-			Slug:            String("s"),
-			Permission:      String("p"),
-			Privacy:         String("p"),
-			MembersCount:    Int(1),
-			ReposCount:      Int(1),
-			MembersURL:      String("m"),
-			RepositoriesURL: String("r"),
+			ID:              Ptr(int64(1)),
+			NodeID:          Ptr("n"),
+			Name:            Ptr("n"),
+			Description:     Ptr("d"),
+			URL:             Ptr("u"),
+			Slug:            Ptr("s"),
+			Permission:      Ptr("p"),
+			Privacy:         Ptr("p"),
+			MembersCount:    Ptr(1),
+			ReposCount:      Ptr(1),
+			MembersURL:      Ptr("m"),
+			RepositoriesURL: Ptr("r"),
 			Organization: &Organization{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				AvatarURL: String("a"),
-				HTMLURL:   String("h"),
-				Name:      String("n"),
-				Company:   String("c"),
-				Blog:      String("b"),
-				Location:  String("l"),
-				Email:     String("e"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				AvatarURL: Ptr("a"),
+				HTMLURL:   Ptr("h"),
+				Name:      Ptr("n"),
+				Company:   Ptr("c"),
+				Blog:      Ptr("b"),
+				Location:  Ptr("l"),
+				Email:     Ptr("e"),
 			},
 			Parent: &Team{
-				ID:           Int64(1),
-				NodeID:       String("n"),
-				Name:         String("n"),
-				Description:  String("d"),
-				URL:          String("u"),
-				Slug:         String("s"),
-				Permission:   String("p"),
-				Privacy:      String("p"),
-				MembersCount: Int(1),
-				ReposCount:   Int(1),
-			},
-			LDAPDN: String("l"),
+				ID:           Ptr(int64(1)),
+				NodeID:       Ptr("n"),
+				Name:         Ptr("n"),
+				Description:  Ptr("d"),
+				URL:          Ptr("u"),
+				Slug:         Ptr("s"),
+				Permission:   Ptr("p"),
+				Privacy:      Ptr("p"),
+				MembersCount: Ptr(1),
+				ReposCount:   Ptr(1),
+			},
+			LDAPDN: Ptr("l"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -9730,138 +9731,138 @@ func TestMergeGroupEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &MergeGroupEvent{}, "{}")
 
 	u := &MergeGroupEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		MergeGroup: &MergeGroup{
-			HeadSHA:    String("hs"),
-			HeadRef:    String("hr"),
-			BaseSHA:    String("bs"),
-			BaseRef:    String("br"),
-			HeadCommit: &Commit{NodeID: String("nid")},
+			HeadSHA:    Ptr("hs"),
+			HeadRef:    Ptr("hr"),
+			BaseSHA:    Ptr("bs"),
+			BaseRef:    Ptr("br"),
+			HeadCommit: &Commit{NodeID: Ptr("nid")},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -10022,135 +10023,135 @@ func TestOrgBlockEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &OrgBlockEvent{}, "{}")
 
 	u := &OrgBlockEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		BlockedUser: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -10308,116 +10309,116 @@ func TestGollumEvent_Marshal(t *testing.T) {
 	u := &GollumEvent{
 		Pages: []*Page{
 			{
-				PageName: String("pn"),
-				Title:    String("t"),
-				Summary:  String("s"),
-				Action:   String("a"),
-				SHA:      String("sha"),
-				HTMLURL:  String("hu"),
+				PageName: Ptr("pn"),
+				Title:    Ptr("t"),
+				Summary:  Ptr("s"),
+				Action:   Ptr("a"),
+				SHA:      Ptr("sha"),
+				HTMLURL:  Ptr("hu"),
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -10556,54 +10557,54 @@ func TestWorkflowRunEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &WorkflowRunEvent{}, "{}")
 
 	u := &WorkflowRunEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Workflow: &Workflow{
-			ID:        Int64(1),
-			NodeID:    String("nid"),
-			Name:      String("n"),
-			Path:      String("p"),
-			State:     String("s"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("nid"),
+			Name:      Ptr("n"),
+			Path:      Ptr("p"),
+			State:     Ptr("s"),
 			CreatedAt: &Timestamp{referenceTime},
 			UpdatedAt: &Timestamp{referenceTime},
-			URL:       String("u"),
-			HTMLURL:   String("h"),
-			BadgeURL:  String("b"),
+			URL:       Ptr("u"),
+			HTMLURL:   Ptr("h"),
+			BadgeURL:  Ptr("b"),
 		},
 		WorkflowRun: &WorkflowRun{
-			ID:         Int64(1),
-			Name:       String("n"),
-			NodeID:     String("nid"),
-			HeadBranch: String("hb"),
-			HeadSHA:    String("hs"),
-			RunNumber:  Int(1),
-			RunAttempt: Int(1),
-			Event:      String("e"),
-			Status:     String("s"),
-			Conclusion: String("c"),
-			WorkflowID: Int64(1),
-			URL:        String("u"),
-			HTMLURL:    String("h"),
+			ID:         Ptr(int64(1)),
+			Name:       Ptr("n"),
+			NodeID:     Ptr("nid"),
+			HeadBranch: Ptr("hb"),
+			HeadSHA:    Ptr("hs"),
+			RunNumber:  Ptr(1),
+			RunAttempt: Ptr(1),
+			Event:      Ptr("e"),
+			Status:     Ptr("s"),
+			Conclusion: Ptr("c"),
+			WorkflowID: Ptr(int64(1)),
+			URL:        Ptr("u"),
+			HTMLURL:    Ptr("h"),
 			PullRequests: []*PullRequest{
 				{
-					URL:    String("u"),
-					ID:     Int64(1),
-					Number: Int(1),
+					URL:    Ptr("u"),
+					ID:     Ptr(int64(1)),
+					Number: Ptr(1),
 					Head: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("s"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("s"),
+							Name: Ptr("n"),
 						},
 					},
 					Base: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("u"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("u"),
+							Name: Ptr("n"),
 						},
 					},
 				},
@@ -10611,79 +10612,79 @@ func TestWorkflowRunEvent_Marshal(t *testing.T) {
 			CreatedAt:          &Timestamp{referenceTime},
 			UpdatedAt:          &Timestamp{referenceTime},
 			RunStartedAt:       &Timestamp{referenceTime},
-			JobsURL:            String("j"),
-			LogsURL:            String("l"),
-			CheckSuiteURL:      String("c"),
-			ArtifactsURL:       String("a"),
-			CancelURL:          String("c"),
-			RerunURL:           String("r"),
-			PreviousAttemptURL: String("p"),
+			JobsURL:            Ptr("j"),
+			LogsURL:            Ptr("l"),
+			CheckSuiteURL:      Ptr("c"),
+			ArtifactsURL:       Ptr("a"),
+			CancelURL:          Ptr("c"),
+			RerunURL:           Ptr("r"),
+			PreviousAttemptURL: Ptr("p"),
 			HeadCommit: &HeadCommit{
-				Message: String("m"),
+				Message: Ptr("m"),
 				Author: &CommitAuthor{
-					Name:  String("n"),
-					Email: String("e"),
-					Login: String("l"),
+					Name:  Ptr("n"),
+					Email: Ptr("e"),
+					Login: Ptr("l"),
 				},
-				URL:       String("u"),
-				Distinct:  Bool(false),
-				SHA:       String("s"),
-				ID:        String("i"),
-				TreeID:    String("tid"),
+				URL:       Ptr("u"),
+				Distinct:  Ptr(false),
+				SHA:       Ptr("s"),
+				ID:        Ptr("i"),
+				TreeID:    Ptr("tid"),
 				Timestamp: &Timestamp{referenceTime},
 				Committer: &CommitAuthor{
-					Name:  String("n"),
-					Email: String("e"),
-					Login: String("l"),
+					Name:  Ptr("n"),
+					Email: Ptr("e"),
+					Login: Ptr("l"),
 				},
 			},
-			WorkflowURL: String("w"),
+			WorkflowURL: Ptr("w"),
 			Repository: &Repository{
-				ID:   Int64(1),
-				URL:  String("u"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("u"),
+				Name: Ptr("n"),
 			},
 			HeadRepository: &Repository{
-				ID:   Int64(1),
-				URL:  String("u"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("u"),
+				Name: Ptr("n"),
 			},
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -10831,43 +10832,43 @@ func TestWorkflowDispatchEvent_Marshal(t *testing.T) {
 	jsonMsg, _ := json.Marshal(i)
 	u := &WorkflowDispatchEvent{
 		Inputs:   jsonMsg,
-		Ref:      String("r"),
-		Workflow: String("w"),
+		Ref:      Ptr("r"),
+		Workflow: Ptr("w"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -10922,109 +10923,109 @@ func TestWatchEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &WatchEvent{}, "{}")
 
 	u := &WatchEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -11155,36 +11156,36 @@ func TestUserEvent_Marshal(t *testing.T) {
 
 	u := &UserEvent{
 		User: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		// The action performed. Possible values are: "created" or "deleted".
-		Action: String("a"),
+		Action: Ptr("a"),
 		Enterprise: &Enterprise{
-			ID:          Int(1),
-			Slug:        String("s"),
-			Name:        String("n"),
-			NodeID:      String("nid"),
-			AvatarURL:   String("au"),
-			Description: String("d"),
-			WebsiteURL:  String("wu"),
-			HTMLURL:     String("hu"),
+			ID:          Ptr(1),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			NodeID:      Ptr("nid"),
+			AvatarURL:   Ptr("au"),
+			Description: Ptr("d"),
+			WebsiteURL:  Ptr("wu"),
+			HTMLURL:     Ptr("hu"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -11230,216 +11231,216 @@ func TestCheckRunEvent_Marshal(t *testing.T) {
 
 	r := &CheckRunEvent{
 		CheckRun: &CheckRun{
-			ID:          Int64(1),
-			NodeID:      String("n"),
-			HeadSHA:     String("h"),
-			ExternalID:  String("1"),
-			URL:         String("u"),
-			HTMLURL:     String("u"),
-			DetailsURL:  String("u"),
-			Status:      String("s"),
-			Conclusion:  String("c"),
+			ID:          Ptr(int64(1)),
+			NodeID:      Ptr("n"),
+			HeadSHA:     Ptr("h"),
+			ExternalID:  Ptr("1"),
+			URL:         Ptr("u"),
+			HTMLURL:     Ptr("u"),
+			DetailsURL:  Ptr("u"),
+			Status:      Ptr("s"),
+			Conclusion:  Ptr("c"),
 			StartedAt:   &Timestamp{referenceTime},
 			CompletedAt: &Timestamp{referenceTime},
 			Output: &CheckRunOutput{
 				Annotations: []*CheckRunAnnotation{
 					{
-						AnnotationLevel: String("a"),
-						EndLine:         Int(1),
-						Message:         String("m"),
-						Path:            String("p"),
-						RawDetails:      String("r"),
-						StartLine:       Int(1),
-						Title:           String("t"),
+						AnnotationLevel: Ptr("a"),
+						EndLine:         Ptr(1),
+						Message:         Ptr("m"),
+						Path:            Ptr("p"),
+						RawDetails:      Ptr("r"),
+						StartLine:       Ptr(1),
+						Title:           Ptr("t"),
 					},
 				},
-				AnnotationsCount: Int(1),
-				AnnotationsURL:   String("a"),
+				AnnotationsCount: Ptr(1),
+				AnnotationsURL:   Ptr("a"),
 				Images: []*CheckRunImage{
 					{
-						Alt:      String("a"),
-						ImageURL: String("i"),
-						Caption:  String("c"),
+						Alt:      Ptr("a"),
+						ImageURL: Ptr("i"),
+						Caption:  Ptr("c"),
 					},
 				},
-				Title:   String("t"),
-				Summary: String("s"),
-				Text:    String("t"),
+				Title:   Ptr("t"),
+				Summary: Ptr("s"),
+				Text:    Ptr("t"),
 			},
-			Name: String("n"),
+			Name: Ptr("n"),
 			CheckSuite: &CheckSuite{
-				ID: Int64(1),
+				ID: Ptr(int64(1)),
 			},
 			App: &App{
-				ID:     Int64(1),
-				NodeID: String("n"),
+				ID:     Ptr(int64(1)),
+				NodeID: Ptr("n"),
 				Owner: &User{
-					Login:     String("l"),
-					ID:        Int64(1),
-					NodeID:    String("n"),
-					URL:       String("u"),
-					ReposURL:  String("r"),
-					EventsURL: String("e"),
-					AvatarURL: String("a"),
+					Login:     Ptr("l"),
+					ID:        Ptr(int64(1)),
+					NodeID:    Ptr("n"),
+					URL:       Ptr("u"),
+					ReposURL:  Ptr("r"),
+					EventsURL: Ptr("e"),
+					AvatarURL: Ptr("a"),
 				},
-				Name:        String("n"),
-				Description: String("d"),
-				HTMLURL:     String("h"),
-				ExternalURL: String("u"),
+				Name:        Ptr("n"),
+				Description: Ptr("d"),
+				HTMLURL:     Ptr("h"),
+				ExternalURL: Ptr("u"),
 				CreatedAt:   &Timestamp{referenceTime},
 				UpdatedAt:   &Timestamp{referenceTime},
 			},
 			PullRequests: []*PullRequest{
 				{
-					URL:    String("u"),
-					ID:     Int64(1),
-					Number: Int(1),
+					URL:    Ptr("u"),
+					ID:     Ptr(int64(1)),
+					Number: Ptr(1),
 					Head: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("s"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("s"),
+							Name: Ptr("n"),
 						},
 					},
 					Base: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("u"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("u"),
+							Name: Ptr("n"),
 						},
 					},
 				},
 			},
 		},
-		Action: String("a"),
+		Action: Ptr("a"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -11684,191 +11685,191 @@ func TestCheckSuiteEvent_Marshal(t *testing.T) {
 
 	r := &CheckSuiteEvent{
 		CheckSuite: &CheckSuite{
-			ID:         Int64(1),
-			NodeID:     String("n"),
-			HeadBranch: String("h"),
-			HeadSHA:    String("h"),
-			URL:        String("u"),
-			BeforeSHA:  String("b"),
-			AfterSHA:   String("a"),
-			Status:     String("s"),
-			Conclusion: String("c"),
+			ID:         Ptr(int64(1)),
+			NodeID:     Ptr("n"),
+			HeadBranch: Ptr("h"),
+			HeadSHA:    Ptr("h"),
+			URL:        Ptr("u"),
+			BeforeSHA:  Ptr("b"),
+			AfterSHA:   Ptr("a"),
+			Status:     Ptr("s"),
+			Conclusion: Ptr("c"),
 			App: &App{
-				ID:     Int64(1),
-				NodeID: String("n"),
+				ID:     Ptr(int64(1)),
+				NodeID: Ptr("n"),
 				Owner: &User{
-					Login:     String("l"),
-					ID:        Int64(1),
-					NodeID:    String("n"),
-					URL:       String("u"),
-					ReposURL:  String("r"),
-					EventsURL: String("e"),
-					AvatarURL: String("a"),
+					Login:     Ptr("l"),
+					ID:        Ptr(int64(1)),
+					NodeID:    Ptr("n"),
+					URL:       Ptr("u"),
+					ReposURL:  Ptr("r"),
+					EventsURL: Ptr("e"),
+					AvatarURL: Ptr("a"),
 				},
-				Name:        String("n"),
-				Description: String("d"),
-				HTMLURL:     String("h"),
-				ExternalURL: String("u"),
+				Name:        Ptr("n"),
+				Description: Ptr("d"),
+				HTMLURL:     Ptr("h"),
+				ExternalURL: Ptr("u"),
 				CreatedAt:   &Timestamp{referenceTime},
 				UpdatedAt:   &Timestamp{referenceTime},
 			},
 			Repository: &Repository{
-				ID: Int64(1),
+				ID: Ptr(int64(1)),
 			},
 			PullRequests: []*PullRequest{
 				{
-					URL:    String("u"),
-					ID:     Int64(1),
-					Number: Int(1),
+					URL:    Ptr("u"),
+					ID:     Ptr(int64(1)),
+					Number: Ptr(1),
 					Head: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("s"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("s"),
+							Name: Ptr("n"),
 						},
 					},
 					Base: &PullRequestBranch{
-						Ref: String("r"),
-						SHA: String("s"),
+						Ref: Ptr("r"),
+						SHA: Ptr("s"),
 						Repo: &Repository{
-							ID:   Int64(1),
-							URL:  String("u"),
-							Name: String("n"),
+							ID:   Ptr(int64(1)),
+							URL:  Ptr("u"),
+							Name: Ptr("n"),
 						},
 					},
 				},
 			},
 			HeadCommit: &Commit{
-				SHA: String("s"),
+				SHA: Ptr("s"),
 			},
 		},
-		Action: String("a"),
+		Action: Ptr("a"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -12081,51 +12082,51 @@ func TestDeployKeyEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &DeployKeyEvent{}, "{}")
 
 	u := &DeployKeyEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Key: &Key{
-			ID:        Int64(1),
-			Key:       String("k"),
-			URL:       String("k"),
-			Title:     String("k"),
-			ReadOnly:  Bool(false),
-			Verified:  Bool(false),
+			ID:        Ptr(int64(1)),
+			Key:       Ptr("k"),
+			URL:       Ptr("k"),
+			Title:     Ptr("k"),
+			ReadOnly:  Ptr(false),
+			Verified:  Ptr(false),
 			CreatedAt: &Timestamp{referenceTime},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			AvatarURL: String("a"),
-			URL:       String("u"),
-			EventsURL: String("e"),
-			ReposURL:  String("r"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			AvatarURL: Ptr("a"),
+			URL:       Ptr("u"),
+			EventsURL: Ptr("e"),
+			ReposURL:  Ptr("r"),
 		},
 	}
 
@@ -12184,28 +12185,27 @@ func TestDeployKeyEvent_Marshal(t *testing.T) {
 func TestMetaEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &MetaEvent{}, "{}")
 
-	v := make(map[string]interface{})
-	v["a"] = "b"
+	v := &HookLastResponse{Code: Ptr(422), Status: Ptr("misconfigured"), Message: Ptr("Invalid HTTP Response: 404")}
 	hookConfig := &HookConfig{
-		ContentType: String("json"),
+		ContentType: Ptr("json"),
 	}
 
 	u := &MetaEvent{
-		Action: String("a"),
-		HookID: Int64(1),
+		Action: Ptr("a"),
+		HookID: Ptr(int64(1)),
 		Hook: &Hook{
 			CreatedAt:    &Timestamp{referenceTime},
 			UpdatedAt:    &Timestamp{referenceTime},
-			URL:          String("u"),
-			ID:           Int64(1),
-			Type:         String("t"),
-			Name:         String("n"),
-			TestURL:      String("tu"),
-			PingURL:      String("pu"),
+			URL:          Ptr("u"),
+			ID:           Ptr(int64(1)),
+			Type:         Ptr("t"),
+			Name:         Ptr("n"),
+			TestURL:      Ptr("tu"),
+			PingURL:      Ptr("pu"),
 			LastResponse: v,
 			Config:       hookConfig,
 			Events:       []string{"a"},
-			Active:       Bool(true),
+			Active:       Ptr(true),
 		},
 	}
 
@@ -12222,7 +12222,9 @@ func TestMetaEvent_Marshal(t *testing.T) {
 			"test_url": "tu",
 			"ping_url": "pu",
 			"last_response": {
-				"a": "b"
+				"code": 422,
+				"status": "misconfigured",
+				"message": "Invalid HTTP Response: 404"
 			},
 			"config": {
 				"content_type": "json"
@@ -12255,113 +12257,113 @@ func TestCreateEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CreateEvent{}, "{}")
 
 	r := &CreateEvent{
-		Ref:          String("r"),
-		RefType:      String("rt"),
-		MasterBranch: String("mb"),
-		Description:  String("d"),
-		PusherType:   String("pt"),
+		Ref:          Ptr("r"),
+		RefType:      Ptr("rt"),
+		MasterBranch: Ptr("mb"),
+		Description:  Ptr("d"),
+		PusherType:   Ptr("pt"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -12495,111 +12497,111 @@ func TestDeleteEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &DeleteEvent{}, "{}")
 
 	r := &DeleteEvent{
-		Ref:        String("r"),
-		RefType:    String("rt"),
-		PusherType: String("pt"),
+		Ref:        Ptr("r"),
+		RefType:    Ptr("rt"),
+		PusherType: Ptr("pt"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -12731,52 +12733,52 @@ func TestDependabotAlertEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &DependabotAlertEvent{}, "{}")
 
 	e := &DependabotAlertEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Alert: &DependabotAlert{
-			Number: Int(1),
-			State:  String("s"),
+			Number: Ptr(1),
+			State:  Ptr("s"),
 			Dependency: &Dependency{
 				Package: &VulnerabilityPackage{
-					Ecosystem: String("e"),
-					Name:      String("n"),
+					Ecosystem: Ptr("e"),
+					Name:      Ptr("n"),
 				},
-				ManifestPath: String("mp"),
-				Scope:        String("s"),
+				ManifestPath: Ptr("mp"),
+				Scope:        Ptr("s"),
 			},
 			SecurityAdvisory: &DependabotSecurityAdvisory{
-				GHSAID:      String("ghsaid"),
-				CVEID:       String("cveid"),
-				Summary:     String("s"),
-				Description: String("d"),
+				GHSAID:      Ptr("ghsaid"),
+				CVEID:       Ptr("cveid"),
+				Summary:     Ptr("s"),
+				Description: Ptr("d"),
 				Vulnerabilities: []*AdvisoryVulnerability{
 					{
 						Package: &VulnerabilityPackage{
-							Ecosystem: String("e"),
-							Name:      String("n"),
+							Ecosystem: Ptr("e"),
+							Name:      Ptr("n"),
 						},
-						Severity: String("s"),
+						Severity: Ptr("s"),
 					},
 				},
-				Severity: String("s"),
+				Severity: Ptr("s"),
 				CVSS: &AdvisoryCVSS{
-					Score:        Float64(1.0),
-					VectorString: String("vs"),
+					Score:        Ptr(1.0),
+					VectorString: Ptr("vs"),
 				},
 				CWEs: []*AdvisoryCWEs{
 					{
-						CWEID: String("cweid"),
-						Name:  String("n"),
+						CWEID: Ptr("cweid"),
+						Name:  Ptr("n"),
 					},
 				},
 				Identifiers: []*AdvisoryIdentifier{
 					{
-						Value: String("v"),
-						Type:  String("t"),
+						Value: Ptr("v"),
+						Type:  Ptr("t"),
 					},
 				},
 				References: []*AdvisoryReference{
 					{
-						URL: String("u"),
+						URL: Ptr("u"),
 					},
 				},
 				PublishedAt: &Timestamp{referenceTime},
@@ -12785,170 +12787,170 @@ func TestDependabotAlertEvent_Marshal(t *testing.T) {
 			},
 			SecurityVulnerability: &AdvisoryVulnerability{
 				Package: &VulnerabilityPackage{
-					Ecosystem: String("e"),
-					Name:      String("n"),
+					Ecosystem: Ptr("e"),
+					Name:      Ptr("n"),
 				},
-				Severity:               String("s"),
-				VulnerableVersionRange: String("vvr"),
+				Severity:               Ptr("s"),
+				VulnerableVersionRange: Ptr("vvr"),
 				FirstPatchedVersion: &FirstPatchedVersion{
-					Identifier: String("i"),
+					Identifier: Ptr("i"),
 				},
 			},
-			URL:         String("u"),
-			HTMLURL:     String("hu"),
+			URL:         Ptr("u"),
+			HTMLURL:     Ptr("hu"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 			DismissedAt: &Timestamp{referenceTime},
 			DismissedBy: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
-			},
-			DismissedReason:  String("dr"),
-			DismissedComment: String("dc"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
+			},
+			DismissedReason:  Ptr("dr"),
+			DismissedComment: Ptr("dc"),
 			FixedAt:          &Timestamp{referenceTime},
 			AutoDismissedAt:  &Timestamp{referenceTime},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Enterprise: &Enterprise{
-			ID:          Int(1),
-			Slug:        String("s"),
-			Name:        String("n"),
-			NodeID:      String("nid"),
-			AvatarURL:   String("au"),
-			Description: String("d"),
-			WebsiteURL:  String("wu"),
-			HTMLURL:     String("hu"),
+			ID:          Ptr(1),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			NodeID:      Ptr("nid"),
+			AvatarURL:   Ptr("au"),
+			Description: Ptr("d"),
+			WebsiteURL:  Ptr("wu"),
+			HTMLURL:     Ptr("hu"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -13193,112 +13195,112 @@ func TestForkEvent_Marshal(t *testing.T) {
 
 	u := &ForkEvent{
 		Forkee: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -13432,15 +13434,15 @@ func TestGitHubAppAuthorizationEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &GitHubAppAuthorizationEvent{}, "{}")
 
 	u := &GitHubAppAuthorizationEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -13464,111 +13466,111 @@ func TestInstallationEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &InstallationEvent{}, "{}")
 
 	u := &InstallationEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Repositories: []*Repository{
 			{
-				ID:   Int64(1),
-				URL:  String("u"),
-				Name: String("n"),
+				ID:   Ptr(int64(1)),
+				URL:  Ptr("u"),
+				Name: Ptr("n"),
 			},
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -13700,24 +13702,24 @@ func TestHeadCommit_Marshal(t *testing.T) {
 	testJSONMarshal(t, &HeadCommit{}, "{}")
 
 	u := &HeadCommit{
-		Message: String("m"),
+		Message: Ptr("m"),
 		Author: &CommitAuthor{
 			Date:  &Timestamp{referenceTime},
-			Name:  String("n"),
-			Email: String("e"),
-			Login: String("u"),
-		},
-		URL:       String("u"),
-		Distinct:  Bool(true),
-		SHA:       String("s"),
-		ID:        String("id"),
-		TreeID:    String("tid"),
+			Name:  Ptr("n"),
+			Email: Ptr("e"),
+			Login: Ptr("u"),
+		},
+		URL:       Ptr("u"),
+		Distinct:  Ptr(true),
+		SHA:       Ptr("s"),
+		ID:        Ptr("id"),
+		TreeID:    Ptr("tid"),
 		Timestamp: &Timestamp{referenceTime},
 		Committer: &CommitAuthor{
 			Date:  &Timestamp{referenceTime},
-			Name:  String("n"),
-			Email: String("e"),
-			Login: String("u"),
+			Name:  Ptr("n"),
+			Email: Ptr("e"),
+			Login: Ptr("u"),
 		},
 		Added:    []string{"a"},
 		Removed:  []string{"r"},
@@ -13762,58 +13764,58 @@ func TestPushEventRepository_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PushEventRepository{}, "{}")
 
 	u := &PushEventRepository{
-		ID:       Int64(1),
-		NodeID:   String("nid"),
-		Name:     String("n"),
-		FullName: String("fn"),
+		ID:       Ptr(int64(1)),
+		NodeID:   Ptr("nid"),
+		Name:     Ptr("n"),
+		FullName: Ptr("fn"),
 		Owner: &User{
-			Login:       String("l"),
-			ID:          Int64(1),
-			AvatarURL:   String("a"),
-			GravatarID:  String("g"),
-			Name:        String("n"),
-			Company:     String("c"),
-			Blog:        String("b"),
-			Location:    String("l"),
-			Email:       String("e"),
-			Hireable:    Bool(true),
-			PublicRepos: Int(1),
-			Followers:   Int(1),
-			Following:   Int(1),
+			Login:       Ptr("l"),
+			ID:          Ptr(int64(1)),
+			AvatarURL:   Ptr("a"),
+			GravatarID:  Ptr("g"),
+			Name:        Ptr("n"),
+			Company:     Ptr("c"),
+			Blog:        Ptr("b"),
+			Location:    Ptr("l"),
+			Email:       Ptr("e"),
+			Hireable:    Ptr(true),
+			PublicRepos: Ptr(1),
+			Followers:   Ptr(1),
+			Following:   Ptr(1),
 			CreatedAt:   &Timestamp{referenceTime},
-			URL:         String("u"),
+			URL:         Ptr("u"),
 		},
-		Private:         Bool(true),
-		Description:     String("d"),
-		Fork:            Bool(true),
+		Private:         Ptr(true),
+		Description:     Ptr("d"),
+		Fork:            Ptr(true),
 		CreatedAt:       &Timestamp{referenceTime},
 		PushedAt:        &Timestamp{referenceTime},
 		UpdatedAt:       &Timestamp{referenceTime},
-		Homepage:        String("h"),
-		PullsURL:        String("p"),
-		Size:            Int(1),
-		StargazersCount: Int(1),
-		WatchersCount:   Int(1),
-		Language:        String("l"),
-		HasIssues:       Bool(true),
-		HasDownloads:    Bool(true),
-		HasWiki:         Bool(true),
-		HasPages:        Bool(true),
-		ForksCount:      Int(1),
-		Archived:        Bool(true),
-		Disabled:        Bool(true),
-		OpenIssuesCount: Int(1),
-		DefaultBranch:   String("d"),
-		MasterBranch:    String("m"),
-		Organization:    String("o"),
-		URL:             String("u"),
-		ArchiveURL:      String("a"),
-		HTMLURL:         String("h"),
-		StatusesURL:     String("s"),
-		GitURL:          String("g"),
-		SSHURL:          String("s"),
-		CloneURL:        String("c"),
-		SVNURL:          String("s"),
+		Homepage:        Ptr("h"),
+		PullsURL:        Ptr("p"),
+		Size:            Ptr(1),
+		StargazersCount: Ptr(1),
+		WatchersCount:   Ptr(1),
+		Language:        Ptr("l"),
+		HasIssues:       Ptr(true),
+		HasDownloads:    Ptr(true),
+		HasWiki:         Ptr(true),
+		HasPages:        Ptr(true),
+		ForksCount:      Ptr(1),
+		Archived:        Ptr(true),
+		Disabled:        Ptr(true),
+		OpenIssuesCount: Ptr(1),
+		DefaultBranch:   Ptr("d"),
+		MasterBranch:    Ptr("m"),
+		Organization:    Ptr("o"),
+		URL:             Ptr("u"),
+		ArchiveURL:      Ptr("a"),
+		HTMLURL:         Ptr("h"),
+		StatusesURL:     Ptr("s"),
+		GitURL:          Ptr("g"),
+		SSHURL:          Ptr("s"),
+		CloneURL:        Ptr("c"),
+		SVNURL:          Ptr("s"),
 		Topics:          []string{"octocat", "api"},
 	}
 
@@ -13880,8 +13882,8 @@ func TestPushEventRepoOwner_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PushEventRepoOwner{}, "{}")
 
 	u := &PushEventRepoOwner{
-		Name:  String("n"),
-		Email: String("e"),
+		Name:  Ptr("n"),
+		Email: Ptr("e"),
 	}
 
 	want := `{
@@ -13896,136 +13898,136 @@ func TestProjectEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectEvent{}, "{}")
 
 	u := &ProjectEvent{
-		Project: &Project{ID: Int64(1)},
-		Action:  String("a"),
+		Project: &Project{ID: Ptr(int64(1))},
+		Action:  Ptr("a"),
 		Changes: &ProjectChange{
-			Name: &ProjectName{From: String("NameFrom")},
-			Body: &ProjectBody{From: String("BodyFrom")},
+			Name: &ProjectName{From: Ptr("NameFrom")},
+			Body: &ProjectBody{From: Ptr("BodyFrom")},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -14188,136 +14190,136 @@ func TestProjectCardEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectCardEvent{}, "{}")
 
 	u := &ProjectCardEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Changes: &ProjectCardChange{
-			Note: &ProjectCardNote{From: String("NoteFrom")},
+			Note: &ProjectCardNote{From: Ptr("NoteFrom")},
 		},
-		AfterID:     Int64(1),
-		ProjectCard: &ProjectCard{ID: Int64(1)},
+		AfterID:     Ptr(int64(1)),
+		ProjectCard: &ProjectCard{ID: Ptr(int64(1))},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -14478,136 +14480,136 @@ func TestProjectColumnEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectColumnEvent{}, "{}")
 
 	u := &ProjectColumnEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Changes: &ProjectColumnChange{
-			Name: &ProjectColumnName{From: String("NameFrom")},
+			Name: &ProjectColumnName{From: Ptr("NameFrom")},
 		},
-		AfterID:       Int64(1),
-		ProjectColumn: &ProjectColumn{ID: Int64(1)},
+		AfterID:       Ptr(int64(1)),
+		ProjectColumn: &ProjectColumn{ID: Ptr(int64(1))},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -14768,101 +14770,101 @@ func TestProjectV2Event_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectV2Event{}, "{}")
 
 	u := &ProjectV2Event{
-		Action: String("a"),
+		Action: Ptr("a"),
 		ProjectsV2: &ProjectsV2{
-			ID:     Int64(1),
-			NodeID: String("nid"),
+			ID:     Ptr(int64(1)),
+			NodeID: Ptr("nid"),
 			Owner: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 			Creator: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
-			},
-			Title:            String("t"),
-			Description:      String("d"),
-			Public:           Bool(true),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
+			},
+			Title:            Ptr("t"),
+			Description:      Ptr("d"),
+			Public:           Ptr(true),
 			ClosedAt:         &Timestamp{referenceTime},
 			CreatedAt:        &Timestamp{referenceTime},
 			UpdatedAt:        &Timestamp{referenceTime},
 			DeletedAt:        &Timestamp{referenceTime},
-			Number:           Int(1),
-			ShortDescription: String("sd"),
+			Number:           Ptr(1),
+			ShortDescription: Ptr("sd"),
 			DeletedBy: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -14978,7 +14980,7 @@ func TestProjectV2ItemEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ProjectV2ItemEvent{}, "{}")
 
 	u := &ProjectV2ItemEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Changes: &ProjectV2ItemChange{
 			ArchivedAt: &ArchivedAt{
 				From: &Timestamp{referenceTime},
@@ -14986,78 +14988,78 @@ func TestProjectV2ItemEvent_Marshal(t *testing.T) {
 			},
 		},
 		ProjectV2Item: &ProjectV2Item{
-			ID:            Int64(1),
-			NodeID:        String("nid"),
-			ProjectNodeID: String("pnid"),
-			ContentNodeID: String("cnid"),
-			ContentType:   String("ct"),
+			ID:            Ptr(int64(1)),
+			NodeID:        Ptr("nid"),
+			ProjectNodeID: Ptr("pnid"),
+			ContentNodeID: Ptr("cnid"),
+			ContentType:   Ptr("ct"),
 			Creator: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 			CreatedAt:  &Timestamp{referenceTime},
 			UpdatedAt:  &Timestamp{referenceTime},
 			ArchivedAt: &Timestamp{referenceTime},
 		},
 		Org: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -15158,180 +15160,180 @@ func TestPullRequestEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestEvent{}, "{}")
 
 	u := &PullRequestEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Assignee: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
-		},
-		Number:      Int(1),
-		PullRequest: &PullRequest{ID: Int64(1)},
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
+		},
+		Number:      Ptr(1),
+		PullRequest: &PullRequest{ID: Ptr(int64(1))},
 		Changes: &EditChange{
 			Title: &EditTitle{
-				From: String("TitleFrom"),
+				From: Ptr("TitleFrom"),
 			},
 			Body: &EditBody{
-				From: String("BodyFrom"),
+				From: Ptr("BodyFrom"),
 			},
 			Base: &EditBase{
 				Ref: &EditRef{
-					From: String("BaseRefFrom"),
+					From: Ptr("BaseRefFrom"),
 				},
 				SHA: &EditSHA{
-					From: String("BaseSHAFrom"),
+					From: Ptr("BaseSHAFrom"),
 				},
 			},
 		},
 		RequestedReviewer: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
-		},
-		RequestedTeam: &Team{ID: Int64(1)},
-		Label:         &Label{ID: Int64(1)},
-		Before:        String("before"),
-		After:         String("after"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
+		},
+		RequestedTeam: &Team{ID: Ptr(int64(1))},
+		Label:         &Label{ID: Ptr(int64(1))},
+		Before:        Ptr("before"),
+		After:         Ptr("after"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		PerformedViaGithubApp: &App{
-			ID:          Int64(1),
-			NodeID:      String("n"),
-			Slug:        String("s"),
-			Name:        String("n"),
-			Description: String("d"),
-			ExternalURL: String("e"),
-			HTMLURL:     String("h"),
+			ID:          Ptr(int64(1)),
+			NodeID:      Ptr("n"),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			Description: Ptr("d"),
+			ExternalURL: Ptr("e"),
+			HTMLURL:     Ptr("h"),
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -15538,127 +15540,127 @@ func TestPullRequestReviewCommentEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestReviewCommentEvent{}, "{}")
 
 	u := &PullRequestReviewCommentEvent{
-		Action:      String("a"),
-		PullRequest: &PullRequest{ID: Int64(1)},
-		Comment:     &PullRequestComment{ID: Int64(1)},
+		Action:      Ptr("a"),
+		PullRequest: &PullRequest{ID: Ptr(int64(1))},
+		Comment:     &PullRequestComment{ID: Ptr(int64(1))},
 		Changes: &EditChange{
 			Title: &EditTitle{
-				From: String("TitleFrom"),
+				From: Ptr("TitleFrom"),
 			},
 			Body: &EditBody{
-				From: String("BodyFrom"),
+				From: Ptr("BodyFrom"),
 			},
 			Base: &EditBase{
 				Ref: &EditRef{
-					From: String("BaseRefFrom"),
+					From: Ptr("BaseRefFrom"),
 				},
 				SHA: &EditSHA{
-					From: String("BaseSHAFrom"),
+					From: Ptr("BaseSHAFrom"),
 				},
 			},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -15810,113 +15812,113 @@ func TestPullRequestReviewThreadEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestReviewThreadEvent{}, "{}")
 
 	u := &PullRequestReviewThreadEvent{
-		Action:      String("a"),
-		PullRequest: &PullRequest{ID: Int64(1)},
+		Action:      Ptr("a"),
+		PullRequest: &PullRequest{ID: Ptr(int64(1))},
 		Thread: &PullRequestThread{
-			Comments: []*PullRequestComment{{ID: Int64(1)}, {ID: Int64(2)}},
+			Comments: []*PullRequestComment{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -16059,180 +16061,180 @@ func TestPullRequestTargetEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestTargetEvent{}, "{}")
 
 	u := &PullRequestTargetEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Assignee: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
-		},
-		Number:      Int(1),
-		PullRequest: &PullRequest{ID: Int64(1)},
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
+		},
+		Number:      Ptr(1),
+		PullRequest: &PullRequest{ID: Ptr(int64(1))},
 		Changes: &EditChange{
 			Title: &EditTitle{
-				From: String("TitleFrom"),
+				From: Ptr("TitleFrom"),
 			},
 			Body: &EditBody{
-				From: String("BodyFrom"),
+				From: Ptr("BodyFrom"),
 			},
 			Base: &EditBase{
 				Ref: &EditRef{
-					From: String("BaseRefFrom"),
+					From: Ptr("BaseRefFrom"),
 				},
 				SHA: &EditSHA{
-					From: String("BaseSHAFrom"),
+					From: Ptr("BaseSHAFrom"),
 				},
 			},
 		},
 		RequestedReviewer: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
-		},
-		RequestedTeam: &Team{ID: Int64(1)},
-		Label:         &Label{ID: Int64(1)},
-		Before:        String("before"),
-		After:         String("after"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
+		},
+		RequestedTeam: &Team{ID: Ptr(int64(1))},
+		Label:         &Label{ID: Ptr(int64(1))},
+		Before:        Ptr("before"),
+		After:         Ptr("after"),
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		PerformedViaGithubApp: &App{
-			ID:          Int64(1),
-			NodeID:      String("n"),
-			Slug:        String("s"),
-			Name:        String("n"),
-			Description: String("d"),
-			ExternalURL: String("e"),
-			HTMLURL:     String("h"),
+			ID:          Ptr(int64(1)),
+			NodeID:      Ptr("n"),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			Description: Ptr("d"),
+			ExternalURL: Ptr("e"),
+			HTMLURL:     Ptr("h"),
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -16439,30 +16441,30 @@ func TestRepositoryVulnerabilityAlertEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepositoryVulnerabilityAlertEvent{}, "{}")
 
 	u := &RepositoryVulnerabilityAlertEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Alert: &RepositoryVulnerabilityAlert{
-			ID:                  Int64(1),
-			AffectedRange:       String("ar"),
-			AffectedPackageName: String("apn"),
-			ExternalReference:   String("er"),
-			ExternalIdentifier:  String("ei"),
-			FixedIn:             String("fi"),
+			ID:                  Ptr(int64(1)),
+			AffectedRange:       Ptr("ar"),
+			AffectedPackageName: Ptr("apn"),
+			ExternalReference:   Ptr("er"),
+			ExternalIdentifier:  Ptr("ei"),
+			FixedIn:             Ptr("fi"),
 			Dismisser: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
-			},
-			DismissReason: String("dr"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
+			},
+			DismissReason: Ptr("dr"),
 			DismissedAt:   &Timestamp{referenceTime},
 		},
 		Repository: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 	}
 
@@ -16501,158 +16503,158 @@ func TestSecretScanningAlertEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &SecretScanningAlertEvent{}, "{}")
 
 	u := &SecretScanningAlertEvent{
-		Action: String("a"),
+		Action: Ptr("a"),
 		Alert: &SecretScanningAlert{
-			Number:     Int(1),
-			SecretType: String("t"),
-			Resolution: String("r"),
+			Number:     Ptr(1),
+			SecretType: Ptr("t"),
+			Resolution: Ptr("r"),
 			ResolvedBy: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
 			ResolvedAt: &Timestamp{referenceTime},
 		},
 		Repo: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Enterprise: &Enterprise{
-			ID:          Int(1),
-			Slug:        String("s"),
-			Name:        String("n"),
-			NodeID:      String("nid"),
-			AvatarURL:   String("au"),
-			Description: String("d"),
-			WebsiteURL:  String("wu"),
-			HTMLURL:     String("hu"),
+			ID:          Ptr(1),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			NodeID:      Ptr("nid"),
+			AvatarURL:   Ptr("au"),
+			Description: Ptr("d"),
+			WebsiteURL:  Ptr("wu"),
+			HTMLURL:     Ptr("hu"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
@@ -16830,31 +16832,31 @@ func TestSecretScanningAlertEvent_Marshal(t *testing.T) {
 func TestSecurityAdvisoryEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &SecurityAdvisoryEvent{}, "{}")
 	u := &SecurityAdvisoryEvent{
-		Action: String("published"),
+		Action: Ptr("published"),
 		SecurityAdvisory: &SecurityAdvisory{
 			CVSS: &AdvisoryCVSS{
-				Score:        Float64(1.0),
-				VectorString: String("vs"),
+				Score:        Ptr(1.0),
+				VectorString: Ptr("vs"),
 			},
 			CWEs: []*AdvisoryCWEs{
 				{
-					CWEID: String("cweid"),
-					Name:  String("n"),
+					CWEID: Ptr("cweid"),
+					Name:  Ptr("n"),
 				},
 			},
-			GHSAID:      String("GHSA-rf4j-j272-some"),
-			Summary:     String("Siuuuuuuuuu"),
-			Description: String("desc"),
-			Severity:    String("moderate"),
+			GHSAID:      Ptr("GHSA-rf4j-j272-some"),
+			Summary:     Ptr("Siuuuuuuuuu"),
+			Description: Ptr("desc"),
+			Severity:    Ptr("moderate"),
 			Identifiers: []*AdvisoryIdentifier{
 				{
-					Value: String("GHSA-rf4j-j272-some"),
-					Type:  String("GHSA"),
+					Value: Ptr("GHSA-rf4j-j272-some"),
+					Type:  Ptr("GHSA"),
 				},
 			},
 			References: []*AdvisoryReference{
 				{
-					URL: String("https://some-url"),
+					URL: Ptr("https://some-url"),
 				},
 			},
 			PublishedAt: &Timestamp{referenceTime},
@@ -16863,157 +16865,157 @@ func TestSecurityAdvisoryEvent_Marshal(t *testing.T) {
 			Vulnerabilities: []*AdvisoryVulnerability{
 				{
 					Package: &VulnerabilityPackage{
-						Ecosystem: String("ucl"),
-						Name:      String("penaldo"),
+						Ecosystem: Ptr("ucl"),
+						Name:      Ptr("penaldo"),
 					},
-					Severity:               String("moderate"),
-					VulnerableVersionRange: String(">= 2.0.0, < 2.0.2"),
+					Severity:               Ptr("moderate"),
+					VulnerableVersionRange: Ptr(">= 2.0.0, < 2.0.2"),
 					FirstPatchedVersion: &FirstPatchedVersion{
-						Identifier: String("2.0.2"),
+						Identifier: Ptr("2.0.2"),
 					},
 				},
 			},
 		},
 		Enterprise: &Enterprise{
-			ID:          Int(1),
-			Slug:        String("s"),
-			Name:        String("n"),
-			NodeID:      String("nid"),
-			AvatarURL:   String("au"),
-			Description: String("d"),
-			WebsiteURL:  String("wu"),
-			HTMLURL:     String("hu"),
+			ID:          Ptr(1),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			NodeID:      Ptr("nid"),
+			AvatarURL:   Ptr("au"),
+			Description: Ptr("d"),
+			WebsiteURL:  Ptr("wu"),
+			HTMLURL:     Ptr("hu"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 			SuspendedAt: &Timestamp{referenceTime},
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Repository: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -17220,160 +17222,160 @@ func TestSecurityAndAnalysisEvent_Marshal(t *testing.T) {
 			From: &SecurityAndAnalysisChangeFrom{
 				SecurityAndAnalysis: &SecurityAndAnalysis{
 					AdvancedSecurity: &AdvancedSecurity{
-						Status: String("enabled"),
+						Status: Ptr("enabled"),
 					},
 					SecretScanning: &SecretScanning{
-						Status: String("enabled"),
+						Status: Ptr("enabled"),
 					},
 					SecretScanningPushProtection: &SecretScanningPushProtection{
-						Status: String("enabled"),
+						Status: Ptr("enabled"),
 					},
 					DependabotSecurityUpdates: &DependabotSecurityUpdates{
-						Status: String("enabled"),
+						Status: Ptr("enabled"),
 					},
 				},
 			},
 		},
 		Enterprise: &Enterprise{
-			ID:          Int(1),
-			Slug:        String("s"),
-			Name:        String("n"),
-			NodeID:      String("nid"),
-			AvatarURL:   String("au"),
-			Description: String("d"),
-			WebsiteURL:  String("wu"),
-			HTMLURL:     String("hu"),
+			ID:          Ptr(1),
+			Slug:        Ptr("s"),
+			Name:        Ptr("n"),
+			NodeID:      Ptr("nid"),
+			AvatarURL:   Ptr("au"),
+			Description: Ptr("d"),
+			WebsiteURL:  Ptr("wu"),
+			HTMLURL:     Ptr("hu"),
 			CreatedAt:   &Timestamp{referenceTime},
 			UpdatedAt:   &Timestamp{referenceTime},
 		},
 		Installation: &Installation{
-			ID:       Int64(1),
-			NodeID:   String("nid"),
-			AppID:    Int64(1),
-			AppSlug:  String("as"),
-			TargetID: Int64(1),
+			ID:       Ptr(int64(1)),
+			NodeID:   Ptr("nid"),
+			AppID:    Ptr(int64(1)),
+			AppSlug:  Ptr("as"),
+			TargetID: Ptr(int64(1)),
 			Account: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			AccessTokensURL:     String("atu"),
-			RepositoriesURL:     String("ru"),
-			HTMLURL:             String("hu"),
-			TargetType:          String("tt"),
-			SingleFileName:      String("sfn"),
-			RepositorySelection: String("rs"),
+			AccessTokensURL:     Ptr("atu"),
+			RepositoriesURL:     Ptr("ru"),
+			HTMLURL:             Ptr("hu"),
+			TargetType:          Ptr("tt"),
+			SingleFileName:      Ptr("sfn"),
+			RepositorySelection: Ptr("rs"),
 			Events:              []string{"e"},
 			SingleFilePaths:     []string{"s"},
 			Permissions: &InstallationPermissions{
-				Actions:                       String("a"),
-				Administration:                String("ad"),
-				Checks:                        String("c"),
-				Contents:                      String("co"),
-				ContentReferences:             String("cr"),
-				Deployments:                   String("d"),
-				Environments:                  String("e"),
-				Issues:                        String("i"),
-				Metadata:                      String("md"),
-				Members:                       String("m"),
-				OrganizationAdministration:    String("oa"),
-				OrganizationHooks:             String("oh"),
-				OrganizationPlan:              String("op"),
-				OrganizationPreReceiveHooks:   String("opr"),
-				OrganizationProjects:          String("op"),
-				OrganizationSecrets:           String("os"),
-				OrganizationSelfHostedRunners: String("osh"),
-				OrganizationUserBlocking:      String("oub"),
-				Packages:                      String("pkg"),
-				Pages:                         String("pg"),
-				PullRequests:                  String("pr"),
-				RepositoryHooks:               String("rh"),
-				RepositoryProjects:            String("rp"),
-				RepositoryPreReceiveHooks:     String("rprh"),
-				Secrets:                       String("s"),
-				SecretScanningAlerts:          String("ssa"),
-				SecurityEvents:                String("se"),
-				SingleFile:                    String("sf"),
-				Statuses:                      String("s"),
-				TeamDiscussions:               String("td"),
-				VulnerabilityAlerts:           String("va"),
-				Workflows:                     String("w"),
+				Actions:                       Ptr("a"),
+				Administration:                Ptr("ad"),
+				Checks:                        Ptr("c"),
+				Contents:                      Ptr("co"),
+				ContentReferences:             Ptr("cr"),
+				Deployments:                   Ptr("d"),
+				Environments:                  Ptr("e"),
+				Issues:                        Ptr("i"),
+				Metadata:                      Ptr("md"),
+				Members:                       Ptr("m"),
+				OrganizationAdministration:    Ptr("oa"),
+				OrganizationHooks:             Ptr("oh"),
+				OrganizationPlan:              Ptr("op"),
+				OrganizationPreReceiveHooks:   Ptr("opr"),
+				OrganizationProjects:          Ptr("op"),
+				OrganizationSecrets:           Ptr("os"),
+				OrganizationSelfHostedRunners: Ptr("osh"),
+				OrganizationUserBlocking:      Ptr("oub"),
+				Packages:                      Ptr("pkg"),
+				Pages:                         Ptr("pg"),
+				PullRequests:                  Ptr("pr"),
+				RepositoryHooks:               Ptr("rh"),
+				RepositoryProjects:            Ptr("rp"),
+				RepositoryPreReceiveHooks:     Ptr("rprh"),
+				Secrets:                       Ptr("s"),
+				SecretScanningAlerts:          Ptr("ssa"),
+				SecurityEvents:                Ptr("se"),
+				SingleFile:                    Ptr("sf"),
+				Statuses:                      Ptr("s"),
+				TeamDiscussions:               Ptr("td"),
+				VulnerabilityAlerts:           Ptr("va"),
+				Workflows:                     Ptr("w"),
 			},
 			CreatedAt:              &Timestamp{referenceTime},
 			UpdatedAt:              &Timestamp{referenceTime},
-			HasMultipleSingleFiles: Bool(false),
+			HasMultipleSingleFiles: Ptr(false),
 			SuspendedBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 			SuspendedAt: &Timestamp{referenceTime},
 		},
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		Repository: &Repository{
-			ID:   Int64(1),
-			URL:  String("s"),
-			Name: String("n"),
+			ID:   Ptr(int64(1)),
+			URL:  Ptr("s"),
+			Name: Ptr("n"),
 		},
 		Sender: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -17551,167 +17553,167 @@ func TestCodeScanningAlertEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CodeScanningAlertEvent{}, "{}")
 
 	u := &CodeScanningAlertEvent{
-		Action: String("reopened"),
+		Action: Ptr("reopened"),
 		Alert: &Alert{
-			Number: Int(10),
+			Number: Ptr(10),
 			Rule: &Rule{
-				ID:              String("Style/FrozenStringLiteralComment"),
-				Severity:        String("note"),
-				Description:     String("desc"),
-				FullDescription: String("full desc"),
+				ID:              Ptr("Style/FrozenStringLiteralComment"),
+				Severity:        Ptr("note"),
+				Description:     Ptr("desc"),
+				FullDescription: Ptr("full desc"),
 				Tags:            []string{"style"},
-				Help:            String("help"),
+				Help:            Ptr("help"),
 			},
 			Tool: &Tool{
-				Name:    String("Rubocop"),
+				Name:    Ptr("Rubocop"),
 				Version: nil,
 			},
 			CreatedAt: &Timestamp{referenceTime},
 			UpdatedAt: &Timestamp{referenceTime},
 			FixedAt:   nil,
-			State:     String("open"),
-			URL:       String("a"),
-			HTMLURL:   String("a"),
+			State:     Ptr("open"),
+			URL:       Ptr("a"),
+			HTMLURL:   Ptr("a"),
 			Instances: []*MostRecentInstance{
 				{
-					Ref:         String("refs/heads/main"),
-					AnalysisKey: String(".github/workflows/workflow.yml:upload"),
-					Environment: String("{}"),
-					State:       String("open"),
+					Ref:         Ptr("refs/heads/main"),
+					AnalysisKey: Ptr(".github/workflows/workflow.yml:upload"),
+					Environment: Ptr("{}"),
+					State:       Ptr("open"),
 				},
 			},
 			DismissedBy:     nil,
 			DismissedAt:     nil,
 			DismissedReason: nil,
 		},
-		Ref:       String("refs/heads/main"),
-		CommitOID: String("d6e4c75c141dbacecc279b721b8bsomeSHA"),
+		Ref:       Ptr("refs/heads/main"),
+		CommitOID: Ptr("d6e4c75c141dbacecc279b721b8bsomeSHA"),
 		Repo: &Repository{
-			ID:     Int64(1234234535),
-			NodeID: String("MDEwOlJlcG9zaXRvcnkxODY4NT=="),
+			ID:     Ptr(int64(1234234535)),
+			NodeID: Ptr("MDEwOlJlcG9zaXRvcnkxODY4NT=="),
 			Owner: &User{
-				Login:             String("Codertocat"),
-				ID:                Int64(21031067),
-				NodeID:            String("MDQ6VXNlcjIxMDMxMDY3"),
-				AvatarURL:         String("a"),
-				GravatarID:        String(""),
-				URL:               String("a"),
-				HTMLURL:           String("a"),
-				Type:              String("User"),
-				SiteAdmin:         Bool(false),
-				FollowersURL:      String("a"),
-				FollowingURL:      String("a"),
-				EventsURL:         String("a"),
-				GistsURL:          String("a"),
-				OrganizationsURL:  String("a"),
-				ReceivedEventsURL: String("a"),
-				ReposURL:          String("a"),
-				StarredURL:        String("a"),
-				SubscriptionsURL:  String("a"),
-			},
-			HTMLURL:          String("a"),
-			Name:             String("Hello-World"),
-			FullName:         String("Codertocat/Hello-World"),
+				Login:             Ptr("Codertocat"),
+				ID:                Ptr(int64(21031067)),
+				NodeID:            Ptr("MDQ6VXNlcjIxMDMxMDY3"),
+				AvatarURL:         Ptr("a"),
+				GravatarID:        Ptr(""),
+				URL:               Ptr("a"),
+				HTMLURL:           Ptr("a"),
+				Type:              Ptr("User"),
+				SiteAdmin:         Ptr(false),
+				FollowersURL:      Ptr("a"),
+				FollowingURL:      Ptr("a"),
+				EventsURL:         Ptr("a"),
+				GistsURL:          Ptr("a"),
+				OrganizationsURL:  Ptr("a"),
+				ReceivedEventsURL: Ptr("a"),
+				ReposURL:          Ptr("a"),
+				StarredURL:        Ptr("a"),
+				SubscriptionsURL:  Ptr("a"),
+			},
+			HTMLURL:          Ptr("a"),
+			Name:             Ptr("Hello-World"),
+			FullName:         Ptr("Codertocat/Hello-World"),
 			Description:      nil,
-			Fork:             Bool(false),
+			Fork:             Ptr(false),
 			Homepage:         nil,
-			DefaultBranch:    String("main"),
+			DefaultBranch:    Ptr("main"),
 			CreatedAt:        &Timestamp{referenceTime},
 			PushedAt:         &Timestamp{referenceTime},
 			UpdatedAt:        &Timestamp{referenceTime},
-			CloneURL:         String("a"),
-			GitURL:           String("a"),
+			CloneURL:         Ptr("a"),
+			GitURL:           Ptr("a"),
 			MirrorURL:        nil,
-			SSHURL:           String("a"),
-			SVNURL:           String("a"),
+			SSHURL:           Ptr("a"),
+			SVNURL:           Ptr("a"),
 			Language:         nil,
-			ForksCount:       Int(0),
-			OpenIssuesCount:  Int(2),
-			OpenIssues:       Int(2),
-			StargazersCount:  Int(0),
-			WatchersCount:    Int(0),
-			Watchers:         Int(0),
-			Size:             Int(0),
-			Archived:         Bool(false),
-			Disabled:         Bool(false),
+			ForksCount:       Ptr(0),
+			OpenIssuesCount:  Ptr(2),
+			OpenIssues:       Ptr(2),
+			StargazersCount:  Ptr(0),
+			WatchersCount:    Ptr(0),
+			Watchers:         Ptr(0),
+			Size:             Ptr(0),
+			Archived:         Ptr(false),
+			Disabled:         Ptr(false),
 			License:          nil,
-			Private:          Bool(false),
-			HasIssues:        Bool(true),
-			HasWiki:          Bool(true),
-			HasPages:         Bool(true),
-			HasProjects:      Bool(true),
-			HasDownloads:     Bool(true),
-			URL:              String("a"),
-			ArchiveURL:       String("a"),
-			AssigneesURL:     String("a"),
-			BlobsURL:         String("a"),
-			BranchesURL:      String("a"),
-			CollaboratorsURL: String("a"),
-			CommentsURL:      String("a"),
-			CommitsURL:       String("a"),
-			CompareURL:       String("a"),
-			ContentsURL:      String("a"),
-			ContributorsURL:  String("a"),
-			DeploymentsURL:   String("a"),
-			DownloadsURL:     String("a"),
-			EventsURL:        String("a"),
-			ForksURL:         String("a"),
-			GitCommitsURL:    String("a"),
-			GitRefsURL:       String("a"),
-			GitTagsURL:       String("a"),
-			HooksURL:         String("a"),
-			IssueCommentURL:  String("a"),
-			IssueEventsURL:   String("a"),
-			IssuesURL:        String("a"),
-			KeysURL:          String("a"),
-			LabelsURL:        String("a"),
-			LanguagesURL:     String("a"),
-			MergesURL:        String("a"),
-			MilestonesURL:    String("a"),
-			NotificationsURL: String("a"),
-			PullsURL:         String("a"),
-			ReleasesURL:      String("a"),
-			StargazersURL:    String("a"),
-			StatusesURL:      String("a"),
-			SubscribersURL:   String("a"),
-			SubscriptionURL:  String("a"),
-			TagsURL:          String("a"),
-			TreesURL:         String("a"),
-			TeamsURL:         String("a"),
+			Private:          Ptr(false),
+			HasIssues:        Ptr(true),
+			HasWiki:          Ptr(true),
+			HasPages:         Ptr(true),
+			HasProjects:      Ptr(true),
+			HasDownloads:     Ptr(true),
+			URL:              Ptr("a"),
+			ArchiveURL:       Ptr("a"),
+			AssigneesURL:     Ptr("a"),
+			BlobsURL:         Ptr("a"),
+			BranchesURL:      Ptr("a"),
+			CollaboratorsURL: Ptr("a"),
+			CommentsURL:      Ptr("a"),
+			CommitsURL:       Ptr("a"),
+			CompareURL:       Ptr("a"),
+			ContentsURL:      Ptr("a"),
+			ContributorsURL:  Ptr("a"),
+			DeploymentsURL:   Ptr("a"),
+			DownloadsURL:     Ptr("a"),
+			EventsURL:        Ptr("a"),
+			ForksURL:         Ptr("a"),
+			GitCommitsURL:    Ptr("a"),
+			GitRefsURL:       Ptr("a"),
+			GitTagsURL:       Ptr("a"),
+			HooksURL:         Ptr("a"),
+			IssueCommentURL:  Ptr("a"),
+			IssueEventsURL:   Ptr("a"),
+			IssuesURL:        Ptr("a"),
+			KeysURL:          Ptr("a"),
+			LabelsURL:        Ptr("a"),
+			LanguagesURL:     Ptr("a"),
+			MergesURL:        Ptr("a"),
+			MilestonesURL:    Ptr("a"),
+			NotificationsURL: Ptr("a"),
+			PullsURL:         Ptr("a"),
+			ReleasesURL:      Ptr("a"),
+			StargazersURL:    Ptr("a"),
+			StatusesURL:      Ptr("a"),
+			SubscribersURL:   Ptr("a"),
+			SubscriptionURL:  Ptr("a"),
+			TagsURL:          Ptr("a"),
+			TreesURL:         Ptr("a"),
+			TeamsURL:         Ptr("a"),
 		},
 		Org: &Organization{
-			Login:            String("Octocoders"),
-			ID:               Int64(6),
-			NodeID:           String("MDEyOk9yZ2FuaXphdGlvbjY="),
-			AvatarURL:        String("a"),
-			Description:      String(""),
-			URL:              String("a"),
-			EventsURL:        String("a"),
-			HooksURL:         String("a"),
-			IssuesURL:        String("a"),
-			MembersURL:       String("a"),
-			PublicMembersURL: String("a"),
-			ReposURL:         String("a"),
+			Login:            Ptr("Octocoders"),
+			ID:               Ptr(int64(6)),
+			NodeID:           Ptr("MDEyOk9yZ2FuaXphdGlvbjY="),
+			AvatarURL:        Ptr("a"),
+			Description:      Ptr(""),
+			URL:              Ptr("a"),
+			EventsURL:        Ptr("a"),
+			HooksURL:         Ptr("a"),
+			IssuesURL:        Ptr("a"),
+			MembersURL:       Ptr("a"),
+			PublicMembersURL: Ptr("a"),
+			ReposURL:         Ptr("a"),
 		},
 		Sender: &User{
-			Login:             String("github"),
-			ID:                Int64(9919),
-			NodeID:            String("MDEyOk9yZ2FuaXphdGlvbjk5MTk="),
-			AvatarURL:         String("a"),
-			HTMLURL:           String("a"),
-			GravatarID:        String(""),
-			Type:              String("Organization"),
-			SiteAdmin:         Bool(false),
-			URL:               String("a"),
-			EventsURL:         String("a"),
-			FollowingURL:      String("a"),
-			FollowersURL:      String("a"),
-			GistsURL:          String("a"),
-			OrganizationsURL:  String("a"),
-			ReceivedEventsURL: String("a"),
-			ReposURL:          String("a"),
-			StarredURL:        String("a"),
-			SubscriptionsURL:  String("a"),
+			Login:             Ptr("github"),
+			ID:                Ptr(int64(9919)),
+			NodeID:            Ptr("MDEyOk9yZ2FuaXphdGlvbjk5MTk="),
+			AvatarURL:         Ptr("a"),
+			HTMLURL:           Ptr("a"),
+			GravatarID:        Ptr(""),
+			Type:              Ptr("Organization"),
+			SiteAdmin:         Ptr(false),
+			URL:               Ptr("a"),
+			EventsURL:         Ptr("a"),
+			FollowingURL:      Ptr("a"),
+			FollowersURL:      Ptr("a"),
+			GistsURL:          Ptr("a"),
+			OrganizationsURL:  Ptr("a"),
+			ReceivedEventsURL: Ptr("a"),
+			ReposURL:          Ptr("a"),
+			StarredURL:        Ptr("a"),
+			SubscriptionsURL:  Ptr("a"),
 		},
 	}
 
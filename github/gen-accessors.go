@@ -80,6 +80,11 @@ func main() {
 			Year:     2017,
 			Package:  pkgName,
 			Imports:  map[string]string{},
+
+			stringTypes: map[string]bool{},
+		}
+		for _, f := range pkg.Files {
+			t.collectStringTypes(f)
 		}
 		for filename, f := range pkg.Files {
 			logf("Processing %v...", filename)
@@ -94,6 +99,23 @@ func main() {
 	logf("Done.")
 }
 
+// collectStringTypes records the named types in f that are defined as string.
+func (t *templateData) collectStringTypes(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if ts, ok := spec.(*ast.TypeSpec); ok {
+				if id, ok := ts.Type.(*ast.Ident); ok && id.Name == "string" {
+					t.stringTypes[ts.Name.Name] = true
+				}
+			}
+		}
+	}
+}
+
 func (t *templateData) processAST(f *ast.File) error {
 	for _, decl := range f.Decls {
 		gd, ok := decl.(*ast.GenDecl)
@@ -264,6 +286,10 @@ func (t *templateData) addIdent(x *ast.Ident, receiverType, fieldName string) {
 	case "Timestamp":
 		zeroValue = "Timestamp{}"
 	default:
+		if t.stringTypes[x.Name] {
+			zeroValue = `""`
+			break
+		}
 		zeroValue = "nil"
 		namedStruct = true
 	}
@@ -331,6 +357,8 @@ type templateData struct {
 	Package  string
 	Imports  map[string]string
 	Getters  []*getter
+
+	stringTypes map[string]bool // Named types whose underlying type is string.
 }
 
 type getter struct {
@@ -0,0 +1,177 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+// gen-ghes-operations generates the table of REST API operations that are
+// available in GitHub Enterprise Server, which is used by clients configured
+// with WithGHESVersion to reject requests for unsupported endpoints.
+//
+// It reads the openapi_operations section of ../openapi_operations.yaml,
+// which is maintained by script/metadata.sh. For each operation, that file
+// lists the newest and the oldest GitHub Enterprise Server descriptions that
+// include it.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	inputFile  = "../openapi_operations.yaml"
+	outputFile = "github-ghes-operations.go"
+)
+
+var (
+	nameRE     = regexp.MustCompile(`^  - name: (.+)$`)
+	ghesFileRE = regexp.MustCompile(`descriptions/ghes-(\d+)\.(\d+)/`)
+)
+
+type version struct {
+	major, minor int
+}
+
+func (v version) less(o version) bool {
+	return v.major < o.major || (v.major == o.major && v.minor < o.minor)
+}
+
+func (v version) String() string {
+	return fmt.Sprintf("%v.%v", v.major, v.minor)
+}
+
+// operation records the GitHub Enterprise Server descriptions that include
+// an operation. oldest and newest are the zero version if there are none.
+type operation struct {
+	oldest, newest version
+}
+
+func main() {
+	f, err := os.Open(inputFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	ops := map[string]*operation{}
+	var inSection bool
+	var current *operation
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, " ") {
+			inSection = line == "openapi_operations:"
+			current = nil
+			continue
+		}
+		if !inSection {
+			continue
+		}
+		if m := nameRE.FindStringSubmatch(line); m != nil {
+			current = &operation{}
+			ops[m[1]] = current
+			continue
+		}
+		if m := ghesFileRE.FindStringSubmatch(line); m != nil && current != nil {
+			major, _ := strconv.Atoi(m[1])
+			minor, _ := strconv.Atoi(m[2])
+			v := version{major, minor}
+			if current.newest == (version{}) || current.newest.less(v) {
+				current.newest = v
+			}
+			if current.oldest == (version{}) || v.less(current.oldest) {
+				current.oldest = v
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if len(ops) == 0 {
+		log.Fatalf("no operations found in %v", inputFile)
+	}
+
+	names := make([]string, 0, len(ops))
+	var newest version
+	for name, op := range ops {
+		names = append(names, name)
+		if newest.less(op.newest) {
+			newest = op.newest
+		}
+	}
+	sort.Strings(names)
+
+	// The descriptions of older releases are only kept for operations that
+	// have since been removed, so the oldest current description is the
+	// oldest release that has any of the operations in the newest one.
+	oldest := newest
+	for _, op := range ops {
+		if op.newest == newest && op.oldest.less(oldest) {
+			oldest = op.oldest
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, `// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-ghes-operations; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+`)
+	fmt.Fprint(&buf, "// The oldest and newest GitHub Enterprise Server versions described by\n")
+	fmt.Fprint(&buf, "// openapi_operations.yaml.\n")
+	fmt.Fprint(&buf, "const (\n")
+	fmt.Fprintf(&buf, "\tghesOldestDescribedVersion = %q\n", oldest)
+	fmt.Fprintf(&buf, "\tghesNewestDescribedVersion = %q\n", newest)
+	fmt.Fprint(&buf, ")\n\n")
+
+	fmt.Fprint(&buf, "// ghesOperations lists the documented operations that are available in\n")
+	fmt.Fprint(&buf, "// GitHub Enterprise Server, with the oldest and newest described versions\n")
+	fmt.Fprint(&buf, "// that have them.\n")
+	fmt.Fprint(&buf, "var ghesOperations = []ghesOperation{\n")
+	for _, name := range names {
+		if op := ops[name]; op.newest != (version{}) {
+			fmt.Fprintf(&buf, "\t{%q, %q, %q},\n", name, op.oldest, op.newest)
+		}
+	}
+	fmt.Fprint(&buf, "}\n\n")
+
+	fmt.Fprint(&buf, "// nonGHESOperations lists the documented operations that are not available\n")
+	fmt.Fprint(&buf, "// in GitHub Enterprise Server.\n")
+	fmt.Fprint(&buf, "var nonGHESOperations = []string{\n")
+	for _, name := range names {
+		if ops[name].newest == (version{}) {
+			fmt.Fprintf(&buf, "\t%q,\n", name)
+		}
+	}
+	fmt.Fprint(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -43,7 +43,8 @@ var (
 	skipStructMethods = map[string]bool{}
 	// skipStructs lists structs to skip.
 	skipStructs = map[string]bool{
-		"RateLimits": true,
+		"RateLimits":  true,
+		"SearchQuery": true,
 	}
 
 	funcMap = template.FuncMap{
@@ -55,13 +56,13 @@ var (
 		},
 		"processZeroValue": func(v string) string {
 			switch v {
-			case "Bool(false)":
+			case "Ptr(false)":
 				return "false"
-			case "Float64(0.0)":
+			case "Ptr(0.0)":
 				return "0"
-			case "0", "Int(0)", "Int64(0)":
+			case "0", "Ptr(0)", "Ptr(int64(0))":
 				return "0"
-			case `""`, `String("")`:
+			case `""`, `Ptr("")`:
 				return `""`
 			case "Timestamp{}", "&Timestamp{}":
 				return "github.Timestamp{0001-01-01 00:00:00 +0000 UTC}"
@@ -74,6 +75,9 @@ var (
 			case "[]Scope{ScopeNone}":
 				return `["(no scope)"]`
 			}
+			if strings.HasPrefix(v, "Ptr(") && strings.HasSuffix(v, `(""))`) {
+				return `""`
+			}
 			log.Fatalf("Unhandled zero value: %q", v)
 			return ""
 		},
@@ -100,6 +104,10 @@ func main() {
 			Imports:      map[string]string{"testing": "testing"},
 			StringFuncs:  map[string]bool{},
 			StructFields: map[string][]*structField{},
+			StringTypes:  map[string]bool{},
+		}
+		for _, f := range pkg.Files {
+			t.collectStringTypes(f)
 		}
 		for filename, f := range pkg.Files {
 			logf("Processing %v...", filename)
@@ -127,6 +135,7 @@ type templateData struct {
 	Imports      map[string]string
 	StringFuncs  map[string]bool
 	StructFields map[string][]*structField
+	StringTypes  map[string]bool // Named types whose underlying type is string.
 }
 
 type structField struct {
@@ -139,6 +148,23 @@ type structField struct {
 	NamedStruct  bool // Getter for named struct.
 }
 
+// collectStringTypes records the named types in f that are defined as string.
+func (t *templateData) collectStringTypes(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if ts, ok := spec.(*ast.TypeSpec); ok {
+				if id, ok := ts.Type.(*ast.Ident); ok && id.Name == "string" {
+					t.StringTypes[ts.Name.Name] = true
+				}
+			}
+		}
+	}
+}
+
 func (t *templateData) processAST(f *ast.File) error {
 	for _, decl := range f.Decls {
 		fn, ok := decl.(*ast.FuncDecl)
@@ -265,6 +291,10 @@ func (t *templateData) addIdent(x *ast.Ident, receiverType, fieldName string) {
 	case "Timestamp":
 		zeroValue = "Timestamp{}"
 	default:
+		if t.StringTypes[x.Name] {
+			zeroValue = `""`
+			break
+		}
 		zeroValue = "nil"
 		namedStruct = true
 	}
@@ -277,18 +307,22 @@ func (t *templateData) addIdentPtr(x *ast.Ident, receiverType, fieldName string)
 	var namedStruct = false
 	switch x.String() {
 	case "int":
-		zeroValue = "Int(0)"
+		zeroValue = "Ptr(0)"
 	case "int64":
-		zeroValue = "Int64(0)"
+		zeroValue = "Ptr(int64(0))"
 	case "float64":
-		zeroValue = "Float64(0.0)"
+		zeroValue = "Ptr(0.0)"
 	case "string":
-		zeroValue = `String("")`
+		zeroValue = `Ptr("")`
 	case "bool":
-		zeroValue = "Bool(false)"
+		zeroValue = "Ptr(false)"
 	case "Timestamp":
 		zeroValue = "&Timestamp{}"
 	default:
+		if t.StringTypes[x.Name] {
+			zeroValue = fmt.Sprintf(`Ptr(%v(""))`, x.Name)
+			break
+		}
 		zeroValue = "nil"
 		namedStruct = true
 	}
@@ -401,7 +435,6 @@ import (
   {{end -}}
 )
 {{end}}
-func Float64(v float64) *float64 { return &v }
 {{range $key, $value := .StructFields}}
 func Test{{ $key }}_String(t *testing.T) {
   v := {{ $key }}{ {{range .}}{{if .NamedStruct}}
@@ -0,0 +1,225 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedByGHES is wrapped by the errors returned for requests to
+// endpoints that are not available in GitHub Enterprise Server.
+var ErrUnsupportedByGHES = errors.New("endpoint is not available in GitHub Enterprise Server")
+
+// UnsupportedByGHESError is returned, without making a request, when a client
+// configured with WithGHESVersion is used to call an endpoint that is not
+// available in that version of GitHub Enterprise Server.
+type UnsupportedByGHESError struct {
+	// Operation is the documented operation, for example "GET /orgs/{org}/blocks".
+	Operation string
+	// Version is the GitHub Enterprise Server version the client is configured for.
+	Version string
+}
+
+func (e *UnsupportedByGHESError) Error() string {
+	return fmt.Sprintf("%v is not available in GitHub Enterprise Server %v", e.Operation, e.Version)
+}
+
+// Unwrap returns ErrUnsupportedByGHES.
+func (e *UnsupportedByGHESError) Unwrap() error {
+	return ErrUnsupportedByGHES
+}
+
+// ghesVersion is a GitHub Enterprise Server feature release, such as 3.12.
+type ghesVersion struct {
+	major, minor int
+}
+
+// parseGHESVersion parses a version such as "3.12" or "3.12.4". Patch
+// releases don't change the API, so the patch number is ignored.
+func parseGHESVersion(v string) (ghesVersion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(v, "v"), ".", 3)
+	if len(parts) < 2 {
+		return ghesVersion{}, fmt.Errorf("invalid GitHub Enterprise Server version %q", v)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ghesVersion{}, fmt.Errorf("invalid GitHub Enterprise Server version %q", v)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return ghesVersion{}, fmt.Errorf("invalid GitHub Enterprise Server version %q", v)
+	}
+	return ghesVersion{major, minor}, nil
+}
+
+func (v ghesVersion) after(o ghesVersion) bool {
+	return v.major > o.major || (v.major == o.major && v.minor > o.minor)
+}
+
+func (v ghesVersion) String() string {
+	return fmt.Sprintf("%v.%v", v.major, v.minor)
+}
+
+// WithGHESVersion returns a copy of the client that targets the given
+// version of GitHub Enterprise Server, such as "3.12". Requests to endpoints
+// that are documented as not available in GitHub Enterprise Server fail with
+// an *UnsupportedByGHESError instead of being sent and returning 404 Not Found.
+//
+// Only endpoints known to be missing from that version are rejected: those
+// not available in GitHub Enterprise Server at all, those removed before it
+// and those added after it. Versions newer than those described by this
+// release of the library are not checked, since they may have gained the
+// endpoint.
+//
+// The version of a server can be found with MetaService.Get, which reports it
+// in APIMeta.InstalledVersion:
+//
+//	meta, _, err := client.Meta.Get(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	client, err = client.WithGHESVersion(meta.GetInstalledVersion())
+func (c *Client) WithGHESVersion(version string) (*Client, error) {
+	v, err := parseGHESVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.ghesVersion = &v
+	return c2, nil
+}
+
+// checkGHESSupport returns an *UnsupportedByGHESError if the client targets
+// GitHub Enterprise Server and req is for an endpoint that isn't available in
+// that version.
+func (c *Client) checkGHESSupport(req *http.Request) error {
+	if c.ghesVersion == nil {
+		return nil
+	}
+	t := matchOperation(req.Method, c.apiPath(req.URL))
+	if t == nil || t.availableIn(*c.ghesVersion) {
+		return nil
+	}
+	return &UnsupportedByGHESError{Operation: t.name, Version: c.ghesVersion.String()}
+}
+
+// ghesOperation is a documented operation that is available in GitHub
+// Enterprise Server, with the oldest and newest described versions that
+// have it.
+type ghesOperation struct {
+	name, oldest, newest string
+}
+
+// operationTemplate is a documented operation split into path segments.
+type operationTemplate struct {
+	name     string
+	segments []string
+	literals int
+	// oldest and newest are the described GitHub Enterprise Server versions
+	// that have the operation. They are nil if it isn't available there.
+	oldest, newest *ghesVersion
+}
+
+var (
+	operationTemplatesOnce sync.Once
+	operationTemplates     map[string][]*operationTemplate // keyed by method
+
+	// The oldest and newest described versions, parsed from the generated
+	// ghesOldestDescribedVersion and ghesNewestDescribedVersion.
+	ghesOldestDescribed, ghesNewestDescribed ghesVersion
+)
+
+func mustParseGHESVersion(v string) *ghesVersion {
+	parsed, err := parseGHESVersion(v)
+	if err != nil {
+		panic(err)
+	}
+	return &parsed
+}
+
+func loadOperationTemplates() {
+	ghesOldestDescribed = *mustParseGHESVersion(ghesOldestDescribedVersion)
+	ghesNewestDescribed = *mustParseGHESVersion(ghesNewestDescribedVersion)
+
+	operationTemplates = make(map[string][]*operationTemplate)
+	add := func(op string, oldest, newest *ghesVersion) {
+		method, path, _ := strings.Cut(op, " ")
+		t := &operationTemplate{
+			name:     op,
+			segments: strings.Split(strings.Trim(path, "/"), "/"),
+			oldest:   oldest,
+			newest:   newest,
+		}
+		for _, s := range t.segments {
+			if !strings.HasPrefix(s, "{") {
+				t.literals++
+			}
+		}
+		operationTemplates[method] = append(operationTemplates[method], t)
+	}
+	for _, op := range ghesOperations {
+		add(op.name, mustParseGHESVersion(op.oldest), mustParseGHESVersion(op.newest))
+	}
+	for _, op := range nonGHESOperations {
+		add(op, nil, nil)
+	}
+}
+
+// matchOperation returns the documented operation that matches method and
+// path. If several operations match, the one with the most literal path
+// segments wins. It returns nil if no operation matches.
+func matchOperation(method, path string) *operationTemplate {
+	operationTemplatesOnce.Do(loadOperationTemplates)
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var match *operationTemplate
+	best := -1
+	for _, t := range operationTemplates[method] {
+		if len(t.segments) != len(segments) || t.literals <= best {
+			continue
+		}
+		if t.matches(segments) {
+			match, best = t, t.literals
+		}
+	}
+	return match
+}
+
+func (t *operationTemplate) matches(segments []string) bool {
+	for i, s := range t.segments {
+		if !strings.HasPrefix(s, "{") && s != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// availableIn reports whether the operation is available in version v of
+// GitHub Enterprise Server. Versions newer than the newest described one are
+// assumed to have every operation, since they may have gained it. Versions
+// older than the oldest described one are assumed to have every operation of
+// that oldest version.
+func (t *operationTemplate) availableIn(v ghesVersion) bool {
+	if v.after(ghesNewestDescribed) {
+		return true
+	}
+	if t.newest == nil {
+		return false
+	}
+	if v.after(*t.newest) {
+		// The operation was removed after its newest described version.
+		return false
+	}
+	// The operation was added in its oldest described version, unless that
+	// is the oldest version that is described at all.
+	return !t.oldest.after(v) || !t.oldest.after(ghesOldestDescribed)
+}
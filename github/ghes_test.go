@@ -0,0 +1,154 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithGHESVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ghes, err := client.WithGHESVersion("3.12.4")
+	if err != nil {
+		t.Fatalf("WithGHESVersion returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	_, err = ghes.Activity.MarkThreadDone(ctx, "1")
+	var ghesErr *UnsupportedByGHESError
+	if !errors.As(err, &ghesErr) {
+		t.Fatalf("MarkThreadDone returned error %v, want *UnsupportedByGHESError", err)
+	}
+	want := &UnsupportedByGHESError{Operation: "DELETE /notifications/threads/{thread_id}", Version: "3.12"}
+	if !cmp.Equal(ghesErr, want) {
+		t.Errorf("MarkThreadDone returned error %+v, want %+v", ghesErr, want)
+	}
+	if !errors.Is(err, ErrUnsupportedByGHES) {
+		t.Errorf("errors.Is(%v, ErrUnsupportedByGHES) = false, want true", err)
+	}
+	if got, want := err.Error(), "DELETE /notifications/threads/{thread_id} is not available in GitHub Enterprise Server 3.12"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	// Endpoints available in GitHub Enterprise Server are sent as usual.
+	if _, err := ghes.Activity.MarkThreadRead(ctx, "1"); err != nil {
+		t.Errorf("MarkThreadRead returned error: %v", err)
+	}
+
+	// Without a version, nothing is checked.
+	if _, err := client.Activity.MarkThreadDone(ctx, "1"); err != nil {
+		t.Errorf("MarkThreadDone returned error: %v", err)
+	}
+
+	// Newer versions than the described one may have gained the endpoint.
+	newer, _ := client.WithGHESVersion("99.0")
+	if _, err := newer.Activity.MarkThreadDone(ctx, "1"); err != nil {
+		t.Errorf("MarkThreadDone returned error: %v", err)
+	}
+}
+
+func TestWithGHESVersion_invalid(t *testing.T) {
+	for _, v := range []string{"", "3", "x.12", "3.x"} {
+		if _, err := NewClient(nil).WithGHESVersion(v); err == nil {
+			t.Errorf("WithGHESVersion(%q) returned nil error, want error", v)
+		}
+	}
+}
+
+func TestWithGHESVersion_removedEndpoint(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":0,"secrets":[]}`)
+	})
+
+	// The endpoint is only described up to GitHub Enterprise Server 3.7.
+	ctx := context.Background()
+	ghes, _ := client.WithGHESVersion("3.12")
+	_, _, err := ghes.Actions.ListEnvSecrets(ctx, 1, "e", nil)
+	var ghesErr *UnsupportedByGHESError
+	if !errors.As(err, &ghesErr) {
+		t.Fatalf("ListEnvSecrets returned error %v, want *UnsupportedByGHESError", err)
+	}
+	want := &UnsupportedByGHESError{Operation: "GET /repositories/{repository_id}/environments/{environment_name}/secrets", Version: "3.12"}
+	if !cmp.Equal(ghesErr, want) {
+		t.Errorf("ListEnvSecrets returned error %+v, want %+v", ghesErr, want)
+	}
+
+	old, _ := client.WithGHESVersion("3.7")
+	if _, _, err := old.Actions.ListEnvSecrets(ctx, 1, "e", nil); err != nil {
+		t.Errorf("ListEnvSecrets returned error: %v", err)
+	}
+}
+
+func TestOperationTemplate_availableIn(t *testing.T) {
+	operationTemplatesOnce.Do(loadOperationTemplates)
+	oldest, newest := ghesOldestDescribed, ghesNewestDescribed
+	defer func() { ghesOldestDescribed, ghesNewestDescribed = oldest, newest }()
+	ghesOldestDescribed = ghesVersion{3, 9}
+	ghesNewestDescribed = ghesVersion{3, 12}
+
+	v := func(major, minor int) *ghesVersion { return &ghesVersion{major, minor} }
+	tests := []struct {
+		name    string
+		t       *operationTemplate
+		version *ghesVersion
+		want    bool
+	}{
+		{"not in GHES", &operationTemplate{}, v(3, 12), false},
+		{"not in GHES, newer than described", &operationTemplate{}, v(3, 13), true},
+		{"in all versions", &operationTemplate{oldest: v(3, 9), newest: v(3, 12)}, v(3, 10), true},
+		{"in all versions, older than described", &operationTemplate{oldest: v(3, 9), newest: v(3, 12)}, v(3, 2), true},
+		{"removed", &operationTemplate{oldest: v(3, 3), newest: v(3, 3)}, v(3, 12), false},
+		{"before removal", &operationTemplate{oldest: v(3, 3), newest: v(3, 3)}, v(3, 3), true},
+		{"added", &operationTemplate{oldest: v(3, 11), newest: v(3, 12)}, v(3, 11), true},
+		{"before addition", &operationTemplate{oldest: v(3, 11), newest: v(3, 12)}, v(3, 10), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.t.availableIn(*tt.version); got != tt.want {
+			t.Errorf("%v: availableIn(%v) = %v, want %v", tt.name, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestMatchOperation(t *testing.T) {
+	tests := []struct {
+		method, path  string
+		wantOp        string
+		wantAvailable bool
+	}{
+		{"GET", "/repos/o/r", "GET /repos/{owner}/{repo}", true},
+		{"GET", "/orgs/o/blocks", "GET /orgs/{org}/blocks", false},
+		{"GET", "/user/interaction-limits", "GET /user/interaction-limits", false},
+		{"GET", "/no/such/endpoint/exists/here", "", false},
+		{"PATCH", "/", "", false},
+	}
+
+	for _, tt := range tests {
+		var op string
+		var available bool
+		if m := matchOperation(tt.method, tt.path); m != nil {
+			op, available = m.name, m.newest != nil
+		}
+		if op != tt.wantOp || available != tt.wantAvailable {
+			t.Errorf("matchOperation(%v, %v) = %q, %v, want %q, %v", tt.method, tt.path, op, available, tt.wantOp, tt.wantAvailable)
+		}
+	}
+}
@@ -22,25 +22,25 @@ func TestGistComments_Marshal(t *testing.T) {
 	createdAt := time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC)
 
 	u := &GistComment{
-		ID:   Int64(1),
-		URL:  String("u"),
-		Body: String("test gist comment"),
+		ID:   Ptr(int64(1)),
+		URL:  Ptr("u"),
+		Body: Ptr("test gist comment"),
 		User: &User{
-			Login:       String("ll"),
-			ID:          Int64(123),
-			AvatarURL:   String("a"),
-			GravatarID:  String("g"),
-			Name:        String("n"),
-			Company:     String("c"),
-			Blog:        String("b"),
-			Location:    String("l"),
-			Email:       String("e"),
-			Hireable:    Bool(true),
-			PublicRepos: Int(1),
-			Followers:   Int(1),
-			Following:   Int(1),
+			Login:       Ptr("ll"),
+			ID:          Ptr(int64(123)),
+			AvatarURL:   Ptr("a"),
+			GravatarID:  Ptr("g"),
+			Name:        Ptr("n"),
+			Company:     Ptr("c"),
+			Blog:        Ptr("b"),
+			Location:    Ptr("l"),
+			Email:       Ptr("e"),
+			Hireable:    Ptr(true),
+			PublicRepos: Ptr(1),
+			Followers:   Ptr(1),
+			Following:   Ptr(1),
 			CreatedAt:   &Timestamp{referenceTime},
-			URL:         String("u"),
+			URL:         Ptr("u"),
 		},
 		CreatedAt: &Timestamp{createdAt},
 	}
@@ -88,7 +88,7 @@ func TestGistsService_ListComments(t *testing.T) {
 		t.Errorf("Gists.Comments returned error: %v", err)
 	}
 
-	want := []*GistComment{{ID: Int64(1)}}
+	want := []*GistComment{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(comments, want) {
 		t.Errorf("Gists.ListComments returned %+v, want %+v", comments, want)
 	}
@@ -132,7 +132,7 @@ func TestGistsService_GetComment(t *testing.T) {
 		t.Errorf("Gists.GetComment returned error: %v", err)
 	}
 
-	want := &GistComment{ID: Int64(1)}
+	want := &GistComment{ID: Ptr(int64(1))}
 	if !cmp.Equal(comment, want) {
 		t.Errorf("Gists.GetComment returned %+v, want %+v", comment, want)
 	}
@@ -165,7 +165,7 @@ func TestGistsService_CreateComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &GistComment{ID: Int64(1), Body: String("b")}
+	input := &GistComment{ID: Ptr(int64(1)), Body: Ptr("b")}
 
 	mux.HandleFunc("/gists/1/comments", func(w http.ResponseWriter, r *http.Request) {
 		v := new(GistComment)
@@ -185,7 +185,7 @@ func TestGistsService_CreateComment(t *testing.T) {
 		t.Errorf("Gists.CreateComment returned error: %v", err)
 	}
 
-	want := &GistComment{ID: Int64(1)}
+	want := &GistComment{ID: Ptr(int64(1))}
 	if !cmp.Equal(comment, want) {
 		t.Errorf("Gists.CreateComment returned %+v, want %+v", comment, want)
 	}
@@ -218,7 +218,7 @@ func TestGistsService_EditComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &GistComment{ID: Int64(1), Body: String("b")}
+	input := &GistComment{ID: Ptr(int64(1)), Body: Ptr("b")}
 
 	mux.HandleFunc("/gists/1/comments/2", func(w http.ResponseWriter, r *http.Request) {
 		v := new(GistComment)
@@ -238,7 +238,7 @@ func TestGistsService_EditComment(t *testing.T) {
 		t.Errorf("Gists.EditComment returned error: %v", err)
 	}
 
-	want := &GistComment{ID: Int64(1)}
+	want := &GistComment{ID: Ptr(int64(1))}
 	if !cmp.Equal(comment, want) {
 		t.Errorf("Gists.EditComment returned %+v, want %+v", comment, want)
 	}
@@ -23,43 +23,43 @@ func TestGist_Marshal(t *testing.T) {
 	updatedAt := time.Date(2010, time.February, 10, 10, 10, 0, 0, time.UTC)
 
 	u := &Gist{
-		ID:          String("i"),
-		Description: String("description"),
-		Public:      Bool(true),
+		ID:          Ptr("i"),
+		Description: Ptr("description"),
+		Public:      Ptr(true),
 		Owner: &User{
-			Login:       String("ll"),
-			ID:          Int64(123),
-			AvatarURL:   String("a"),
-			GravatarID:  String("g"),
-			Name:        String("n"),
-			Company:     String("c"),
-			Blog:        String("b"),
-			Location:    String("l"),
-			Email:       String("e"),
-			Hireable:    Bool(true),
-			PublicRepos: Int(1),
-			Followers:   Int(1),
-			Following:   Int(1),
+			Login:       Ptr("ll"),
+			ID:          Ptr(int64(123)),
+			AvatarURL:   Ptr("a"),
+			GravatarID:  Ptr("g"),
+			Name:        Ptr("n"),
+			Company:     Ptr("c"),
+			Blog:        Ptr("b"),
+			Location:    Ptr("l"),
+			Email:       Ptr("e"),
+			Hireable:    Ptr(true),
+			PublicRepos: Ptr(1),
+			Followers:   Ptr(1),
+			Following:   Ptr(1),
 			CreatedAt:   &Timestamp{referenceTime},
-			URL:         String("u"),
+			URL:         Ptr("u"),
 		},
 		Files: map[GistFilename]GistFile{
 			"gistfile.py": {
-				Size:     Int(167),
-				Filename: String("gistfile.py"),
-				Language: String("Python"),
-				Type:     String("application/x-python"),
-				RawURL:   String("raw-url"),
-				Content:  String("c"),
+				Size:     Ptr(167),
+				Filename: Ptr("gistfile.py"),
+				Language: Ptr("Python"),
+				Type:     Ptr("application/x-python"),
+				RawURL:   Ptr("raw-url"),
+				Content:  Ptr("c"),
 			},
 		},
-		Comments:   Int(1),
-		HTMLURL:    String("html-url"),
-		GitPullURL: String("gitpull-url"),
-		GitPushURL: String("gitpush-url"),
+		Comments:   Ptr(1),
+		HTMLURL:    Ptr("html-url"),
+		GitPullURL: Ptr("gitpull-url"),
+		GitPushURL: Ptr("gitpush-url"),
 		CreatedAt:  &Timestamp{createdAt},
 		UpdatedAt:  &Timestamp{updatedAt},
-		NodeID:     String("node"),
+		NodeID:     Ptr("node"),
 	}
 
 	want := `{
@@ -109,32 +109,32 @@ func TestGistCommit_Marshal(t *testing.T) {
 	testJSONMarshal(t, &GistCommit{}, "{}")
 
 	u := &GistCommit{
-		URL:     String("u"),
-		Version: String("v"),
+		URL:     Ptr("u"),
+		Version: Ptr("v"),
 		User: &User{
-			Login:       String("ll"),
-			ID:          Int64(123),
-			AvatarURL:   String("a"),
-			GravatarID:  String("g"),
-			Name:        String("n"),
-			Company:     String("c"),
-			Blog:        String("b"),
-			Location:    String("l"),
-			Email:       String("e"),
-			Hireable:    Bool(true),
-			PublicRepos: Int(1),
-			Followers:   Int(1),
-			Following:   Int(1),
+			Login:       Ptr("ll"),
+			ID:          Ptr(int64(123)),
+			AvatarURL:   Ptr("a"),
+			GravatarID:  Ptr("g"),
+			Name:        Ptr("n"),
+			Company:     Ptr("c"),
+			Blog:        Ptr("b"),
+			Location:    Ptr("l"),
+			Email:       Ptr("e"),
+			Hireable:    Ptr(true),
+			PublicRepos: Ptr(1),
+			Followers:   Ptr(1),
+			Following:   Ptr(1),
 			CreatedAt:   &Timestamp{referenceTime},
-			URL:         String("u"),
+			URL:         Ptr("u"),
 		},
 		ChangeStatus: &CommitStats{
-			Additions: Int(1),
-			Deletions: Int(1),
-			Total:     Int(2),
+			Additions: Ptr(1),
+			Deletions: Ptr(1),
+			Total:     Ptr(2),
 		},
 		CommittedAt: &Timestamp{referenceTime},
-		NodeID:      String("node"),
+		NodeID:      Ptr("node"),
 	}
 
 	want := `{
@@ -173,28 +173,28 @@ func TestGistFork_Marshal(t *testing.T) {
 	testJSONMarshal(t, &GistFork{}, "{}")
 
 	u := &GistFork{
-		URL: String("u"),
+		URL: Ptr("u"),
 		User: &User{
-			Login:       String("ll"),
-			ID:          Int64(123),
-			AvatarURL:   String("a"),
-			GravatarID:  String("g"),
-			Name:        String("n"),
-			Company:     String("c"),
-			Blog:        String("b"),
-			Location:    String("l"),
-			Email:       String("e"),
-			Hireable:    Bool(true),
-			PublicRepos: Int(1),
-			Followers:   Int(1),
-			Following:   Int(1),
+			Login:       Ptr("ll"),
+			ID:          Ptr(int64(123)),
+			AvatarURL:   Ptr("a"),
+			GravatarID:  Ptr("g"),
+			Name:        Ptr("n"),
+			Company:     Ptr("c"),
+			Blog:        Ptr("b"),
+			Location:    Ptr("l"),
+			Email:       Ptr("e"),
+			Hireable:    Ptr(true),
+			PublicRepos: Ptr(1),
+			Followers:   Ptr(1),
+			Following:   Ptr(1),
 			CreatedAt:   &Timestamp{referenceTime},
-			URL:         String("u"),
+			URL:         Ptr("u"),
 		},
-		ID:        String("id"),
+		ID:        Ptr("id"),
 		CreatedAt: &Timestamp{referenceTime},
 		UpdatedAt: &Timestamp{referenceTime},
-		NodeID:    String("node"),
+		NodeID:    Ptr("node"),
 	}
 
 	want := `{
@@ -246,7 +246,7 @@ func TestGistsService_List_specifiedUser(t *testing.T) {
 		t.Errorf("Gists.List returned error: %v", err)
 	}
 
-	want := []*Gist{{ID: String("1")}}
+	want := []*Gist{{ID: Ptr("1")}}
 	if !cmp.Equal(gists, want) {
 		t.Errorf("Gists.List returned %+v, want %+v", gists, want)
 	}
@@ -281,7 +281,7 @@ func TestGistsService_List_authenticatedUser(t *testing.T) {
 		t.Errorf("Gists.List returned error: %v", err)
 	}
 
-	want := []*Gist{{ID: String("1")}}
+	want := []*Gist{{ID: Ptr("1")}}
 	if !cmp.Equal(gists, want) {
 		t.Errorf("Gists.List returned %+v, want %+v", gists, want)
 	}
@@ -331,7 +331,7 @@ func TestGistsService_ListAll(t *testing.T) {
 		t.Errorf("Gists.ListAll returned error: %v", err)
 	}
 
-	want := []*Gist{{ID: String("1")}}
+	want := []*Gist{{ID: Ptr("1")}}
 	if !cmp.Equal(gists, want) {
 		t.Errorf("Gists.ListAll returned %+v, want %+v", gists, want)
 	}
@@ -367,7 +367,7 @@ func TestGistsService_ListStarred(t *testing.T) {
 		t.Errorf("Gists.ListStarred returned error: %v", err)
 	}
 
-	want := []*Gist{{ID: String("1")}}
+	want := []*Gist{{ID: Ptr("1")}}
 	if !cmp.Equal(gists, want) {
 		t.Errorf("Gists.ListStarred returned %+v, want %+v", gists, want)
 	}
@@ -397,7 +397,7 @@ func TestGistsService_Get(t *testing.T) {
 		t.Errorf("Gists.Get returned error: %v", err)
 	}
 
-	want := &Gist{ID: String("1")}
+	want := &Gist{ID: Ptr("1")}
 	if !cmp.Equal(gist, want) {
 		t.Errorf("Gists.Get returned %+v, want %+v", gist, want)
 	}
@@ -441,7 +441,7 @@ func TestGistsService_GetRevision(t *testing.T) {
 		t.Errorf("Gists.Get returned error: %v", err)
 	}
 
-	want := &Gist{ID: String("1")}
+	want := &Gist{ID: Ptr("1")}
 	if !cmp.Equal(gist, want) {
 		t.Errorf("Gists.Get returned %+v, want %+v", gist, want)
 	}
@@ -475,10 +475,10 @@ func TestGistsService_Create(t *testing.T) {
 	defer teardown()
 
 	input := &Gist{
-		Description: String("Gist description"),
-		Public:      Bool(false),
+		Description: Ptr("Gist description"),
+		Public:      Ptr(false),
 		Files: map[GistFilename]GistFile{
-			"test.txt": {Content: String("Gist file content")},
+			"test.txt": {Content: Ptr("Gist file content")},
 		},
 	}
 
@@ -512,11 +512,11 @@ func TestGistsService_Create(t *testing.T) {
 	}
 
 	want := &Gist{
-		ID:          String("1"),
-		Description: String("Gist description"),
-		Public:      Bool(false),
+		ID:          Ptr("1"),
+		Description: Ptr("Gist description"),
+		Public:      Ptr(false),
 		Files: map[GistFilename]GistFile{
-			"test.txt": {Filename: String("test.txt")},
+			"test.txt": {Filename: Ptr("test.txt")},
 		},
 	}
 	if !cmp.Equal(gist, want) {
@@ -538,9 +538,9 @@ func TestGistsService_Edit(t *testing.T) {
 	defer teardown()
 
 	input := &Gist{
-		Description: String("New description"),
+		Description: Ptr("New description"),
 		Files: map[GistFilename]GistFile{
-			"new.txt": {Content: String("new file content")},
+			"new.txt": {Content: Ptr("new file content")},
 		},
 	}
 
@@ -577,12 +577,12 @@ func TestGistsService_Edit(t *testing.T) {
 	}
 
 	want := &Gist{
-		ID:          String("1"),
-		Description: String("new description"),
-		Public:      Bool(false),
+		ID:          Ptr("1"),
+		Description: Ptr("new description"),
+		Public:      Ptr(false),
 		Files: map[GistFilename]GistFile{
-			"test.txt": {Filename: String("test.txt")},
-			"new.txt":  {Filename: String("new.txt")},
+			"test.txt": {Filename: Ptr("test.txt")},
+			"new.txt":  {Filename: Ptr("new.txt")},
 		},
 	}
 	if !cmp.Equal(gist, want) {
@@ -646,14 +646,14 @@ func TestGistsService_ListCommits(t *testing.T) {
 	}
 
 	want := []*GistCommit{{
-		URL:         String("https://api.github.com/gists/1/1"),
-		Version:     String("1"),
-		User:        &User{ID: Int64(1)},
+		URL:         Ptr("https://api.github.com/gists/1/1"),
+		Version:     Ptr("1"),
+		User:        &User{ID: Ptr(int64(1))},
 		CommittedAt: &Timestamp{time.Date(2010, time.January, 1, 00, 00, 00, 0, time.UTC)},
 		ChangeStatus: &CommitStats{
-			Additions: Int(180),
-			Deletions: Int(0),
-			Total:     Int(180),
+			Additions: Ptr(180),
+			Deletions: Ptr(0),
+			Total:     Ptr(180),
 		}}}
 
 	if !cmp.Equal(gistCommits, want) {
@@ -909,7 +909,7 @@ func TestGistsService_Fork(t *testing.T) {
 		t.Errorf("Gists.Fork returned error: %v", err)
 	}
 
-	want := &Gist{ID: String("2")}
+	want := &Gist{ID: Ptr("2")}
 	if !cmp.Equal(gist, want) {
 		t.Errorf("Gists.Fork returned %+v, want %+v", gist, want)
 	}
@@ -955,9 +955,9 @@ func TestGistsService_ListForks(t *testing.T) {
 	}
 
 	want := []*GistFork{{
-		URL:       String("https://api.github.com/gists/1"),
-		ID:        String("1"),
-		User:      &User{ID: Int64(1)},
+		URL:       Ptr("https://api.github.com/gists/1"),
+		ID:        Ptr("1"),
+		User:      &User{ID: Ptr(int64(1))},
 		CreatedAt: &Timestamp{time.Date(2010, time.January, 1, 00, 00, 00, 0, time.UTC)},
 		UpdatedAt: &Timestamp{time.Date(2013, time.January, 1, 00, 00, 00, 0, time.UTC)}}}
 
@@ -1022,12 +1022,12 @@ func TestGistFile_Marshal(t *testing.T) {
 	testJSONMarshal(t, &GistFile{}, "{}")
 
 	u := &GistFile{
-		Size:     Int(1),
-		Filename: String("fn"),
-		Language: String("lan"),
-		Type:     String("type"),
-		RawURL:   String("rurl"),
-		Content:  String("con"),
+		Size:     Ptr(1),
+		Filename: Ptr("fn"),
+		Language: Ptr("lan"),
+		Type:     Ptr("type"),
+		RawURL:   Ptr("rurl"),
+		Content:  Ptr("con"),
 	}
 
 	want := `{
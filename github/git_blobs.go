@@ -8,7 +8,9 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
 )
 
 // Blob represents a blob object.
@@ -66,6 +68,32 @@ func (s *GitService) GetBlobRaw(ctx context.Context, owner, repo, sha string) ([
 	return buf.Bytes(), resp, nil
 }
 
+// DownloadBlob returns an io.ReadCloser that reads the raw contents of a
+// blob. Unlike GetBlobRaw, it doesn't hold the contents in memory, so it
+// is suited to large blobs. The request is never served from or stored in
+// the client's CacheStore, since caching would read the whole blob. It is
+// the caller's responsibility to close the ReadCloser.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#get-a-blob
+//
+//meta:operation GET /repos/{owner}/{repo}/git/blobs/{file_sha}
+func (s *GitService) DownloadBlob(ctx context.Context, owner, repo, sha string) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, sha)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeRaw)
+
+	resp, err := s.client.BareDo(context.WithValue(ctx, bypassCache, true), req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
+
 // CreateBlob creates a blob object.
 //
 // GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
@@ -86,3 +114,54 @@ func (s *GitService) CreateBlob(ctx context.Context, owner string, repo string,
 
 	return t, resp, nil
 }
+
+// CreateBlobFromReader creates a blob object with the contents read from r.
+// The contents are base64-encoded while they are sent, so, unlike CreateBlob,
+// they are never held in memory, which suits large files. Since r can only
+// be read once, the request is not retried.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
+//
+//meta:operation POST /repos/{owner}/{repo}/git/blobs
+func (s *GitService) CreateBlobFromReader(ctx context.Context, owner, repo string, r io.Reader) (*Blob, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs", owner, repo)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pr, pw := io.Pipe()
+	// Closing pr stops writeBlobBody if the request fails before the whole
+	// body has been read.
+	defer pr.Close()
+	go func() {
+		pw.CloseWithError(writeBlobBody(pw, r))
+	}()
+	req.Body = pr
+	req.Header.Set("Content-Type", "application/json")
+
+	t := new(Blob)
+	resp, err := s.client.Do(ctx, req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+// writeBlobBody writes the JSON body of a create blob request with the
+// contents read from r to w.
+func writeBlobBody(w io.Writer, r io.Reader) error {
+	if _, err := io.WriteString(w, `{"encoding":"base64","content":"`); err != nil {
+		return err
+	}
+	enc := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err := io.Copy(enc, r); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, `"}`)
+	return err
+}
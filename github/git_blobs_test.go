@@ -8,10 +8,16 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -36,8 +42,8 @@ func TestGitService_GetBlob(t *testing.T) {
 	}
 
 	want := Blob{
-		SHA:     String("s"),
-		Content: String("blob content"),
+		SHA:     Ptr("s"),
+		Content: Ptr("blob content"),
 	}
 
 	if !cmp.Equal(*blob, want) {
@@ -105,15 +111,84 @@ func TestGitService_GetBlobRaw(t *testing.T) {
 	})
 }
 
+func TestGitService_DownloadBlob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeRaw)
+
+		fmt.Fprint(w, `raw contents here`)
+	})
+
+	ctx := context.Background()
+	rc, _, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+	if err != nil {
+		t.Fatalf("Git.DownloadBlob returned error: %v", err)
+	}
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	assertNilError(t, err)
+	if want := "raw contents here"; string(got) != want {
+		t.Errorf("Git.DownloadBlob returned %q, want %q", got, want)
+	}
+
+	const methodName = "DownloadBlob"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.DownloadBlob(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGitService_DownloadBlob_cacheStore(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	store := &MemoryCacheStore{}
+	client = client.WithCacheStore(store, time.Hour)
+
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, headerIfNoneMatch, "")
+		w.Header().Set(headerETag, `"abc"`)
+		fmt.Fprint(w, `raw contents here`)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		rc, _, err := client.Git.DownloadBlob(ctx, "o", "r", "s")
+		if err != nil {
+			t.Fatalf("Git.DownloadBlob returned error: %v", err)
+		}
+		got, err := io.ReadAll(rc)
+		assertNilError(t, err)
+		rc.Close()
+		if want := "raw contents here"; string(got) != want {
+			t.Errorf("Git.DownloadBlob returned %q, want %q", got, want)
+		}
+	}
+	if n := len(store.entries); n != 0 {
+		t.Errorf("cache store has %v entries, want 0", n)
+	}
+}
+
 func TestGitService_CreateBlob(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
 	input := &Blob{
-		SHA:      String("s"),
-		Content:  String("blob content"),
-		Encoding: String("utf-8"),
-		Size:     Int(12),
+		SHA:      Ptr("s"),
+		Content:  Ptr("blob content"),
+		Encoding: Ptr("utf-8"),
+		Size:     Ptr(12),
 	}
 
 	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
@@ -171,16 +246,81 @@ func TestGitService_CreateBlob_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestGitService_CreateBlobFromReader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	content := strings.Repeat("blob content ", 1000)
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		v := new(Blob)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+		want := &Blob{
+			Content:  Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+			Encoding: Ptr("base64"),
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Git.CreateBlobFromReader request body: %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"sha": "s", "url": "u"}`)
+	})
+
+	ctx := context.Background()
+	blob, _, err := client.Git.CreateBlobFromReader(ctx, "o", "r", strings.NewReader(content))
+	if err != nil {
+		t.Errorf("Git.CreateBlobFromReader returned error: %v", err)
+	}
+
+	want := &Blob{SHA: Ptr("s"), URL: Ptr("u")}
+	if !cmp.Equal(blob, want) {
+		t.Errorf("Git.CreateBlobFromReader returned %+v, want %+v", blob, want)
+	}
+
+	const methodName = "CreateBlobFromReader"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.CreateBlobFromReader(ctx, "\n", "\n", strings.NewReader(content))
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.CreateBlobFromReader(ctx, "o", "r", strings.NewReader(content))
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGitService_CreateBlobFromReader_readError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		fmt.Fprint(w, `{}`)
+	})
+
+	readErr := errors.New("read failed")
+	ctx := context.Background()
+	_, _, err := client.Git.CreateBlobFromReader(ctx, "o", "r", iotest.ErrReader(readErr))
+	if !errors.Is(err, readErr) {
+		t.Errorf("Git.CreateBlobFromReader returned error %v, want %v", err, readErr)
+	}
+}
+
 func TestBlob_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Blob{}, "{}")
 
 	u := &Blob{
-		Content:  String("content"),
-		Encoding: String("encoding"),
-		SHA:      String("sha"),
-		Size:     Int(1),
-		URL:      String("url"),
-		NodeID:   String("nid"),
+		Content:  Ptr("content"),
+		Encoding: Ptr("encoding"),
+		SHA:      Ptr("sha"),
+		Size:     Ptr(1),
+		URL:      Ptr("url"),
+		NodeID:   Ptr("nid"),
 	}
 
 	want := `{
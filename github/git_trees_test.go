@@ -18,11 +18,11 @@ import (
 
 func TestMarshalJSON_withNilContentAndSHA(t *testing.T) {
 	te := &TreeEntry{
-		Path: String("path"),
-		Mode: String("mode"),
-		Type: String("type"),
-		Size: Int(1),
-		URL:  String("url"),
+		Path: Ptr("path"),
+		Mode: Ptr("mode"),
+		Type: Ptr("type"),
+		Size: Ptr(1),
+		URL:  Ptr("url"),
 	}
 
 	got, err := te.MarshalJSON()
@@ -56,13 +56,13 @@ func TestGitService_GetTree(t *testing.T) {
 	}
 
 	want := Tree{
-		SHA: String("s"),
+		SHA: Ptr("s"),
 		Entries: []*TreeEntry{
 			{
-				Type: String("blob"),
+				Type: Ptr("blob"),
 			},
 		},
-		Truncated: Bool(true),
+		Truncated: Ptr(true),
 	}
 	if !cmp.Equal(*tree, want) {
 		t.Errorf("Tree.Get returned %+v, want %+v", *tree, want)
@@ -98,10 +98,10 @@ func TestGitService_CreateTree(t *testing.T) {
 
 	input := []*TreeEntry{
 		{
-			Path: String("file.rb"),
-			Mode: String("100644"),
-			Type: String("blob"),
-			SHA:  String("7c258a9869f33c1e1e1f74fbb32f07c86cb5a75b"),
+			Path: Ptr("file.rb"),
+			Mode: Ptr("100644"),
+			Type: Ptr("blob"),
+			SHA:  Ptr("7c258a9869f33c1e1e1f74fbb32f07c86cb5a75b"),
 		},
 	}
 
@@ -139,14 +139,14 @@ func TestGitService_CreateTree(t *testing.T) {
 	}
 
 	want := Tree{
-		String("cd8274d15fa3ae2ab983129fb037999f264ba9a7"),
+		Ptr("cd8274d15fa3ae2ab983129fb037999f264ba9a7"),
 		[]*TreeEntry{
 			{
-				Path: String("file.rb"),
-				Mode: String("100644"),
-				Type: String("blob"),
-				Size: Int(132),
-				SHA:  String("7c258a9869f33c1e1e1f74fbb32f07c86cb5a75b"),
+				Path: Ptr("file.rb"),
+				Mode: Ptr("100644"),
+				Type: Ptr("blob"),
+				Size: Ptr(132),
+				SHA:  Ptr("7c258a9869f33c1e1e1f74fbb32f07c86cb5a75b"),
 			},
 		},
 		nil,
@@ -177,9 +177,9 @@ func TestGitService_CreateTree_Content(t *testing.T) {
 
 	input := []*TreeEntry{
 		{
-			Path:    String("content.md"),
-			Mode:    String("100644"),
-			Content: String("file content"),
+			Path:    Ptr("content.md"),
+			Mode:    Ptr("100644"),
+			Content: Ptr("file content"),
 		},
 	}
 
@@ -219,15 +219,15 @@ func TestGitService_CreateTree_Content(t *testing.T) {
 	}
 
 	want := Tree{
-		String("5c6780ad2c68743383b740fd1dab6f6a33202b11"),
+		Ptr("5c6780ad2c68743383b740fd1dab6f6a33202b11"),
 		[]*TreeEntry{
 			{
-				Path: String("content.md"),
-				Mode: String("100644"),
-				Type: String("blob"),
-				Size: Int(12),
-				SHA:  String("aad8feacf6f8063150476a7b2bd9770f2794c08b"),
-				URL:  String("https://api.github.com/repos/o/r/git/blobs/aad8feacf6f8063150476a7b2bd9770f2794c08b"),
+				Path: Ptr("content.md"),
+				Mode: Ptr("100644"),
+				Type: Ptr("blob"),
+				Size: Ptr(12),
+				SHA:  Ptr("aad8feacf6f8063150476a7b2bd9770f2794c08b"),
+				URL:  Ptr("https://api.github.com/repos/o/r/git/blobs/aad8feacf6f8063150476a7b2bd9770f2794c08b"),
 			},
 		},
 		nil,
@@ -258,8 +258,8 @@ func TestGitService_CreateTree_Delete(t *testing.T) {
 
 	input := []*TreeEntry{
 		{
-			Path: String("content.md"),
-			Mode: String("100644"),
+			Path: Ptr("content.md"),
+			Mode: Ptr("100644"),
 		},
 	}
 
@@ -299,15 +299,15 @@ func TestGitService_CreateTree_Delete(t *testing.T) {
 	}
 
 	want := Tree{
-		String("5c6780ad2c68743383b740fd1dab6f6a33202b11"),
+		Ptr("5c6780ad2c68743383b740fd1dab6f6a33202b11"),
 		[]*TreeEntry{
 			{
-				Path: String("content.md"),
-				Mode: String("100644"),
-				Type: String("blob"),
-				Size: Int(12),
+				Path: Ptr("content.md"),
+				Mode: Ptr("100644"),
+				Type: Ptr("blob"),
+				Size: Ptr(12),
 				SHA:  nil,
-				URL:  String("https://api.github.com/repos/o/r/git/blobs/aad8feacf6f8063150476a7b2bd9770f2794c08b"),
+				URL:  Ptr("https://api.github.com/repos/o/r/git/blobs/aad8feacf6f8063150476a7b2bd9770f2794c08b"),
 			},
 		},
 		nil,
@@ -345,19 +345,19 @@ func TestTree_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Tree{}, "{}")
 
 	u := &Tree{
-		SHA: String("sha"),
+		SHA: Ptr("sha"),
 		Entries: []*TreeEntry{
 			{
-				SHA:     String("sha"),
-				Path:    String("path"),
-				Mode:    String("mode"),
-				Type:    String("type"),
-				Size:    Int(1),
-				Content: String("content"),
-				URL:     String("url"),
+				SHA:     Ptr("sha"),
+				Path:    Ptr("path"),
+				Mode:    Ptr("mode"),
+				Type:    Ptr("type"),
+				Size:    Ptr(1),
+				Content: Ptr("content"),
+				URL:     Ptr("url"),
 			},
 		},
-		Truncated: Bool(false),
+		Truncated: Ptr(false),
 	}
 
 	want := `{
@@ -383,13 +383,13 @@ func TestTreeEntry_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TreeEntry{}, "{}")
 
 	u := &TreeEntry{
-		SHA:     String("sha"),
-		Path:    String("path"),
-		Mode:    String("mode"),
-		Type:    String("type"),
-		Size:    Int(1),
-		Content: String("content"),
-		URL:     String("url"),
+		SHA:     Ptr("sha"),
+		Path:    Ptr("path"),
+		Mode:    Ptr("mode"),
+		Type:    Ptr("type"),
+		Size:    Ptr(1),
+		Content: Ptr("content"),
+		URL:     Ptr("url"),
 	}
 
 	want := `{
@@ -409,13 +409,13 @@ func TestTreeEntryWithFileDelete_Marshal(t *testing.T) {
 	testJSONMarshal(t, &treeEntryWithFileDelete{}, "{}")
 
 	u := &treeEntryWithFileDelete{
-		SHA:     String("sha"),
-		Path:    String("path"),
-		Mode:    String("mode"),
-		Type:    String("type"),
-		Size:    Int(1),
-		Content: String("content"),
-		URL:     String("url"),
+		SHA:     Ptr("sha"),
+		Path:    Ptr("path"),
+		Mode:    Ptr("mode"),
+		Type:    Ptr("type"),
+		Size:    Ptr(1),
+		Content: Ptr("content"),
+		URL:     Ptr("url"),
 	}
 
 	want := `{
@@ -351,9 +351,9 @@ func (a *AdvancedSecurity) GetStatus() string {
 }
 
 // GetLastPushedDate returns the LastPushedDate field if it's non-nil, zero value otherwise.
-func (a *AdvancedSecurityCommittersBreakdown) GetLastPushedDate() string {
+func (a *AdvancedSecurityCommittersBreakdown) GetLastPushedDate() Timestamp {
 	if a == nil || a.LastPushedDate == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *a.LastPushedDate
 }
@@ -662,6 +662,14 @@ func (a *AnalysesListOptions) GetSarifID() string {
 	return *a.SarifID
 }
 
+// GetInstalledVersion returns the InstalledVersion field if it's non-nil, zero value otherwise.
+func (a *APIMeta) GetInstalledVersion() string {
+	if a == nil || a.InstalledVersion == nil {
+		return ""
+	}
+	return *a.InstalledVersion
+}
+
 // GetSSHKeyFingerprints returns the SSHKeyFingerprints map if it's non-nil, an empty map otherwise.
 func (a *APIMeta) GetSSHKeyFingerprints() map[string]string {
 	if a == nil || a.SSHKeyFingerprints == nil {
@@ -4063,9 +4071,9 @@ func (c *CopilotSeatDetails) GetLastActivityEditor() string {
 }
 
 // GetPendingCancellationDate returns the PendingCancellationDate field if it's non-nil, zero value otherwise.
-func (c *CopilotSeatDetails) GetPendingCancellationDate() string {
+func (c *CopilotSeatDetails) GetPendingCancellationDate() Timestamp {
 	if c == nil || c.PendingCancellationDate == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *c.PendingCancellationDate
 }
@@ -8166,6 +8174,14 @@ func (h *Hook) GetID() int64 {
 	return *h.ID
 }
 
+// GetLastResponse returns the LastResponse field.
+func (h *Hook) GetLastResponse() *HookLastResponse {
+	if h == nil {
+		return nil
+	}
+	return h.LastResponse
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (h *Hook) GetName() string {
 	if h == nil || h.Name == nil {
@@ -8350,6 +8366,30 @@ func (h *HookDelivery) GetStatusCode() int {
 	return *h.StatusCode
 }
 
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (h *HookLastResponse) GetCode() int {
+	if h == nil || h.Code == nil {
+		return 0
+	}
+	return *h.Code
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (h *HookLastResponse) GetMessage() string {
+	if h == nil || h.Message == nil {
+		return ""
+	}
+	return *h.Message
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HookLastResponse) GetStatus() string {
+	if h == nil || h.Status == nil {
+		return ""
+	}
+	return *h.Status
+}
+
 // GetHeaders returns the Headers map if it's non-nil, an empty map otherwise.
 func (h *HookRequest) GetHeaders() map[string]string {
 	if h == nil || h.Headers == nil {
@@ -9343,7 +9383,7 @@ func (i *InteractionRestriction) GetExpiresAt() Timestamp {
 }
 
 // GetLimit returns the Limit field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetLimit() string {
+func (i *InteractionRestriction) GetLimit() InteractionLimit {
 	if i == nil || i.Limit == nil {
 		return ""
 	}
@@ -11887,9 +11927,9 @@ func (m *Metric) GetURL() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetCreatedAt() string {
+func (m *Migration) GetCreatedAt() Timestamp {
 	if m == nil || m.CreatedAt == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *m.CreatedAt
 }
@@ -11935,9 +11975,9 @@ func (m *Migration) GetState() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetUpdatedAt() string {
+func (m *Migration) GetUpdatedAt() Timestamp {
 	if m == nil || m.UpdatedAt == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *m.UpdatedAt
 }
@@ -14247,9 +14287,9 @@ func (p *PagesHTTPSCertificate) GetDescription() string {
 }
 
 // GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (p *PagesHTTPSCertificate) GetExpiresAt() string {
+func (p *PagesHTTPSCertificate) GetExpiresAt() Timestamp {
 	if p == nil || p.ExpiresAt == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *p.ExpiresAt
 }
@@ -20559,7 +20599,7 @@ func (r *RepoStatus) GetNodeID() string {
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetState() string {
+func (r *RepoStatus) GetState() StatusState {
 	if r == nil || r.State == nil {
 		return ""
 	}
@@ -20686,6 +20726,14 @@ func (r *RequiredWorkflowSelectedRepos) GetTotalCount() int {
 	return *r.TotalCount
 }
 
+// GetBudget returns the Budget field.
+func (r *RetryPolicy) GetBudget() *RetryBudget {
+	if r == nil {
+		return nil
+	}
+	return r.Budget
+}
+
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
 func (r *ReviewersRequest) GetNodeID() string {
 	if r == nil || r.NodeID == nil {
@@ -21095,7 +21143,7 @@ func (r *RunnerLabels) GetName() string {
 }
 
 // GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetType() string {
+func (r *RunnerLabels) GetType() RunnerLabelType {
 	if r == nil || r.Type == nil {
 		return ""
 	}
@@ -21478,6 +21526,38 @@ func (s *SCIMUserName) GetFormatted() string {
 	return *s.Formatted
 }
 
+// GetCreated returns the Created field.
+func (s *SearchQuery) GetCreated() *DateRange {
+	if s == nil {
+		return nil
+	}
+	return s.Created
+}
+
+// GetPushed returns the Pushed field.
+func (s *SearchQuery) GetPushed() *DateRange {
+	if s == nil {
+		return nil
+	}
+	return s.Pushed
+}
+
+// GetQualifiers returns the Qualifiers map if it's non-nil, an empty map otherwise.
+func (s *SearchQuery) GetQualifiers() map[string]string {
+	if s == nil || s.Qualifiers == nil {
+		return map[string]string{}
+	}
+	return s.Qualifiers
+}
+
+// GetUpdated returns the Updated field.
+func (s *SearchQuery) GetUpdated() *DateRange {
+	if s == nil {
+		return nil
+	}
+	return s.Updated
+}
+
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
 func (s *SecretScanning) GetStatus() string {
 	if s == nil || s.Status == nil {
@@ -23615,9 +23695,9 @@ func (t *TopicResult) GetShortDescription() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (t *TopicResult) GetUpdatedAt() string {
+func (t *TopicResult) GetUpdatedAt() Timestamp {
 	if t == nil || t.UpdatedAt == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *t.UpdatedAt
 }
@@ -23878,6 +23958,14 @@ func (u *UpdateCheckRunOptions) GetOutput() *CheckRunOutput {
 	return u.Output
 }
 
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (u *UpdateCheckRunOptions) GetStartedAt() Timestamp {
+	if u == nil || u.StartedAt == nil {
+		return Timestamp{}
+	}
+	return *u.StartedAt
+}
+
 // GetStatus returns the Status field if it's non-nil, zero value otherwise.
 func (u *UpdateCheckRunOptions) GetStatus() string {
 	if u == nil || u.Status == nil {
@@ -24318,6 +24406,70 @@ func (u *User) GetURL() string {
 	return *u.URL
 }
 
+// GetAccessToken returns the AccessToken field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetAccessToken() string {
+	if u == nil || u.AccessToken == nil {
+		return ""
+	}
+	return *u.AccessToken
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetExpiresAt() Timestamp {
+	if u == nil || u.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *u.ExpiresAt
+}
+
+// GetExpiresIn returns the ExpiresIn field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetExpiresIn() int64 {
+	if u == nil || u.ExpiresIn == nil {
+		return 0
+	}
+	return *u.ExpiresIn
+}
+
+// GetRefreshToken returns the RefreshToken field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetRefreshToken() string {
+	if u == nil || u.RefreshToken == nil {
+		return ""
+	}
+	return *u.RefreshToken
+}
+
+// GetRefreshTokenExpiresAt returns the RefreshTokenExpiresAt field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetRefreshTokenExpiresAt() Timestamp {
+	if u == nil || u.RefreshTokenExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *u.RefreshTokenExpiresAt
+}
+
+// GetRefreshTokenExpiresIn returns the RefreshTokenExpiresIn field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetRefreshTokenExpiresIn() int64 {
+	if u == nil || u.RefreshTokenExpiresIn == nil {
+		return 0
+	}
+	return *u.RefreshTokenExpiresIn
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetScope() string {
+	if u == nil || u.Scope == nil {
+		return ""
+	}
+	return *u.Scope
+}
+
+// GetTokenType returns the TokenType field if it's non-nil, zero value otherwise.
+func (u *UserAccessToken) GetTokenType() string {
+	if u == nil || u.TokenType == nil {
+		return ""
+	}
+	return *u.TokenType
+}
+
 // GetApp returns the App field.
 func (u *UserAuthorization) GetApp() *OAuthAPP {
 	if u == nil {
@@ -24631,9 +24783,9 @@ func (u *UserLDAPMapping) GetURL() string {
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (u *UserMigration) GetCreatedAt() string {
+func (u *UserMigration) GetCreatedAt() Timestamp {
 	if u == nil || u.CreatedAt == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *u.CreatedAt
 }
@@ -24679,9 +24831,9 @@ func (u *UserMigration) GetState() string {
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (u *UserMigration) GetUpdatedAt() string {
+func (u *UserMigration) GetUpdatedAt() Timestamp {
 	if u == nil || u.UpdatedAt == nil {
-		return ""
+		return Timestamp{}
 	}
 	return *u.UpdatedAt
 }
@@ -403,7 +403,7 @@ func TestAdvancedSecurity_GetStatus(tt *testing.T) {
 }
 
 func TestAdvancedSecurityCommittersBreakdown_GetLastPushedDate(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	a := &AdvancedSecurityCommittersBreakdown{LastPushedDate: &zeroValue}
 	a.GetLastPushedDate()
 	a = &AdvancedSecurityCommittersBreakdown{}
@@ -765,6 +765,16 @@ func TestAnalysesListOptions_GetSarifID(tt *testing.T) {
 	a.GetSarifID()
 }
 
+func TestAPIMeta_GetInstalledVersion(tt *testing.T) {
+	var zeroValue string
+	a := &APIMeta{InstalledVersion: &zeroValue}
+	a.GetInstalledVersion()
+	a = &APIMeta{}
+	a.GetInstalledVersion()
+	a = nil
+	a.GetInstalledVersion()
+}
+
 func TestAPIMeta_GetSSHKeyFingerprints(tt *testing.T) {
 	zeroValue := map[string]string{}
 	a := &APIMeta{SSHKeyFingerprints: zeroValue}
@@ -4761,7 +4771,7 @@ func TestCopilotSeatDetails_GetLastActivityEditor(tt *testing.T) {
 }
 
 func TestCopilotSeatDetails_GetPendingCancellationDate(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	c := &CopilotSeatDetails{PendingCancellationDate: &zeroValue}
 	c.GetPendingCancellationDate()
 	c = &CopilotSeatDetails{}
@@ -9554,6 +9564,13 @@ func TestHook_GetID(tt *testing.T) {
 	h.GetID()
 }
 
+func TestHook_GetLastResponse(tt *testing.T) {
+	h := &Hook{}
+	h.GetLastResponse()
+	h = nil
+	h.GetLastResponse()
+}
+
 func TestHook_GetName(tt *testing.T) {
 	var zeroValue string
 	h := &Hook{Name: &zeroValue}
@@ -9775,6 +9792,36 @@ func TestHookDelivery_GetStatusCode(tt *testing.T) {
 	h.GetStatusCode()
 }
 
+func TestHookLastResponse_GetCode(tt *testing.T) {
+	var zeroValue int
+	h := &HookLastResponse{Code: &zeroValue}
+	h.GetCode()
+	h = &HookLastResponse{}
+	h.GetCode()
+	h = nil
+	h.GetCode()
+}
+
+func TestHookLastResponse_GetMessage(tt *testing.T) {
+	var zeroValue string
+	h := &HookLastResponse{Message: &zeroValue}
+	h.GetMessage()
+	h = &HookLastResponse{}
+	h.GetMessage()
+	h = nil
+	h.GetMessage()
+}
+
+func TestHookLastResponse_GetStatus(tt *testing.T) {
+	var zeroValue string
+	h := &HookLastResponse{Status: &zeroValue}
+	h.GetStatus()
+	h = &HookLastResponse{}
+	h.GetStatus()
+	h = nil
+	h.GetStatus()
+}
+
 func TestHookRequest_GetHeaders(tt *testing.T) {
 	zeroValue := map[string]string{}
 	h := &HookRequest{Headers: zeroValue}
@@ -10944,7 +10991,7 @@ func TestInteractionRestriction_GetExpiresAt(tt *testing.T) {
 }
 
 func TestInteractionRestriction_GetLimit(tt *testing.T) {
-	var zeroValue string
+	var zeroValue InteractionLimit
 	i := &InteractionRestriction{Limit: &zeroValue}
 	i.GetLimit()
 	i = &InteractionRestriction{}
@@ -13872,7 +13919,7 @@ func TestMetric_GetURL(tt *testing.T) {
 }
 
 func TestMigration_GetCreatedAt(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	m := &Migration{CreatedAt: &zeroValue}
 	m.GetCreatedAt()
 	m = &Migration{}
@@ -13932,7 +13979,7 @@ func TestMigration_GetState(tt *testing.T) {
 }
 
 func TestMigration_GetUpdatedAt(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	m := &Migration{UpdatedAt: &zeroValue}
 	m.GetUpdatedAt()
 	m = &Migration{}
@@ -16672,7 +16719,7 @@ func TestPagesHTTPSCertificate_GetDescription(tt *testing.T) {
 }
 
 func TestPagesHTTPSCertificate_GetExpiresAt(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	p := &PagesHTTPSCertificate{ExpiresAt: &zeroValue}
 	p.GetExpiresAt()
 	p = &PagesHTTPSCertificate{}
@@ -23878,7 +23925,7 @@ func TestRepoStatus_GetNodeID(tt *testing.T) {
 }
 
 func TestRepoStatus_GetState(tt *testing.T) {
-	var zeroValue string
+	var zeroValue StatusState
 	r := &RepoStatus{State: &zeroValue}
 	r.GetState()
 	r = &RepoStatus{}
@@ -24037,6 +24084,13 @@ func TestRequiredWorkflowSelectedRepos_GetTotalCount(tt *testing.T) {
 	r.GetTotalCount()
 }
 
+func TestRetryPolicy_GetBudget(tt *testing.T) {
+	r := &RetryPolicy{}
+	r.GetBudget()
+	r = nil
+	r.GetBudget()
+}
+
 func TestReviewersRequest_GetNodeID(tt *testing.T) {
 	var zeroValue string
 	r := &ReviewersRequest{NodeID: &zeroValue}
@@ -24527,7 +24581,7 @@ func TestRunnerLabels_GetName(tt *testing.T) {
 }
 
 func TestRunnerLabels_GetType(tt *testing.T) {
-	var zeroValue string
+	var zeroValue RunnerLabelType
 	r := &RunnerLabels{Type: &zeroValue}
 	r.GetType()
 	r = &RunnerLabels{}
@@ -24994,6 +25048,37 @@ func TestSCIMUserName_GetFormatted(tt *testing.T) {
 	s.GetFormatted()
 }
 
+func TestSearchQuery_GetCreated(tt *testing.T) {
+	s := &SearchQuery{}
+	s.GetCreated()
+	s = nil
+	s.GetCreated()
+}
+
+func TestSearchQuery_GetPushed(tt *testing.T) {
+	s := &SearchQuery{}
+	s.GetPushed()
+	s = nil
+	s.GetPushed()
+}
+
+func TestSearchQuery_GetQualifiers(tt *testing.T) {
+	zeroValue := map[string]string{}
+	s := &SearchQuery{Qualifiers: zeroValue}
+	s.GetQualifiers()
+	s = &SearchQuery{}
+	s.GetQualifiers()
+	s = nil
+	s.GetQualifiers()
+}
+
+func TestSearchQuery_GetUpdated(tt *testing.T) {
+	s := &SearchQuery{}
+	s.GetUpdated()
+	s = nil
+	s.GetUpdated()
+}
+
 func TestSecretScanning_GetStatus(tt *testing.T) {
 	var zeroValue string
 	s := &SecretScanning{Status: &zeroValue}
@@ -27404,7 +27489,7 @@ func TestTopicResult_GetShortDescription(tt *testing.T) {
 }
 
 func TestTopicResult_GetUpdatedAt(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	t := &TopicResult{UpdatedAt: &zeroValue}
 	t.GetUpdatedAt()
 	t = &TopicResult{}
@@ -27730,6 +27815,16 @@ func TestUpdateCheckRunOptions_GetOutput(tt *testing.T) {
 	u.GetOutput()
 }
 
+func TestUpdateCheckRunOptions_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	u := &UpdateCheckRunOptions{StartedAt: &zeroValue}
+	u.GetStartedAt()
+	u = &UpdateCheckRunOptions{}
+	u.GetStartedAt()
+	u = nil
+	u.GetStartedAt()
+}
+
 func TestUpdateCheckRunOptions_GetStatus(tt *testing.T) {
 	var zeroValue string
 	u := &UpdateCheckRunOptions{Status: &zeroValue}
@@ -28277,6 +28372,86 @@ func TestUser_GetURL(tt *testing.T) {
 	u.GetURL()
 }
 
+func TestUserAccessToken_GetAccessToken(tt *testing.T) {
+	var zeroValue string
+	u := &UserAccessToken{AccessToken: &zeroValue}
+	u.GetAccessToken()
+	u = &UserAccessToken{}
+	u.GetAccessToken()
+	u = nil
+	u.GetAccessToken()
+}
+
+func TestUserAccessToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	u := &UserAccessToken{ExpiresAt: &zeroValue}
+	u.GetExpiresAt()
+	u = &UserAccessToken{}
+	u.GetExpiresAt()
+	u = nil
+	u.GetExpiresAt()
+}
+
+func TestUserAccessToken_GetExpiresIn(tt *testing.T) {
+	var zeroValue int64
+	u := &UserAccessToken{ExpiresIn: &zeroValue}
+	u.GetExpiresIn()
+	u = &UserAccessToken{}
+	u.GetExpiresIn()
+	u = nil
+	u.GetExpiresIn()
+}
+
+func TestUserAccessToken_GetRefreshToken(tt *testing.T) {
+	var zeroValue string
+	u := &UserAccessToken{RefreshToken: &zeroValue}
+	u.GetRefreshToken()
+	u = &UserAccessToken{}
+	u.GetRefreshToken()
+	u = nil
+	u.GetRefreshToken()
+}
+
+func TestUserAccessToken_GetRefreshTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	u := &UserAccessToken{RefreshTokenExpiresAt: &zeroValue}
+	u.GetRefreshTokenExpiresAt()
+	u = &UserAccessToken{}
+	u.GetRefreshTokenExpiresAt()
+	u = nil
+	u.GetRefreshTokenExpiresAt()
+}
+
+func TestUserAccessToken_GetRefreshTokenExpiresIn(tt *testing.T) {
+	var zeroValue int64
+	u := &UserAccessToken{RefreshTokenExpiresIn: &zeroValue}
+	u.GetRefreshTokenExpiresIn()
+	u = &UserAccessToken{}
+	u.GetRefreshTokenExpiresIn()
+	u = nil
+	u.GetRefreshTokenExpiresIn()
+}
+
+func TestUserAccessToken_GetScope(tt *testing.T) {
+	var zeroValue string
+	u := &UserAccessToken{Scope: &zeroValue}
+	u.GetScope()
+	u = &UserAccessToken{}
+	u.GetScope()
+	u = nil
+	u.GetScope()
+}
+
+func TestUserAccessToken_GetTokenType(tt *testing.T) {
+	var zeroValue string
+	u := &UserAccessToken{TokenType: &zeroValue}
+	u.GetTokenType()
+	u = &UserAccessToken{}
+	u.GetTokenType()
+	u = nil
+	u.GetTokenType()
+}
+
 func TestUserAuthorization_GetApp(tt *testing.T) {
 	u := &UserAuthorization{}
 	u.GetApp()
@@ -28653,7 +28828,7 @@ func TestUserLDAPMapping_GetURL(tt *testing.T) {
 }
 
 func TestUserMigration_GetCreatedAt(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	u := &UserMigration{CreatedAt: &zeroValue}
 	u.GetCreatedAt()
 	u = &UserMigration{}
@@ -28713,7 +28888,7 @@ func TestUserMigration_GetState(tt *testing.T) {
 }
 
 func TestUserMigration_GetUpdatedAt(tt *testing.T) {
-	var zeroValue string
+	var zeroValue Timestamp
 	u := &UserMigration{UpdatedAt: &zeroValue}
 	u.GetUpdatedAt()
 	u = &UserMigration{}
@@ -5,6 +5,7 @@
 
 //go:generate go run gen-accessors.go
 //go:generate go run gen-stringify-test.go
+//go:generate go run gen-ghes-operations.go
 //go:generate ../script/metadata.sh update-go
 
 package github
@@ -16,9 +17,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,16 +43,22 @@ const (
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "X-RateLimit-Remaining"
 	headerRateReset     = "X-RateLimit-Reset"
+	headerRateUsed      = "X-RateLimit-Used"
+	headerRateResource  = "X-RateLimit-Resource"
 	headerOTP           = "X-GitHub-OTP"
 	headerRetryAfter    = "Retry-After"
 
 	headerTokenExpiration = "GitHub-Authentication-Token-Expiration"
+	headerDeprecation     = "Deprecation"
+	headerSunset          = "Sunset"
 
 	mediaTypeV3                = "application/vnd.github.v3+json"
 	defaultMediaType           = "application/octet-stream"
 	mediaTypeV3SHA             = "application/vnd.github.v3.sha"
 	mediaTypeV3Diff            = "application/vnd.github.v3.diff"
 	mediaTypeV3Patch           = "application/vnd.github.v3.patch"
+	mediaTypeRaw               = "application/vnd.github.raw+json"
+	mediaTypeHTML              = "application/vnd.github.html+json"
 	mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
 	mediaTypeIssueImportAPI    = "application/vnd.github.golden-comet-preview+json"
 
@@ -151,6 +161,39 @@ const (
 	mediaTypeContentAttachmentsPreview = "application/vnd.github.corsair-preview+json"
 )
 
+// previewMediaTypes maps the names of API previews to the media types that
+// opt into them. Most follow the "application/vnd.github.<name>-preview+json"
+// convention, but some do not include the "+json" suffix.
+var previewMediaTypes = map[string]string{
+	"ant-man":           mediaTypeDeploymentStatusPreview,
+	"antiope":           mediaTypeCheckRunsPreview,
+	"baptiste":          mediaTypeRepositoryTemplatePreview,
+	"cloak":             mediaTypeCommitSearchPreview,
+	"comfort-fade":      mediaTypeMultiLineCommentsPreview,
+	"corsair":           mediaTypeContentAttachmentsPreview,
+	"doctor-strange":    mediaTypeOAuthAppPreview,
+	"dorian":            mediaTypeRequiredVulnerabilityAlertsPreview,
+	"eye-scream":        mediaTypePreReceiveHooksPreview,
+	"flash":             mediaTypeExpandDeploymentStatusPreview,
+	"giant-sentry-fist": mediaTypeBlockUsersPreview,
+	"groot":             mediaTypeListPullsOrBranchesForCommitPreview,
+	"inertia":           mediaTypeProjectsPreview,
+	"luke-cage":         mediaTypeRequiredApprovingReviewsPreview,
+	"lydian":            mediaTypeUpdatePullRequestBranchPreview,
+	"mercy":             mediaTypeTopicsPreview,
+	"mockingbird":       mediaTypeTimelinePreview,
+	"nebula":            mediaTypeRepositoryVisibilityPreview,
+	"scarlet-witch":     mediaTypeCodesOfConductPreview,
+	"sombra":            mediaTypeInteractionRestrictionsPreview,
+	"squirrel-girl":     mediaTypeReactionsPreview,
+	"star":              mediaTypeStarringPreview,
+	"starfox":           mediaTypeProjectCardDetailsPreview,
+	"surtur":            mediaTypeMemberAllowedRepoCreationTypePreview,
+	"switcheroo":        mediaTypeEnablePagesAPIPreview,
+	"wyandotte":         mediaTypeMigrationsPreview,
+	"zzzax":             mediaTypeSignaturePreview,
+}
+
 var errNonNilContext = errors.New("context must be non-nil")
 
 // A Client manages communication with the GitHub API.
@@ -173,6 +216,42 @@ type Client struct {
 	rateLimits              [Categories]Rate // Rate limits for the client as determined by the most recent API calls.
 	secondaryRateLimitReset time.Time        // Secondary rate limit reset for the client as determined by the most recent API calls.
 
+	// JSON codec used for request and response bodies. If nil, encoding/json is used.
+	jsonMarshal   func(v interface{}) ([]byte, error)
+	jsonUnmarshal func(data []byte, v interface{}) error
+
+	// maxErrorBodySize caps how many bytes of an error response body are read. Zero means no limit.
+	maxErrorBodySize int64
+
+	// retryPolicy controls retrying requests that fail with a transient error.
+	retryPolicy RetryPolicy
+
+	// previews are the media types added to the Accept header of every request.
+	previews []string
+
+	// ghesVersion is the GitHub Enterprise Server version the client targets, if any.
+	ghesVersion *ghesVersion
+
+	// version is the REST API version sent in the X-GitHub-Api-Version header.
+	// If empty, defaultAPIVersion is used.
+	version string
+
+	// logger receives a record of each request, if set.
+	logger *slog.Logger
+
+	// cacheStore holds responses for conditional requests, if set.
+	cacheStore CacheStore
+	cacheTTL   time.Duration
+	// credentialKey identifies the token set with WithAuthToken, so that
+	// cached responses are not shared between credentials.
+	credentialKey string
+
+	// rateBudget holds back requests that would exceed the shared rate limit budget, if set.
+	rateBudget *RateBudget
+
+	// validateRequests makes methods validate enum fields before sending a request.
+	validateRequests bool
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
@@ -331,6 +410,7 @@ func NewClient(httpClient *http.Client) *Client {
 func (c *Client) WithAuthToken(token string) *Client {
 	c2 := c.copy()
 	defer c2.initialize()
+	c2.credentialKey = hashCredential("Bearer " + token)
 	transport := c2.client.Transport
 	if transport == nil {
 		transport = http.DefaultTransport
@@ -455,6 +535,19 @@ func (c *Client) copy() *Client {
 		BaseURL:                 c.BaseURL,
 		UploadURL:               c.UploadURL,
 		secondaryRateLimitReset: c.secondaryRateLimitReset,
+		jsonMarshal:             c.jsonMarshal,
+		jsonUnmarshal:           c.jsonUnmarshal,
+		maxErrorBodySize:        c.maxErrorBodySize,
+		retryPolicy:             c.retryPolicy,
+		previews:                c.previews,
+		ghesVersion:             c.ghesVersion,
+		version:                 c.version,
+		logger:                  c.logger,
+		cacheStore:              c.cacheStore,
+		cacheTTL:                c.cacheTTL,
+		credentialKey:           c.credentialKey,
+		rateBudget:              c.rateBudget,
+		validateRequests:        c.validateRequests,
 	}
 	c.clientMu.Unlock()
 	if c.client != nil {
@@ -469,6 +562,212 @@ func (c *Client) copy() *Client {
 	return &clone
 }
 
+// WithJSONCodec returns a copy of the client configured to use marshal to
+// encode request bodies in NewRequest and unmarshal to decode response bodies
+// in Do, in place of encoding/json. This allows using a faster or newer JSON
+// implementation; marshal and unmarshal must honor the same struct tags as
+// encoding/json. Passing nil for either function restores the default for it.
+//
+// Errors returned by the API are always decoded with encoding/json.
+func (c *Client) WithJSONCodec(marshal func(v interface{}) ([]byte, error), unmarshal func(data []byte, v interface{}) error) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.jsonMarshal = marshal
+	c2.jsonUnmarshal = unmarshal
+	return c2
+}
+
+// WithMaxErrorBodySize returns a copy of the client that reads at most n bytes
+// of the body of an error response. The (possibly truncated) body is still
+// parsed into the returned error and made available in its Response. A value
+// of zero or less means no limit, which is the default.
+func (c *Client) WithMaxErrorBodySize(n int64) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.maxErrorBodySize = n
+	return c2
+}
+
+// RetryPolicy specifies how the client retries requests that fail with a
+// transient server error. The zero value disables retries.
+//
+// Only requests whose body can be replayed are retried, which includes all
+// requests created by NewRequest.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried.
+	MaxRetries int
+
+	// Backoff returns how long to wait before the given retry, starting at 1.
+	// If nil, the wait starts at one second and doubles on each retry.
+	// A Retry-After header in the response takes precedence over Backoff.
+	Backoff func(retry int) time.Duration
+
+	// MaxWait is the longest the client waits before a retry. Longer waits
+	// returned by Backoff are shortened to MaxWait, while responses whose
+	// Retry-After header asks for a longer wait are returned instead of
+	// being retried. If zero, one minute is used.
+	MaxWait time.Duration
+
+	// RetryableStatuses lists the response status codes that are retried.
+	// If empty, 502 Bad Gateway, 503 Service Unavailable, and 504 Gateway
+	// Timeout are retried.
+	RetryableStatuses []int
+
+	// RetryableMethods lists the HTTP methods of the requests that are
+	// retried. If empty, only GET, HEAD and OPTIONS requests are retried.
+	// Other methods are not retried by default because a request that
+	// failed with a server error may still have been processed, so retrying
+	// it could, for example, create an issue or comment twice.
+	RetryableMethods []string
+
+	// Jitter randomizes the wait before each retry to between half and all
+	// of the wait returned by Backoff, so that clients which failed at the
+	// same time do not retry at the same time. The jittered wait is then
+	// shortened to MaxWait if needed. Jitter does not apply to waits
+	// requested by a Retry-After header.
+	Jitter bool
+
+	// Budget, if set, limits the retries of all requests made with this
+	// policy. Copies of the policy share the budget.
+	Budget *RetryBudget
+}
+
+var (
+	defaultRetryableStatuses = []int{
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+	defaultRetryableMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+	}
+)
+
+// defaultMaxRetryWait is the default RetryPolicy.MaxWait.
+const defaultMaxRetryWait = time.Minute
+
+// retryable reports whether a request with the given method that received
+// a response with the given status code should be retried.
+func (p RetryPolicy) retryable(method string, statusCode int) bool {
+	methods := p.RetryableMethods
+	if len(methods) == 0 {
+		methods = defaultRetryableMethods
+	}
+	statuses := p.RetryableStatuses
+	if len(statuses) == 0 {
+		statuses = defaultRetryableStatuses
+	}
+	return slices.Contains(methods, method) && slices.Contains(statuses, statusCode)
+}
+
+// wait returns how long to wait before the given retry of a request that
+// received resp, and whether the wait is within MaxWait.
+func (p RetryPolicy) wait(retry int, resp *http.Response) (time.Duration, bool) {
+	maxWait := p.MaxWait
+	if maxWait <= 0 {
+		maxWait = defaultMaxRetryWait
+	}
+
+	if secs, err := strconv.Atoi(resp.Header.Get(headerRetryAfter)); err == nil && secs >= 0 {
+		// The server asked for this wait, so it is not shortened.
+		if time.Duration(secs) > maxWait/time.Second {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	var wait time.Duration
+	if p.Backoff != nil {
+		wait = p.Backoff(retry)
+	} else {
+		wait = exponentialBackoff(retry, maxWait)
+	}
+	if p.Jitter && wait/2 > 0 {
+		wait -= time.Duration(rand.Int63n(int64(wait / 2)))
+	}
+	return min(wait, maxWait), true
+}
+
+// exponentialBackoff returns the default wait before the given retry: one
+// second, doubled for each retry after the first. Doubling stops once the
+// wait reaches maxWait, so that it cannot overflow.
+func exponentialBackoff(retry int, maxWait time.Duration) time.Duration {
+	wait := time.Second
+	for i := 1; i < retry && wait < maxWait; i++ {
+		wait *= 2
+	}
+	return wait
+}
+
+// WithValidation returns a copy of the client that validates the enum
+// fields of request bodies, such as RepoStatus.State and
+// Ruleset.Enforcement, before sending requests that create or update them.
+// Methods return the validation error without making a request if a field
+// holds a value that the API does not accept.
+func (c *Client) WithValidation() *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.validateRequests = true
+	return c2
+}
+
+// validate calls v.Validate if the client is configured to validate requests.
+func (c *Client) validate(v interface{ Validate() error }) error {
+	if !c.validateRequests {
+		return nil
+	}
+	return v.Validate()
+}
+
+// WithRetryPolicy returns a copy of the client that retries requests
+// according to policy.
+func (c *Client) WithRetryPolicy(policy RetryPolicy) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.retryPolicy = policy
+	return c2
+}
+
+// WithPreviews returns a copy of the client that opts into the named API
+// previews on every request, by adding their media types to the Accept
+// header alongside the media type chosen by each method. See WithPreview
+// for how preview names map to media types.
+func (c *Client) WithPreviews(names ...string) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.previews = make([]string, 0, len(c.previews)+len(names))
+	c2.previews = append(c2.previews, c.previews...)
+	for _, name := range names {
+		c2.previews = append(c2.previews, PreviewMediaType(name))
+	}
+	return c2
+}
+
+// WithAPIVersion returns a copy of the client that sends the given REST API
+// version, such as "2022-11-28", in the X-GitHub-Api-Version header of every
+// request. By default the version that this library's types were written
+// against is sent. Use WithVersion to override the version of an individual
+// request.
+//
+// When GitHub deprecates the version or endpoint used for a request, it is
+// reported in Response.Deprecated and Response.Sunset.
+func (c *Client) WithAPIVersion(version string) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.version = version
+	return c2
+}
+
+// apiVersion returns the REST API version to send with requests.
+func (c *Client) apiVersion() string {
+	if c.version == "" {
+		return defaultAPIVersion
+	}
+	return c.version
+}
+
 // NewClientWithEnvProxy enhances NewClient with the HttpProxy env.
 func NewClientWithEnvProxy() *Client {
 	return NewClient(&http.Client{Transport: &http.Transport{Proxy: http.ProxyFromEnvironment}})
@@ -501,6 +800,85 @@ func WithVersion(version string) RequestOption {
 	}
 }
 
+// WithAccept overrides the Accept header for this individual request.
+// Pass it to WithRequestOptions to override the media type chosen by a
+// service method.
+func WithAccept(mediaTypes ...string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Accept", strings.Join(mediaTypes, ", "))
+	}
+}
+
+// WithPreview opts this individual request into the named API preview by
+// adding its media type to the Accept header. See PreviewMediaType. Pass it
+// to WithRequestOptions to use it with service methods.
+func WithPreview(name string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Accept", addMediaTypes(req.Header.Get("Accept"), PreviewMediaType(name)))
+	}
+}
+
+// PreviewMediaType returns the media type used to opt into the named API
+// preview, for example "application/vnd.github.baptiste-preview+json" for
+// "baptiste". Previews known to this package are looked up in a table; for
+// other names, such as previews announced after this release, the media type
+// is derived from the name by GitHub's naming convention. If name already
+// looks like a media type, it is returned as is.
+func PreviewMediaType(name string) string {
+	if strings.Contains(name, "/") {
+		return name
+	}
+	if mt, ok := previewMediaTypes[name]; ok {
+		return mt
+	}
+	return "application/vnd.github." + name + "-preview+json"
+}
+
+// addMediaTypes appends mediaTypes that are not already present to the
+// comma-separated Accept header value accept.
+func addMediaTypes(accept string, mediaTypes ...string) string {
+	present := make(map[string]bool)
+	for _, mt := range strings.Split(accept, ",") {
+		present[strings.TrimSpace(mt)] = true
+	}
+	for _, mt := range mediaTypes {
+		if present[mt] {
+			continue
+		}
+		present[mt] = true
+		if accept == "" {
+			accept = mt
+		} else {
+			accept += ", " + mt
+		}
+	}
+	return accept
+}
+
+// WithRequestOptions returns a copy of ctx that applies opts to every request
+// sent with it. This is how options reach requests made by service methods,
+// for example:
+//
+//	ctx := github.WithRequestOptions(ctx, github.WithTimeout(5*time.Second))
+//	repo, _, err := client.Repositories.Get(ctx, "o", "r")
+//
+// The options are applied just before the request is sent, after the method
+// has set its own headers, so they take precedence over them. Options
+// already attached to ctx are kept and applied first.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	prev, _ := ctx.Value(requestOptions).([]RequestOption)
+	return context.WithValue(ctx, requestOptions, append(slices.Clip(prev), opts...))
+}
+
+// WithTimeout limits the time spent on this individual request, including
+// any retries and reading the response body, to timeout. Pass it to
+// NewRequest, or to WithRequestOptions for requests made by service methods.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(req *http.Request) {
+		*req = *req.WithContext(context.WithValue(req.Context(), requestTimeout, timeout))
+	}
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
@@ -516,15 +894,18 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 		return nil, err
 	}
 
-	var buf io.ReadWriter
+	var buf io.Reader
 	if body != nil {
-		buf = &bytes.Buffer{}
-		enc := json.NewEncoder(buf)
-		enc.SetEscapeHTML(false)
-		err := enc.Encode(body)
+		var b []byte
+		if c.jsonMarshal != nil {
+			b, err = c.jsonMarshal(body)
+		} else {
+			b, err = encodeBody(body)
+		}
 		if err != nil {
 			return nil, err
 		}
+		buf = bytes.NewReader(b)
 	}
 
 	req, err := http.NewRequest(method, u.String(), buf)
@@ -539,7 +920,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	req.Header.Set(headerAPIVersion, defaultAPIVersion)
+	req.Header.Set(headerAPIVersion, c.apiVersion())
 
 	for _, opt := range opts {
 		opt(req)
@@ -548,6 +929,46 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 	return req, nil
 }
 
+// maxPooledBufferSize is the capacity above which encoding buffers are
+// dropped rather than returned to bodyEncoderPool, so that one unusually
+// large request body does not pin its memory for the life of the process.
+const maxPooledBufferSize = 64 << 10
+
+// bodyEncoder is a JSON encoder together with the buffer it writes to.
+type bodyEncoder struct {
+	buf bytes.Buffer
+	enc *json.Encoder
+}
+
+// bodyEncoderPool holds the encoders used to JSON encode request bodies.
+var bodyEncoderPool = sync.Pool{
+	New: func() interface{} {
+		e := new(bodyEncoder)
+		e.enc = json.NewEncoder(&e.buf)
+		e.enc.SetEscapeHTML(false)
+		return e
+	},
+}
+
+// encodeBody JSON encodes body using a pooled encoder and returns a copy of
+// the encoded bytes sized to fit. The copy is needed because the transport
+// may read the request body after NewRequest returns.
+func encodeBody(body interface{}) ([]byte, error) {
+	e := bodyEncoderPool.Get().(*bodyEncoder)
+	e.buf.Reset()
+	defer func() {
+		if e.buf.Cap() <= maxPooledBufferSize {
+			bodyEncoderPool.Put(e)
+		}
+	}()
+
+	if err := e.enc.Encode(body); err != nil {
+		return nil, err
+	}
+
+	return bytes.Clone(e.buf.Bytes()), nil
+}
+
 // NewFormRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash.
@@ -572,7 +993,7 @@ func (c *Client) NewFormRequest(urlStr string, body io.Reader, opts ...RequestOp
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	req.Header.Set(headerAPIVersion, defaultAPIVersion)
+	req.Header.Set(headerAPIVersion, c.apiVersion())
 
 	for _, opt := range opts {
 		opt(req)
@@ -606,7 +1027,7 @@ func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, m
 	req.Header.Set("Content-Type", mediaType)
 	req.Header.Set("Accept", mediaTypeV3)
 	req.Header.Set("User-Agent", c.UserAgent)
-	req.Header.Set(headerAPIVersion, defaultAPIVersion)
+	req.Header.Set(headerAPIVersion, c.apiVersion())
 
 	for _, opt := range opts {
 		opt(req)
@@ -662,6 +1083,15 @@ type Response struct {
 	// token's expiration date. Timestamp is 0001-01-01 when token doesn't expire.
 	// So it is valid for TokenExpiration.Equal(Timestamp{}) or TokenExpiration.Time.After(time.Now())
 	TokenExpiration Timestamp
+
+	// Deprecated reports whether GitHub marked the API version or endpoint
+	// used for the request as deprecated, via the Deprecation header.
+	Deprecated bool
+
+	// Sunset is the time after which a deprecated API version or endpoint
+	// will stop working, from the Sunset header. It is the zero Timestamp
+	// if no sunset has been announced.
+	Sunset Timestamp
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -671,9 +1101,20 @@ func newResponse(r *http.Response) *Response {
 	response.populatePageValues()
 	response.Rate = parseRate(r)
 	response.TokenExpiration = parseTokenExpiration(r)
+	response.Deprecated, response.Sunset = parseDeprecation(r)
 	return response
 }
 
+// TokenExpiresIn returns the time left until the token used for the request
+// expires, and whether the token expires at all. The duration is negative
+// if the token has already expired.
+func (r *Response) TokenExpiresIn() (time.Duration, bool) {
+	if r.TokenExpiration.IsZero() {
+		return 0, false
+	}
+	return time.Until(r.TokenExpiration.Time), true
+}
+
 // populatePageValues parses the HTTP Link response headers and populates the
 // various pagination link values in the Response.
 func (r *Response) populatePageValues() {
@@ -757,6 +1198,10 @@ func parseRate(r *http.Response) Rate {
 			rate.Reset = Timestamp{time.Unix(v, 0)}
 		}
 	}
+	if used := r.Header.Get(headerRateUsed); used != "" {
+		rate.Used, _ = strconv.Atoi(used)
+	}
+	rate.Resource = r.Header.Get(headerRateResource)
 	return rate
 }
 
@@ -800,13 +1245,45 @@ func parseTokenExpiration(r *http.Response) Timestamp {
 	return Timestamp{} // 0001-01-01 00:00:00
 }
 
+// parseDeprecation parses the Deprecation and Sunset headers. Deprecation is
+// either a date or "@" followed by a Unix timestamp (RFC 9745), or "true" in
+// older drafts; any value other than "false" marks the response deprecated.
+func parseDeprecation(r *http.Response) (deprecated bool, sunset Timestamp) {
+	if v := r.Header.Get(headerDeprecation); v != "" && v != "false" {
+		deprecated = true
+	}
+	if v := r.Header.Get(headerSunset); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			sunset = Timestamp{t}
+		}
+	}
+	return deprecated, sunset
+}
+
 type requestContext uint8
 
 const (
 	bypassRateLimitCheck requestContext = iota
 	SleepUntilPrimaryRateLimitResetWhenRateLimited
+	requestTimeout
+	rateReservation
+	requestOptions
+	bypassCache
 )
 
+// cancelOnCloseBody calls cancel once the response body is closed, so that a
+// per-request timeout covers reading the body.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // BareDo sends an API request and lets you handle the api response. If an error
 // or API Error occurs, the error will contain more information. Otherwise you
 // are supposed to read and close the response's Body. If rate limit is exceeded
@@ -820,9 +1297,37 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		return nil, errNonNilContext
 	}
 
+	if opts, _ := ctx.Value(requestOptions).([]RequestOption); len(opts) > 0 {
+		// Apply the options only once, even if BareDo is called again below.
+		ctx = context.WithValue(ctx, requestOptions, []RequestOption(nil))
+		for _, opt := range opts {
+			opt(req)
+		}
+	}
+
+	if timeout, ok := req.Context().Value(requestTimeout).(time.Duration); ok && timeout > 0 {
+		// The zero timeout marks the request as already handled.
+		ctx, cancel := context.WithTimeout(context.WithValue(ctx, requestTimeout, time.Duration(0)), timeout)
+		resp, err := c.BareDo(ctx, req.WithContext(ctx))
+		if err == nil && resp.Body != nil {
+			resp.Body = &cancelOnCloseBody{resp.Body, cancel}
+		} else {
+			cancel()
+		}
+		return resp, err
+	}
+
 	req = withContext(ctx, req)
 
-	rateLimitCategory := GetRateLimitCategory(req.Method, req.URL.Path)
+	if len(c.previews) > 0 {
+		req.Header.Set("Accept", addMediaTypes(req.Header.Get("Accept"), c.previews...))
+	}
+
+	if err := c.checkGHESSupport(req); err != nil {
+		return nil, err
+	}
+
+	rateLimitCategory := GetRateLimitCategory(req.Method, c.apiPath(req.URL))
 
 	if bypass := ctx.Value(bypassRateLimitCheck); bypass == nil {
 		// If we've hit rate limit, don't make further requests before Reset time.
@@ -840,7 +1345,17 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		}
 	}
 
-	resp, err := c.client.Do(req)
+	// Checking the rate limit itself doesn't count against it, so it isn't
+	// drawn from the budget either.
+	if c.rateBudget != nil && ctx.Value(bypassRateLimitCheck) == nil {
+		if err := c.rateBudget.acquire(ctx, rateLimitCategory); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.doWithCache(ctx, req)
+	c.logRequest(ctx, req, resp, err, time.Since(start))
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -865,15 +1380,27 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 
 	// Don't update the rate limits if this was a cached response.
 	// X-From-Cache is set by https://github.com/gregjones/httpcache
-	if response.Header.Get("X-From-Cache") == "" {
+	if response.Header.Get(headerFromCache) == "" {
 		c.rateMu.Lock()
 		c.rateLimits[rateLimitCategory] = response.Rate
 		c.rateMu.Unlock()
+		if c.rateBudget != nil {
+			c.rateBudget.update(rateLimitCategory, response.Rate)
+		}
+	}
+
+	body := resp.Body
+	if c.maxErrorBodySize > 0 && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.LimitReader(body, c.maxErrorBodySize), body}
 	}
 
 	err = CheckResponse(resp)
 	if err != nil {
-		defer resp.Body.Close()
+		// CheckResponse replaces resp.Body, so close the original body.
+		defer drainAndClose(body)
 		// Special case for AcceptedErrors. If an AcceptedError
 		// has been encountered, the response's payload will be
 		// added to the AcceptedError and returned.
@@ -918,6 +1445,9 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 // If rate limit is exceeded and reset time is in the future, Do returns
 // *RateLimitError immediately without making a network API call.
 //
+// Do always reads any unread remainder of the response body, up to a limit,
+// and closes it, so that the underlying connection can be reused.
+//
 // The provided ctx must be non-nil, if it is nil an error is returned. If it
 // is canceled or times out, ctx.Err() will be returned.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
@@ -925,13 +1455,17 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	if err != nil {
 		return resp, err
 	}
-	defer resp.Body.Close()
+	defer drainAndClose(resp.Body)
 
 	switch v := v.(type) {
 	case nil:
 	case io.Writer:
 		_, err = io.Copy(v, resp.Body)
 	default:
+		if c.jsonUnmarshal != nil {
+			err = c.decodeWithCodec(resp.Body, v)
+			break
+		}
 		decErr := json.NewDecoder(resp.Body).Decode(v)
 		if decErr == io.EOF {
 			decErr = nil // ignore EOF errors caused by empty response body
@@ -943,6 +1477,92 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return resp, err
 }
 
+// doWithRetry sends req, retrying it according to the client's RetryPolicy.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.retryPolicy
+	if policy.Budget != nil {
+		policy.Budget.deposit()
+	}
+	for retry := 1; ; retry++ {
+		resp, err := c.client.Do(req)
+		if err != nil || retry > policy.MaxRetries || !policy.retryable(req.Method, resp.StatusCode) {
+			return resp, err
+		}
+
+		wait, ok := policy.wait(retry, resp)
+		if !ok {
+			return resp, nil
+		}
+
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		if policy.Budget != nil && !policy.Budget.withdraw() {
+			return resp, nil
+		}
+
+		drainAndClose(resp.Body)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// maxDrainSize is the maximum number of unread response body bytes that
+// drainAndClose discards. Connections with more data left unread are closed
+// rather than reused, since reading the rest would cost more than a new
+// connection.
+const maxDrainSize = 64 << 10
+
+// drainAndClose reads what remains of body, up to maxDrainSize bytes, and
+// closes it. A body that has been read to EOF and closed allows the
+// transport to reuse the connection for subsequent requests.
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.CopyN(io.Discard, body, maxDrainSize)
+	body.Close()
+}
+
+// decodeWithCodec reads r and decodes it into v using the client's
+// configured unmarshal function. An empty body is not an error.
+func (c *Client) decodeWithCodec(r io.Reader, v interface{}) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	return c.jsonUnmarshal(data, v)
+}
+
+// apiPath returns the path of u relative to the root of the API, stripping the
+// path of BaseURL (e.g. "/api/v3" for GitHub Enterprise Server) if present,
+// so that it can be matched against the documented endpoint paths.
+func (c *Client) apiPath(u *url.URL) string {
+	if c.BaseURL == nil {
+		return u.Path
+	}
+	prefix := strings.TrimSuffix(c.BaseURL.Path, "/")
+	if prefix != "" && strings.HasPrefix(u.Path, prefix+"/") {
+		return strings.TrimPrefix(u.Path, prefix)
+	}
+	return u.Path
+}
+
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *RateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
@@ -1195,18 +1815,35 @@ func (r *AbuseRateLimitError) Is(target error) bool {
 		compareHTTPResponse(r.Response, v.Response)
 }
 
-// sanitizeURL redacts the client_secret parameter from the URL which may be
-// exposed to the user.
+// sensitiveQueryParams are the query parameters that sanitizeURL redacts.
+// Besides OAuth client secrets and tokens, they include the signature of
+// pre-signed download URLs, such as those of runner JIT configurations and
+// artifacts.
+var sensitiveQueryParams = []string{"client_secret", "access_token", "token", "sig"}
+
+// sanitizeURL returns a copy of the URL with credentials redacted, so that it
+// can be exposed to the user in errors and logs. The password of the URL's
+// user info and the values of sensitiveQueryParams are replaced with REDACTED.
 func sanitizeURL(uri *url.URL) *url.URL {
 	if uri == nil {
 		return nil
 	}
-	params := uri.Query()
-	if len(params.Get("client_secret")) > 0 {
-		params.Set("client_secret", "REDACTED")
-		uri.RawQuery = params.Encode()
+	u := *uri
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), "REDACTED")
 	}
-	return uri
+	params := u.Query()
+	redacted := false
+	for _, p := range sensitiveQueryParams {
+		if len(params.Get(p)) > 0 {
+			params.Set(p, "REDACTED")
+			redacted = true
+		}
+	}
+	if redacted {
+		u.RawQuery = params.Encode()
+	}
+	return &u
 }
 
 /*
@@ -1399,6 +2036,21 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 	return c.RateLimit.Get(ctx)
 }
 
+// LastRateLimit returns the rate limit for the given category as reported by
+// the most recent API response in that category, without making a network
+// call. It returns the zero Rate if no request in that category has been made
+// yet. For example, LastRateLimit(SearchCategory) reports the remaining quota
+// of the separate search rate limit.
+func (c *Client) LastRateLimit(category RateLimitCategory) Rate {
+	if category >= Categories {
+		return Rate{}
+	}
+
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rateLimits[category]
+}
+
 func setCredentialsAsHeaders(req *http.Request, id, secret string) *http.Request {
 	// To set extra headers, we must make a copy of the Request so
 	// that we don't modify the Request we were given. This is required by the
@@ -1579,20 +2231,54 @@ func (c *Client) roundTripWithOptionalFollowRedirect(ctx context.Context, u stri
 	return resp, err
 }
 
+// validateEnum returns an error if value is not one of allowed.
+func validateEnum[T ~string](field string, value T, allowed ...T) error {
+	if slices.Contains(allowed, value) {
+		return nil
+	}
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		names[i] = string(a)
+	}
+	return fmt.Errorf("invalid %v %q: must be one of %v", field, value, strings.Join(names, ", "))
+}
+
+// Ptr is a helper routine that allocates a new T value
+// to store v and returns a pointer to it.
+func Ptr[T any](v T) *T { return &v }
+
+// Deref is a helper routine that returns the value p points to,
+// or the zero value of T if p is nil.
+func Deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
+//
+// Deprecated: Use Ptr instead.
 func Bool(v bool) *bool { return &v }
 
 // Int is a helper routine that allocates a new int value
 // to store v and returns a pointer to it.
+//
+// Deprecated: Use Ptr instead.
 func Int(v int) *int { return &v }
 
 // Int64 is a helper routine that allocates a new int64 value
 // to store v and returns a pointer to it.
+//
+// Deprecated: Use Ptr instead.
 func Int64(v int64) *int64 { return &v }
 
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
+//
+// Deprecated: Use Ptr instead.
 func String(v string) *string { return &v }
 
 // roundTripperFunc creates a RoundTripper (transport)
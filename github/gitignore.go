@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 )
@@ -66,3 +67,26 @@ func (s *GitignoresService) Get(ctx context.Context, name string) (*Gitignore, *
 
 	return gitignore, resp, nil
 }
+
+// GetRaw gets the raw contents of a Gitignore template by name.
+//
+// GitHub API docs: https://docs.github.com/rest/gitignore/gitignore#get-a-gitignore-template
+//
+//meta:operation GET /gitignore/templates/{name}
+func (s *GitignoresService) GetRaw(ctx context.Context, name string) (string, *Response, error) {
+	u := fmt.Sprintf("gitignore/templates/%v", name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeRaw)
+
+	buf := new(bytes.Buffer)
+	resp, err := s.client.Do(ctx, req, buf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return buf.String(), resp, nil
+}
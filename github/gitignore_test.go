@@ -59,7 +59,7 @@ func TestGitignoresService_Get(t *testing.T) {
 		t.Errorf("Gitignores.List returned error: %v", err)
 	}
 
-	want := &Gitignore{Name: String("Name"), Source: String("template source")}
+	want := &Gitignore{Name: Ptr("Name"), Source: Ptr("template source")}
 	if !cmp.Equal(gitignore, want) {
 		t.Errorf("Gitignores.Get returned %+v, want %+v", gitignore, want)
 	}
@@ -92,8 +92,8 @@ func TestGitignore_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Gitignore{}, "{}")
 
 	u := &Gitignore{
-		Name:   String("name"),
-		Source: String("source"),
+		Name:   Ptr("name"),
+		Source: Ptr("source"),
 	}
 
 	want := `{
@@ -103,3 +103,38 @@ func TestGitignore_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestGitignoresService_GetRaw(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/gitignore/templates/name", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeRaw)
+		fmt.Fprint(w, "# Binaries\n*.exe\n")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Gitignores.GetRaw(ctx, "name")
+	if err != nil {
+		t.Errorf("Gitignores.GetRaw returned error: %v", err)
+	}
+
+	if want := "# Binaries\n*.exe\n"; got != want {
+		t.Errorf("Gitignores.GetRaw returned %q, want %q", got, want)
+	}
+
+	const methodName = "GetRaw"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Gitignores.GetRaw(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Gitignores.GetRaw(ctx, "name")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty string", methodName, got)
+		}
+		return resp, err
+	})
+}
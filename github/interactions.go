@@ -11,12 +11,36 @@ package github
 // GitHub API docs: https://docs.github.com/rest/interactions/
 type InteractionsService service
 
+// InteractionLimit specifies the group of GitHub users who can comment, open
+// issues, or create pull requests in the public repositories an interaction
+// restriction applies to.
+type InteractionLimit string
+
+// Interaction limits that can be set on a user, organization, or repository.
+const (
+	InteractionLimitExistingUsers     InteractionLimit = "existing_users"
+	InteractionLimitContributorsOnly  InteractionLimit = "contributors_only"
+	InteractionLimitCollaboratorsOnly InteractionLimit = "collaborators_only"
+)
+
+// InteractionExpiry specifies how long an interaction restriction lasts.
+type InteractionExpiry string
+
+// Durations that can be passed as the expiry of an interaction restriction.
+const (
+	InteractionExpiryOneDay    InteractionExpiry = "one_day"
+	InteractionExpiryThreeDays InteractionExpiry = "three_days"
+	InteractionExpiryOneWeek   InteractionExpiry = "one_week"
+	InteractionExpiryOneMonth  InteractionExpiry = "one_month"
+	InteractionExpirySixMonths InteractionExpiry = "six_months"
+)
+
 // InteractionRestriction represents the interaction restrictions for repository and organization.
 type InteractionRestriction struct {
 	// Specifies the group of GitHub users who can
 	// comment, open issues, or create pull requests for the given repository.
 	// Possible values are: "existing_users", "contributors_only" and "collaborators_only".
-	Limit *string `json:"limit,omitempty"`
+	Limit *InteractionLimit `json:"limit,omitempty"`
 
 	// Origin specifies the type of the resource to interact with.
 	// Possible values are: "repository" and "organization".
@@ -26,3 +50,10 @@ type InteractionRestriction struct {
 	// The default expiry time is 24 hours from the time restriction is created.
 	ExpiresAt *Timestamp `json:"expires_at,omitempty"`
 }
+
+// interactionRestrictionRequest represents the body of a request that sets
+// interaction restrictions. An empty Expiry uses the API default of one day.
+type interactionRestrictionRequest struct {
+	Limit  InteractionLimit  `json:"limit"`
+	Expiry InteractionExpiry `json:"expiry,omitempty"`
+}
@@ -39,15 +39,15 @@ func (s *InteractionsService) GetRestrictionsForOrg(ctx context.Context, organiz
 //
 // limit specifies the group of GitHub users who can comment, open issues, or create pull requests
 // in public repositories for the given organization.
-// Possible values are: "existing_users", "contributors_only", "collaborators_only".
+// expiry specifies how long the restrictions last; if empty, they expire after one day.
 //
 // GitHub API docs: https://docs.github.com/rest/interactions/orgs#set-interaction-restrictions-for-an-organization
 //
 //meta:operation PUT /orgs/{org}/interaction-limits
-func (s *InteractionsService) UpdateRestrictionsForOrg(ctx context.Context, organization, limit string) (*InteractionRestriction, *Response, error) {
+func (s *InteractionsService) UpdateRestrictionsForOrg(ctx context.Context, organization string, limit InteractionLimit, expiry InteractionExpiry) (*InteractionRestriction, *Response, error) {
 	u := fmt.Sprintf("orgs/%v/interaction-limits", organization)
 
-	interaction := &InteractionRestriction{Limit: String(limit)}
+	interaction := &interactionRestrictionRequest{Limit: limit, Expiry: expiry}
 
 	req, err := s.client.NewRequest("PUT", u, interaction)
 	if err != nil {
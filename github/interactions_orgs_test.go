@@ -31,7 +31,7 @@ func TestInteractionsService_GetRestrictionsForOrgs(t *testing.T) {
 		t.Errorf("Interactions.GetRestrictionsForOrg returned error: %v", err)
 	}
 
-	want := &InteractionRestriction{Origin: String("organization")}
+	want := &InteractionRestriction{Origin: Ptr("organization")}
 	if !cmp.Equal(organizationInteractions, want) {
 		t.Errorf("Interactions.GetRestrictionsForOrg returned %+v, want %+v", organizationInteractions, want)
 	}
@@ -55,10 +55,10 @@ func TestInteractionsService_UpdateRestrictionsForOrg(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &InteractionRestriction{Limit: String("existing_users")}
+	input := &interactionRestrictionRequest{Limit: InteractionLimitExistingUsers, Expiry: InteractionExpiryOneMonth}
 
 	mux.HandleFunc("/orgs/o/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
-		v := new(InteractionRestriction)
+		v := new(interactionRestrictionRequest)
 		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
 
 		testMethod(t, r, "PUT")
@@ -70,24 +70,24 @@ func TestInteractionsService_UpdateRestrictionsForOrg(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	organizationInteractions, _, err := client.Interactions.UpdateRestrictionsForOrg(ctx, "o", input.GetLimit())
+	organizationInteractions, _, err := client.Interactions.UpdateRestrictionsForOrg(ctx, "o", input.Limit, input.Expiry)
 	if err != nil {
 		t.Errorf("Interactions.UpdateRestrictionsForOrg returned error: %v", err)
 	}
 
-	want := &InteractionRestriction{Origin: String("organization")}
+	want := &InteractionRestriction{Origin: Ptr("organization")}
 	if !cmp.Equal(organizationInteractions, want) {
 		t.Errorf("Interactions.UpdateRestrictionsForOrg returned %+v, want %+v", organizationInteractions, want)
 	}
 
 	const methodName = "UpdateRestrictionsForOrg"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Interactions.UpdateRestrictionsForOrg(ctx, "\n", input.GetLimit())
+		_, _, err = client.Interactions.UpdateRestrictionsForOrg(ctx, "\n", input.Limit, input.Expiry)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		got, resp, err := client.Interactions.UpdateRestrictionsForOrg(ctx, "o", input.GetLimit())
+		got, resp, err := client.Interactions.UpdateRestrictionsForOrg(ctx, "o", input.Limit, input.Expiry)
 		if got != nil {
 			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
 		}
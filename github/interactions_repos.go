@@ -39,15 +39,15 @@ func (s *InteractionsService) GetRestrictionsForRepo(ctx context.Context, owner,
 //
 // limit specifies the group of GitHub users who can comment, open issues, or create pull requests
 // for the given repository.
-// Possible values are: "existing_users", "contributors_only", "collaborators_only".
+// expiry specifies how long the restrictions last; if empty, they expire after one day.
 //
 // GitHub API docs: https://docs.github.com/rest/interactions/repos#set-interaction-restrictions-for-a-repository
 //
 //meta:operation PUT /repos/{owner}/{repo}/interaction-limits
-func (s *InteractionsService) UpdateRestrictionsForRepo(ctx context.Context, owner, repo, limit string) (*InteractionRestriction, *Response, error) {
+func (s *InteractionsService) UpdateRestrictionsForRepo(ctx context.Context, owner, repo string, limit InteractionLimit, expiry InteractionExpiry) (*InteractionRestriction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/interaction-limits", owner, repo)
 
-	interaction := &InteractionRestriction{Limit: String(limit)}
+	interaction := &interactionRestrictionRequest{Limit: limit, Expiry: expiry}
 
 	req, err := s.client.NewRequest("PUT", u, interaction)
 	if err != nil {
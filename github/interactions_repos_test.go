@@ -31,7 +31,7 @@ func TestInteractionsService_GetRestrictionsForRepo(t *testing.T) {
 		t.Errorf("Interactions.GetRestrictionsForRepo returned error: %v", err)
 	}
 
-	want := &InteractionRestriction{Origin: String("repository")}
+	want := &InteractionRestriction{Origin: Ptr("repository")}
 	if !cmp.Equal(repoInteractions, want) {
 		t.Errorf("Interactions.GetRestrictionsForRepo returned %+v, want %+v", repoInteractions, want)
 	}
@@ -55,10 +55,10 @@ func TestInteractionsService_UpdateRestrictionsForRepo(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &InteractionRestriction{Limit: String("existing_users")}
+	input := &interactionRestrictionRequest{Limit: InteractionLimitExistingUsers, Expiry: InteractionExpiryOneMonth}
 
 	mux.HandleFunc("/repos/o/r/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
-		v := new(InteractionRestriction)
+		v := new(interactionRestrictionRequest)
 		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
 
 		testMethod(t, r, "PUT")
@@ -70,24 +70,24 @@ func TestInteractionsService_UpdateRestrictionsForRepo(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	repoInteractions, _, err := client.Interactions.UpdateRestrictionsForRepo(ctx, "o", "r", input.GetLimit())
+	repoInteractions, _, err := client.Interactions.UpdateRestrictionsForRepo(ctx, "o", "r", input.Limit, input.Expiry)
 	if err != nil {
 		t.Errorf("Interactions.UpdateRestrictionsForRepo returned error: %v", err)
 	}
 
-	want := &InteractionRestriction{Origin: String("repository")}
+	want := &InteractionRestriction{Origin: Ptr("repository")}
 	if !cmp.Equal(repoInteractions, want) {
 		t.Errorf("Interactions.UpdateRestrictionsForRepo returned %+v, want %+v", repoInteractions, want)
 	}
 
 	const methodName = "UpdateRestrictionsForRepo"
 	testBadOptions(t, methodName, func() (err error) {
-		_, _, err = client.Interactions.UpdateRestrictionsForRepo(ctx, "\n", "\n", input.GetLimit())
+		_, _, err = client.Interactions.UpdateRestrictionsForRepo(ctx, "\n", "\n", input.Limit, input.Expiry)
 		return err
 	})
 
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		got, resp, err := client.Interactions.UpdateRestrictionsForRepo(ctx, "o", "r", input.GetLimit())
+		got, resp, err := client.Interactions.UpdateRestrictionsForRepo(ctx, "o", "r", input.Limit, input.Expiry)
 		if got != nil {
 			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
 		}
@@ -11,13 +11,13 @@ func TestInteractionRestriction_Marshal(t *testing.T) {
 	testJSONMarshal(t, &InteractionRestriction{}, "{}")
 
 	u := &InteractionRestriction{
-		Limit:     String("limit"),
-		Origin:    String("origin"),
+		Limit:     Ptr(InteractionLimitExistingUsers),
+		Origin:    Ptr("origin"),
 		ExpiresAt: &Timestamp{referenceTime},
 	}
 
 	want := `{
-		"limit": "limit",
+		"limit": "existing_users",
 		"origin": "origin",
 		"expires_at": ` + referenceTimeStr + `
 	}`
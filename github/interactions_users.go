@@ -0,0 +1,84 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+)
+
+// GetRestrictionsForUser fetches the interaction restrictions that are active
+// on public repositories owned by the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/rest/interactions/user#get-interaction-restrictions-for-your-public-repositories
+//
+//meta:operation GET /user/interaction-limits
+func (s *InteractionsService) GetRestrictionsForUser(ctx context.Context) (*InteractionRestriction, *Response, error) {
+	req, err := s.client.NewRequest("GET", "user/interaction-limits", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeInteractionRestrictionsPreview)
+
+	userInteractions := new(InteractionRestriction)
+
+	resp, err := s.client.Do(ctx, req, userInteractions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return userInteractions, resp, nil
+}
+
+// UpdateRestrictionsForUser sets the interaction restrictions on all public
+// repositories owned by the authenticated user.
+//
+// limit specifies the group of GitHub users who can comment, open issues, or create pull requests
+// in public repositories owned by the user.
+// expiry specifies how long the restrictions last; if empty, they expire after one day.
+//
+// GitHub API docs: https://docs.github.com/rest/interactions/user#set-interaction-restrictions-for-your-public-repositories
+//
+//meta:operation PUT /user/interaction-limits
+func (s *InteractionsService) UpdateRestrictionsForUser(ctx context.Context, limit InteractionLimit, expiry InteractionExpiry) (*InteractionRestriction, *Response, error) {
+	body := &interactionRestrictionRequest{Limit: limit, Expiry: expiry}
+
+	req, err := s.client.NewRequest("PUT", "user/interaction-limits", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeInteractionRestrictionsPreview)
+
+	userInteractions := new(InteractionRestriction)
+
+	resp, err := s.client.Do(ctx, req, userInteractions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return userInteractions, resp, nil
+}
+
+// RemoveRestrictionsFromUser removes the interaction restrictions from all
+// public repositories owned by the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/rest/interactions/user#remove-interaction-restrictions-from-your-public-repositories
+//
+//meta:operation DELETE /user/interaction-limits
+func (s *InteractionsService) RemoveRestrictionsFromUser(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "user/interaction-limits", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeInteractionRestrictionsPreview)
+
+	return s.client.Do(ctx, req, nil)
+}
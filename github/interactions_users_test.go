@@ -0,0 +1,115 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInteractionsService_GetRestrictionsForUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		fmt.Fprint(w, `{"limit":"collaborators_only","origin":"user","expires_at":"2024-01-02T03:04:05Z"}`)
+	})
+
+	ctx := context.Background()
+	userInteractions, _, err := client.Interactions.GetRestrictionsForUser(ctx)
+	if err != nil {
+		t.Errorf("Interactions.GetRestrictionsForUser returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{
+		Limit:     Ptr(InteractionLimitCollaboratorsOnly),
+		Origin:    Ptr("user"),
+		ExpiresAt: &Timestamp{time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)},
+	}
+	if !cmp.Equal(userInteractions, want) {
+		t.Errorf("Interactions.GetRestrictionsForUser returned %+v, want %+v", userInteractions, want)
+	}
+
+	const methodName = "GetRestrictionsForUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.GetRestrictionsForUser(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestInteractionsService_UpdateRestrictionsForUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		v := new(interactionRestrictionRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		want := &interactionRestrictionRequest{
+			Limit:  InteractionLimitExistingUsers,
+			Expiry: InteractionExpiryOneWeek,
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		fmt.Fprint(w, `{"limit":"existing_users","origin":"user"}`)
+	})
+
+	ctx := context.Background()
+	userInteractions, _, err := client.Interactions.UpdateRestrictionsForUser(ctx, InteractionLimitExistingUsers, InteractionExpiryOneWeek)
+	if err != nil {
+		t.Errorf("Interactions.UpdateRestrictionsForUser returned error: %v", err)
+	}
+
+	want := &InteractionRestriction{Limit: Ptr(InteractionLimitExistingUsers), Origin: Ptr("user")}
+	if !cmp.Equal(userInteractions, want) {
+		t.Errorf("Interactions.UpdateRestrictionsForUser returned %+v, want %+v", userInteractions, want)
+	}
+
+	const methodName = "UpdateRestrictionsForUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Interactions.UpdateRestrictionsForUser(ctx, InteractionLimitExistingUsers, InteractionExpiryOneWeek)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestInteractionsService_RemoveRestrictionsFromUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypeInteractionRestrictionsPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Interactions.RemoveRestrictionsFromUser(ctx)
+	if err != nil {
+		t.Errorf("Interactions.RemoveRestrictionsFromUser returned error: %v", err)
+	}
+
+	const methodName = "RemoveRestrictionsFromUser"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Interactions.RemoveRestrictionsFromUser(ctx)
+	})
+}
@@ -34,8 +34,8 @@ func TestIssuesService_ListComments_allIssues(t *testing.T) {
 
 	since := time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC)
 	opt := &IssueListCommentsOptions{
-		Sort:        String("updated"),
-		Direction:   String("desc"),
+		Sort:        Ptr("updated"),
+		Direction:   Ptr("desc"),
 		Since:       &since,
 		ListOptions: ListOptions{Page: 2},
 	}
@@ -45,7 +45,7 @@ func TestIssuesService_ListComments_allIssues(t *testing.T) {
 		t.Errorf("Issues.ListComments returned error: %v", err)
 	}
 
-	want := []*IssueComment{{ID: Int64(1)}}
+	want := []*IssueComment{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(comments, want) {
 		t.Errorf("Issues.ListComments returned %+v, want %+v", comments, want)
 	}
@@ -81,7 +81,7 @@ func TestIssuesService_ListComments_specificIssue(t *testing.T) {
 		t.Errorf("Issues.ListComments returned error: %v", err)
 	}
 
-	want := []*IssueComment{{ID: Int64(1)}}
+	want := []*IssueComment{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(comments, want) {
 		t.Errorf("Issues.ListComments returned %+v, want %+v", comments, want)
 	}
@@ -126,7 +126,7 @@ func TestIssuesService_GetComment(t *testing.T) {
 		t.Errorf("Issues.GetComment returned error: %v", err)
 	}
 
-	want := &IssueComment{ID: Int64(1)}
+	want := &IssueComment{ID: Ptr(int64(1))}
 	if !cmp.Equal(comment, want) {
 		t.Errorf("Issues.GetComment returned %+v, want %+v", comment, want)
 	}
@@ -159,7 +159,7 @@ func TestIssuesService_CreateComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &IssueComment{Body: String("b")}
+	input := &IssueComment{Body: Ptr("b")}
 
 	mux.HandleFunc("/repos/o/r/issues/1/comments", func(w http.ResponseWriter, r *http.Request) {
 		v := new(IssueComment)
@@ -179,7 +179,7 @@ func TestIssuesService_CreateComment(t *testing.T) {
 		t.Errorf("Issues.CreateComment returned error: %v", err)
 	}
 
-	want := &IssueComment{ID: Int64(1)}
+	want := &IssueComment{ID: Ptr(int64(1))}
 	if !cmp.Equal(comment, want) {
 		t.Errorf("Issues.CreateComment returned %+v, want %+v", comment, want)
 	}
@@ -212,7 +212,7 @@ func TestIssuesService_EditComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &IssueComment{Body: String("b")}
+	input := &IssueComment{Body: Ptr("b")}
 
 	mux.HandleFunc("/repos/o/r/issues/comments/1", func(w http.ResponseWriter, r *http.Request) {
 		v := new(IssueComment)
@@ -232,7 +232,7 @@ func TestIssuesService_EditComment(t *testing.T) {
 		t.Errorf("Issues.EditComment returned error: %v", err)
 	}
 
-	want := &IssueComment{ID: Int64(1)}
+	want := &IssueComment{ID: Ptr(int64(1))}
 	if !cmp.Equal(comment, want) {
 		t.Errorf("Issues.EditComment returned %+v, want %+v", comment, want)
 	}
@@ -299,36 +299,36 @@ func TestIssueComment_Marshal(t *testing.T) {
 	testJSONMarshal(t, &IssueComment{}, "{}")
 
 	u := &IssueComment{
-		ID:     Int64(1),
-		NodeID: String("nid"),
-		Body:   String("body"),
+		ID:     Ptr(int64(1)),
+		NodeID: Ptr("nid"),
+		Body:   Ptr("body"),
 		User: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Reactions:         &Reactions{TotalCount: Int(1)},
+		Reactions:         &Reactions{TotalCount: Ptr(1)},
 		CreatedAt:         &Timestamp{referenceTime},
 		UpdatedAt:         &Timestamp{referenceTime},
-		AuthorAssociation: String("aa"),
-		URL:               String("url"),
-		HTMLURL:           String("hurl"),
-		IssueURL:          String("iurl"),
+		AuthorAssociation: Ptr("aa"),
+		URL:               Ptr("url"),
+		HTMLURL:           Ptr("hurl"),
+		IssueURL:          Ptr("iurl"),
 	}
 
 	want := `{
@@ -35,7 +35,7 @@ func TestIssuesService_ListIssueEvents(t *testing.T) {
 		t.Errorf("Issues.ListIssueEvents returned error: %v", err)
 	}
 
-	want := []*IssueEvent{{ID: Int64(1)}}
+	want := []*IssueEvent{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(events, want) {
 		t.Errorf("Issues.ListIssueEvents returned %+v, want %+v", events, want)
 	}
@@ -75,7 +75,7 @@ func TestIssuesService_ListRepositoryEvents(t *testing.T) {
 		t.Errorf("Issues.ListRepositoryEvents returned error: %v", err)
 	}
 
-	want := []*IssueEvent{{ID: Int64(1)}}
+	want := []*IssueEvent{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(events, want) {
 		t.Errorf("Issues.ListRepositoryEvents returned %+v, want %+v", events, want)
 	}
@@ -110,7 +110,7 @@ func TestIssuesService_GetEvent(t *testing.T) {
 		t.Errorf("Issues.GetEvent returned error: %v", err)
 	}
 
-	want := &IssueEvent{ID: Int64(1)}
+	want := &IssueEvent{ID: Ptr(int64(1))}
 	if !cmp.Equal(event, want) {
 		t.Errorf("Issues.GetEvent returned %+v, want %+v", event, want)
 	}
@@ -134,8 +134,8 @@ func TestRename_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Rename{}, "{}")
 
 	u := &Rename{
-		From: String("from"),
-		To:   String("to"),
+		From: Ptr("from"),
+		To:   Ptr("to"),
 	}
 
 	want := `{
@@ -150,10 +150,10 @@ func TestDismissedReview_Marshal(t *testing.T) {
 	testJSONMarshal(t, &DismissedReview{}, "{}")
 
 	u := &DismissedReview{
-		State:             String("state"),
-		ReviewID:          Int64(1),
-		DismissalMessage:  String("dm"),
-		DismissalCommitID: String("dcid"),
+		State:             Ptr("state"),
+		ReviewID:          Ptr(int64(1)),
+		DismissalMessage:  Ptr("dm"),
+		DismissalCommitID: Ptr("dcid"),
 	}
 
 	want := `{
@@ -170,179 +170,179 @@ func TestIssueEvent_Marshal(t *testing.T) {
 	testJSONMarshal(t, &IssueEvent{}, "{}")
 
 	u := &IssueEvent{
-		ID:  Int64(1),
-		URL: String("url"),
+		ID:  Ptr(int64(1)),
+		URL: Ptr("url"),
 		Actor: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Event:     String("event"),
+		Event:     Ptr("event"),
 		CreatedAt: &Timestamp{referenceTime},
-		Issue:     &Issue{ID: Int64(1)},
+		Issue:     &Issue{ID: Ptr(int64(1))},
 		Assignee: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
 		Assigner: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		CommitID:  String("cid"),
-		Milestone: &Milestone{ID: Int64(1)},
-		Label:     &Label{ID: Int64(1)},
+		CommitID:  Ptr("cid"),
+		Milestone: &Milestone{ID: Ptr(int64(1))},
+		Label:     &Label{ID: Ptr(int64(1))},
 		Rename: &Rename{
-			From: String("from"),
-			To:   String("to"),
+			From: Ptr("from"),
+			To:   Ptr("to"),
 		},
-		LockReason:  String("lr"),
-		ProjectCard: &ProjectCard{ID: Int64(1)},
+		LockReason:  Ptr("lr"),
+		ProjectCard: &ProjectCard{ID: Ptr(int64(1))},
 		DismissedReview: &DismissedReview{
-			State:             String("state"),
-			ReviewID:          Int64(1),
-			DismissalMessage:  String("dm"),
-			DismissalCommitID: String("dcid"),
+			State:             Ptr("state"),
+			ReviewID:          Ptr(int64(1)),
+			DismissalMessage:  Ptr("dm"),
+			DismissalCommitID: Ptr("dcid"),
 		},
 		RequestedReviewer: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
 		RequestedTeam: &Team{
-			ID:              Int64(1),
-			NodeID:          String("n"),
-			Name:            String("n"),
-			Description:     String("d"),
-			URL:             String("u"),
-			Slug:            String("s"),
-			Permission:      String("p"),
-			Privacy:         String("p"),
-			MembersCount:    Int(1),
-			ReposCount:      Int(1),
-			MembersURL:      String("m"),
-			RepositoriesURL: String("r"),
+			ID:              Ptr(int64(1)),
+			NodeID:          Ptr("n"),
+			Name:            Ptr("n"),
+			Description:     Ptr("d"),
+			URL:             Ptr("u"),
+			Slug:            Ptr("s"),
+			Permission:      Ptr("p"),
+			Privacy:         Ptr("p"),
+			MembersCount:    Ptr(1),
+			ReposCount:      Ptr(1),
+			MembersURL:      Ptr("m"),
+			RepositoriesURL: Ptr("r"),
 			Organization: &Organization{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				AvatarURL: String("a"),
-				HTMLURL:   String("h"),
-				Name:      String("n"),
-				Company:   String("c"),
-				Blog:      String("b"),
-				Location:  String("l"),
-				Email:     String("e"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				AvatarURL: Ptr("a"),
+				HTMLURL:   Ptr("h"),
+				Name:      Ptr("n"),
+				Company:   Ptr("c"),
+				Blog:      Ptr("b"),
+				Location:  Ptr("l"),
+				Email:     Ptr("e"),
 			},
 			Parent: &Team{
-				ID:           Int64(1),
-				NodeID:       String("n"),
-				Name:         String("n"),
-				Description:  String("d"),
-				URL:          String("u"),
-				Slug:         String("s"),
-				Permission:   String("p"),
-				Privacy:      String("p"),
-				MembersCount: Int(1),
-				ReposCount:   Int(1),
+				ID:           Ptr(int64(1)),
+				NodeID:       Ptr("n"),
+				Name:         Ptr("n"),
+				Description:  Ptr("d"),
+				URL:          Ptr("u"),
+				Slug:         Ptr("s"),
+				Permission:   Ptr("p"),
+				Privacy:      Ptr("p"),
+				MembersCount: Ptr(1),
+				ReposCount:   Ptr(1),
 			},
-			LDAPDN: String("l"),
+			LDAPDN: Ptr("l"),
 		},
 		PerformedViaGithubApp: &App{
-			ID:     Int64(1),
-			NodeID: String("n"),
+			ID:     Ptr(int64(1)),
+			NodeID: Ptr("n"),
 			Owner: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
-			Name:        String("n"),
-			Description: String("d"),
-			HTMLURL:     String("h"),
-			ExternalURL: String("u"),
+			Name:        Ptr("n"),
+			Description: Ptr("d"),
+			HTMLURL:     Ptr("h"),
+			ExternalURL: Ptr("u"),
 		},
 		ReviewRequester: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
@@ -37,7 +37,7 @@ func TestIssuesService_ListIssueTimeline(t *testing.T) {
 		t.Errorf("Issues.ListIssueTimeline returned error: %v", err)
 	}
 
-	want := []*Timeline{{ID: Int64(1)}}
+	want := []*Timeline{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(events, want) {
 		t.Errorf("Issues.ListIssueTimeline = %+v, want %+v", events, want)
 	}
@@ -61,19 +61,19 @@ func TestSource_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Source{}, "{}")
 
 	u := &Source{
-		ID:  Int64(1),
-		URL: String("url"),
+		ID:  Ptr(int64(1)),
+		URL: Ptr("url"),
 		Actor: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
-		Type:  String("type"),
-		Issue: &Issue{ID: Int64(1)},
+		Type:  Ptr("type"),
+		Issue: &Issue{ID: Ptr(int64(1))},
 	}
 
 	want := `{
@@ -101,75 +101,75 @@ func TestTimeline_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Timeline{}, "{}")
 
 	u := &Timeline{
-		ID:        Int64(1),
-		URL:       String("url"),
-		CommitURL: String("curl"),
+		ID:        Ptr(int64(1)),
+		URL:       Ptr("url"),
+		CommitURL: Ptr("curl"),
 		Actor: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Event:     String("event"),
-		CommitID:  String("cid"),
+		Event:     Ptr("event"),
+		CommitID:  Ptr("cid"),
 		CreatedAt: &Timestamp{referenceTime},
-		Label:     &Label{ID: Int64(1)},
+		Label:     &Label{ID: Ptr(int64(1))},
 		Assignee: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Milestone: &Milestone{ID: Int64(1)},
+		Milestone: &Milestone{ID: Ptr(int64(1))},
 		Source: &Source{
-			ID:  Int64(1),
-			URL: String("url"),
+			ID:  Ptr(int64(1)),
+			URL: Ptr("url"),
 			Actor: &User{
-				Login:     String("l"),
-				ID:        Int64(1),
-				NodeID:    String("n"),
-				URL:       String("u"),
-				ReposURL:  String("r"),
-				EventsURL: String("e"),
-				AvatarURL: String("a"),
+				Login:     Ptr("l"),
+				ID:        Ptr(int64(1)),
+				NodeID:    Ptr("n"),
+				URL:       Ptr("u"),
+				ReposURL:  Ptr("r"),
+				EventsURL: Ptr("e"),
+				AvatarURL: Ptr("a"),
 			},
-			Type:  String("type"),
-			Issue: &Issue{ID: Int64(1)},
+			Type:  Ptr("type"),
+			Issue: &Issue{ID: Ptr(int64(1))},
 		},
 		Rename: &Rename{
-			From: String("from"),
-			To:   String("to"),
+			From: Ptr("from"),
+			To:   Ptr("to"),
 		},
-		ProjectCard: &ProjectCard{ID: Int64(1)},
-		State:       String("state"),
+		ProjectCard: &ProjectCard{ID: Ptr(int64(1))},
+		State:       Ptr("state"),
 	}
 
 	want := `{
@@ -18,30 +18,30 @@ func TestRepositoryLicense_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepositoryLicense{}, "{}")
 
 	rl := &RepositoryLicense{
-		Name:        String("n"),
-		Path:        String("p"),
-		SHA:         String("s"),
-		Size:        Int(1),
-		URL:         String("u"),
-		HTMLURL:     String("h"),
-		GitURL:      String("g"),
-		DownloadURL: String("d"),
-		Type:        String("t"),
-		Content:     String("c"),
-		Encoding:    String("e"),
+		Name:        Ptr("n"),
+		Path:        Ptr("p"),
+		SHA:         Ptr("s"),
+		Size:        Ptr(1),
+		URL:         Ptr("u"),
+		HTMLURL:     Ptr("h"),
+		GitURL:      Ptr("g"),
+		DownloadURL: Ptr("d"),
+		Type:        Ptr("t"),
+		Content:     Ptr("c"),
+		Encoding:    Ptr("e"),
 		License: &License{
-			Key:            String("k"),
-			Name:           String("n"),
-			URL:            String("u"),
-			SPDXID:         String("s"),
-			HTMLURL:        String("h"),
-			Featured:       Bool(true),
-			Description:    String("d"),
-			Implementation: String("i"),
+			Key:            Ptr("k"),
+			Name:           Ptr("n"),
+			URL:            Ptr("u"),
+			SPDXID:         Ptr("s"),
+			HTMLURL:        Ptr("h"),
+			Featured:       Ptr(true),
+			Description:    Ptr("d"),
+			Implementation: Ptr("i"),
 			Permissions:    &[]string{"p"},
 			Conditions:     &[]string{"c"},
 			Limitations:    &[]string{"l"},
-			Body:           String("b"),
+			Body:           Ptr("b"),
 		},
 	}
 	want := `{
@@ -78,18 +78,18 @@ func TestLicense_Marshal(t *testing.T) {
 	testJSONMarshal(t, &License{}, "{}")
 
 	l := &License{
-		Key:            String("k"),
-		Name:           String("n"),
-		URL:            String("u"),
-		SPDXID:         String("s"),
-		HTMLURL:        String("h"),
-		Featured:       Bool(true),
-		Description:    String("d"),
-		Implementation: String("i"),
+		Key:            Ptr("k"),
+		Name:           Ptr("n"),
+		URL:            Ptr("u"),
+		SPDXID:         Ptr("s"),
+		HTMLURL:        Ptr("h"),
+		Featured:       Ptr(true),
+		Description:    Ptr("d"),
+		Implementation: Ptr("i"),
 		Permissions:    &[]string{"p"},
 		Conditions:     &[]string{"c"},
 		Limitations:    &[]string{"l"},
-		Body:           String("b"),
+		Body:           Ptr("b"),
 	}
 	want := `{
 		"key": "k",
@@ -124,11 +124,11 @@ func TestLicensesService_List(t *testing.T) {
 	}
 
 	want := []*License{{
-		Key:      String("mit"),
-		Name:     String("MIT"),
-		SPDXID:   String("MIT"),
-		URL:      String("https://api.github.com/licenses/mit"),
-		Featured: Bool(true),
+		Key:      Ptr("mit"),
+		Name:     Ptr("MIT"),
+		SPDXID:   Ptr("MIT"),
+		URL:      Ptr("https://api.github.com/licenses/mit"),
+		Featured: Ptr(true),
 	}}
 	if !cmp.Equal(licenses, want) {
 		t.Errorf("Licenses.List returned %+v, want %+v", licenses, want)
@@ -159,7 +159,7 @@ func TestLicensesService_Get(t *testing.T) {
 		t.Errorf("Licenses.Get returned error: %v", err)
 	}
 
-	want := &License{Key: String("mit"), Name: String("MIT")}
+	want := &License{Key: Ptr("mit"), Name: Ptr("MIT")}
 	if !cmp.Equal(license, want) {
 		t.Errorf("Licenses.Get returned %+v, want %+v", license, want)
 	}
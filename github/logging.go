@@ -0,0 +1,79 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithLogger returns a copy of the client that logs every API request it
+// sends to logger. Successful requests are logged at slog.LevelDebug and
+// failed requests at slog.LevelWarn, with the attributes:
+//
+//   - method: the HTTP method
+//   - url: the request URL, with secrets such as client_secret redacted
+//   - endpoint: the documented endpoint, e.g. "GET /repos/{owner}/{repo}", if known
+//   - status: the response status code
+//   - duration: how long the request took, including any retries
+//   - rate_remaining: the remaining requests in the current rate limit window
+//   - error: the error, if the request could not be completed
+//
+// Request and response headers and bodies are never logged. Passing a nil
+// logger disables logging.
+func (c *Client) WithLogger(logger *slog.Logger) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.logger = logger
+	return c2
+}
+
+// logRequest logs the outcome of sending req, if the client has a logger.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	if c.logger == nil {
+		return
+	}
+
+	u := *req.URL
+	u.User = nil
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", sanitizeURL(&u).String()),
+	}
+	if t := matchOperation(req.Method, c.apiPath(req.URL)); t != nil {
+		attrs = append(attrs, slog.String("endpoint", t.name))
+	}
+
+	level := slog.LevelDebug
+	if err != nil {
+		level = slog.LevelWarn
+		// A *url.Error includes the unredacted URL, which is logged above.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+	} else {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if resp.StatusCode >= 400 {
+			level = slog.LevelWarn
+		}
+	}
+	attrs = append(attrs, slog.Duration("duration", duration))
+	if resp != nil {
+		if v := resp.Header.Get(headerRateRemaining); v != "" {
+			attrs = append(attrs, slog.String("rate_remaining", v))
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	c.logger.LogAttrs(ctx, level, "GitHub API request", attrs...)
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWithLogger(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == "duration" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	client = client.WithLogger(logger)
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateRemaining, "42")
+	})
+	mux.HandleFunc("/repos/o/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", "repos/o/r?client_secret=s3cr3t", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	req, _ = client.NewRequest("GET", "repos/o/missing", nil)
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Fatal("Do returned nil error, want error")
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("logged %v lines, want 2:\n%v", len(lines), buf.String())
+	}
+	if strings.Contains(buf.String(), "s3cr3t") {
+		t.Errorf("log contains client_secret:\n%v", buf.String())
+	}
+	for i, want := range []string{
+		`level=DEBUG msg="GitHub API request" method=GET url="` + client.BaseURL.String() + `repos/o/r?client_secret=REDACTED" endpoint="GET /repos/{owner}/{repo}" status=200 rate_remaining=42`,
+		`level=WARN msg="GitHub API request" method=GET url=` + client.BaseURL.String() + `repos/o/missing endpoint="GET /repos/{owner}/{repo}" status=404`,
+	} {
+		if got := lines[i]; got != want {
+			t.Errorf("log line %v is\n%v\nwant\n%v", i, got, want)
+		}
+	}
+
+	// Without a logger nothing is logged.
+	buf.Reset()
+	client = client.WithLogger(nil)
+	req, _ = client.NewRequest("GET", "repos/o/r", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("logged %q with a nil logger, want nothing", buf.String())
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/netip"
 	"net/url"
 )
 
@@ -73,6 +74,31 @@ type APIMeta struct {
 	// A map of GitHub services and their associated domains. Note that many
 	// of these domains are represented as wildcards (e.g. "*.github.com").
 	Domains map[string][]string `json:"domains,omitempty"`
+
+	// The version of GitHub Enterprise Server, for example "3.12.4".
+	// Only returned by GitHub Enterprise Server.
+	InstalledVersion *string `json:"installed_version,omitempty"`
+}
+
+// ParseIPRanges parses a list of IP ranges as returned in the APIMeta
+// Hooks, Git, Pages, Importer, Actions, and similar fields into typed
+// prefixes suitable for firewall rules. Plain IP addresses without a prefix
+// length are returned as single-address prefixes (/32 or /128).
+func ParseIPRanges(ranges []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(ranges))
+	for _, r := range ranges {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(r)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid IP range %q: %w", r, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	return prefixes, nil
 }
 
 // Get returns information about GitHub.com, the service. Or, if you access
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -20,7 +21,7 @@ func TestAPIMeta_Marshal(t *testing.T) {
 	a := &APIMeta{
 		Hooks:                            []string{"h"},
 		Git:                              []string{"g"},
-		VerifiablePasswordAuthentication: Bool(true),
+		VerifiablePasswordAuthentication: Ptr(true),
 		Pages:                            []string{"p"},
 		Importer:                         []string{"i"},
 		GithubEnterpriseImporter:         []string{"gei"},
@@ -82,7 +83,7 @@ func TestMetaService_Get(t *testing.T) {
 			"example": {"example.com", "*.example.com"},
 		},
 
-		VerifiablePasswordAuthentication: Bool(true),
+		VerifiablePasswordAuthentication: Ptr(true),
 	}
 	if !cmp.Equal(want, meta) {
 		t.Errorf("Get returned %+v, want %+v", meta, want)
@@ -163,3 +164,23 @@ func TestMetaService_Zen(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestParseIPRanges(t *testing.T) {
+	got, err := ParseIPRanges([]string{"192.30.252.0/22", "2a0a:a440::/29", "20.201.28.151"})
+	if err != nil {
+		t.Fatalf("ParseIPRanges returned error: %v", err)
+	}
+
+	want := []netip.Prefix{
+		netip.MustParsePrefix("192.30.252.0/22"),
+		netip.MustParsePrefix("2a0a:a440::/29"),
+		netip.MustParsePrefix("20.201.28.151/32"),
+	}
+	if !cmp.Equal(got, want, cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })) {
+		t.Errorf("ParseIPRanges returned %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseIPRanges([]string{"not-an-ip"}); err == nil {
+		t.Error("ParseIPRanges returned nil error for an invalid range, want error")
+	}
+}
@@ -37,8 +37,8 @@ type Migration struct {
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool         `json:"exclude_attachments,omitempty"`
 	URL                *string       `json:"url,omitempty"`
-	CreatedAt          *string       `json:"created_at,omitempty"`
-	UpdatedAt          *string       `json:"updated_at,omitempty"`
+	CreatedAt          *Timestamp    `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp    `json:"updated_at,omitempty"`
 	Repositories       []*Repository `json:"repositories,omitempty"`
 }
 
@@ -82,8 +82,8 @@ func (s *MigrationService) StartMigration(ctx context.Context, org string, repos
 
 	body := &startMigration{Repositories: repos}
 	if opts != nil {
-		body.LockRepositories = Bool(opts.LockRepositories)
-		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		body.LockRepositories = Ptr(opts.LockRepositories)
+		body.ExcludeAttachments = Ptr(opts.ExcludeAttachments)
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -234,20 +235,20 @@ var migrationJSON = []byte(`{
 }`)
 
 var wantMigration = &Migration{
-	ID:                 Int64(79),
-	GUID:               String("0b989ba4-242f-11e5-81e1-c7b6966d2516"),
-	State:              String("pending"),
-	LockRepositories:   Bool(true),
-	ExcludeAttachments: Bool(false),
-	URL:                String("https://api.github.com/orgs/octo-org/migrations/79"),
-	CreatedAt:          String("2015-07-06T15:33:38-07:00"),
-	UpdatedAt:          String("2015-07-06T15:33:38-07:00"),
+	ID:                 Ptr(int64(79)),
+	GUID:               Ptr("0b989ba4-242f-11e5-81e1-c7b6966d2516"),
+	State:              Ptr("pending"),
+	LockRepositories:   Ptr(true),
+	ExcludeAttachments: Ptr(false),
+	URL:                Ptr("https://api.github.com/orgs/octo-org/migrations/79"),
+	CreatedAt:          &Timestamp{time.Date(2015, time.July, 6, 22, 33, 38, 0, time.UTC)},
+	UpdatedAt:          &Timestamp{time.Date(2015, time.July, 6, 22, 33, 38, 0, time.UTC)},
 	Repositories: []*Repository{
 		{
-			ID:          Int64(1296269),
-			Name:        String("Hello-World"),
-			FullName:    String("octocat/Hello-World"),
-			Description: String("This your first repo!"),
+			ID:          Ptr(int64(1296269)),
+			Name:        Ptr("Hello-World"),
+			FullName:    Ptr("octocat/Hello-World"),
+			Description: Ptr("This your first repo!"),
 		},
 	},
 }
@@ -256,15 +257,15 @@ func TestMigration_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Migration{}, "{}")
 
 	u := &Migration{
-		ID:                 Int64(1),
-		GUID:               String("guid"),
-		State:              String("state"),
-		LockRepositories:   Bool(false),
-		ExcludeAttachments: Bool(false),
-		URL:                String("url"),
-		CreatedAt:          String("ca"),
-		UpdatedAt:          String("ua"),
-		Repositories:       []*Repository{{ID: Int64(1)}},
+		ID:                 Ptr(int64(1)),
+		GUID:               Ptr("guid"),
+		State:              Ptr("state"),
+		LockRepositories:   Ptr(false),
+		ExcludeAttachments: Ptr(false),
+		URL:                Ptr("url"),
+		CreatedAt:          &Timestamp{referenceTime},
+		UpdatedAt:          &Timestamp{referenceTime},
+		Repositories:       []*Repository{{ID: Ptr(int64(1))}},
 	}
 
 	want := `{
@@ -274,8 +275,8 @@ func TestMigration_Marshal(t *testing.T) {
 		"lock_repositories": false,
 		"exclude_attachments": false,
 		"url": "url",
-		"created_at": "ca",
-		"updated_at": "ua",
+		"created_at": ` + referenceTimeStr + `,
+		"updated_at": ` + referenceTimeStr + `,
 		"repositories": [
 			{
 				"id": 1
@@ -291,8 +292,8 @@ func TestStartMigration_Marshal(t *testing.T) {
 
 	u := &startMigration{
 		Repositories:       []string{"r"},
-		LockRepositories:   Bool(false),
-		ExcludeAttachments: Bool(false),
+		LockRepositories:   Ptr(false),
+		ExcludeAttachments: Ptr(false),
 	}
 
 	want := `{
@@ -30,8 +30,8 @@ type UserMigration struct {
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool         `json:"exclude_attachments,omitempty"`
 	URL                *string       `json:"url,omitempty"`
-	CreatedAt          *string       `json:"created_at,omitempty"`
-	UpdatedAt          *string       `json:"updated_at,omitempty"`
+	CreatedAt          *Timestamp    `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp    `json:"updated_at,omitempty"`
 	Repositories       []*Repository `json:"repositories,omitempty"`
 }
 
@@ -75,8 +75,8 @@ func (s *MigrationService) StartUserMigration(ctx context.Context, repos []strin
 
 	body := &startUserMigration{Repositories: repos}
 	if opts != nil {
-		body.LockRepositories = Bool(opts.LockRepositories)
-		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		body.LockRepositories = Ptr(opts.LockRepositories)
+		body.ExcludeAttachments = Ptr(opts.ExcludeAttachments)
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -228,3 +228,33 @@ func (s *MigrationService) UnlockUserRepo(ctx context.Context, id int64, repo st
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// ListUserMigrationRepositories lists the repositories included in a user migration.
+// id is the migration ID.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/users#list-repositories-for-a-user-migration
+//
+//meta:operation GET /user/migrations/{migration_id}/repositories
+func (s *MigrationService) ListUserMigrationRepositories(ctx context.Context, id int64, opts *ListOptions) ([]*Repository, *Response, error) {
+	u := fmt.Sprintf("user/migrations/%v/repositories", id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeMigrationsPreview)
+
+	var repos []*Repository
+	resp, err := s.client.Do(ctx, req, &repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, nil
+}
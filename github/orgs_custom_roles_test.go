@@ -29,7 +29,7 @@ func TestOrganizationsService_ListRoles(t *testing.T) {
 		t.Errorf("Organizations.ListRoles returned error: %v", err)
 	}
 
-	want := &OrganizationCustomRoles{TotalCount: Int(1), CustomRepoRoles: []*CustomOrgRoles{{ID: Int64(1), Name: String("Auditor"), Permissions: []string{"read_audit_logs"}}}}
+	want := &OrganizationCustomRoles{TotalCount: Ptr(1), CustomRepoRoles: []*CustomOrgRoles{{ID: Ptr(int64(1)), Name: Ptr("Auditor"), Permissions: []string{"read_audit_logs"}}}}
 	if !cmp.Equal(apps, want) {
 		t.Errorf("Organizations.ListRoles returned %+v, want %+v", apps, want)
 	}
@@ -61,8 +61,8 @@ func TestOrganizationsService_CreateCustomOrgRole(t *testing.T) {
 	ctx := context.Background()
 
 	opts := &CreateOrUpdateOrgRoleOptions{
-		Name:        String("Reader"),
-		Description: String("A role for reading custom org roles"),
+		Name:        Ptr("Reader"),
+		Description: Ptr("A role for reading custom org roles"),
 		Permissions: []string{"read_organization_custom_org_role"},
 	}
 	gotRoles, _, err := client.Organizations.CreateCustomOrgRole(ctx, "o", opts)
@@ -70,7 +70,7 @@ func TestOrganizationsService_CreateCustomOrgRole(t *testing.T) {
 		t.Errorf("Organizations.CreateCustomOrgRole returned error: %v", err)
 	}
 
-	want := &CustomOrgRoles{ID: Int64(8030), Name: String("Reader"), Permissions: []string{"read_organization_custom_org_role"}, Description: String("A role for reading custom org roles")}
+	want := &CustomOrgRoles{ID: Ptr(int64(8030)), Name: Ptr("Reader"), Permissions: []string{"read_organization_custom_org_role"}, Description: Ptr("A role for reading custom org roles")}
 
 	if !cmp.Equal(gotRoles, want) {
 		t.Errorf("Organizations.CreateCustomOrgRole returned %+v, want %+v", gotRoles, want)
@@ -103,15 +103,15 @@ func TestOrganizationsService_UpdateCustomOrgRole(t *testing.T) {
 	ctx := context.Background()
 
 	opts := &CreateOrUpdateOrgRoleOptions{
-		Name:        String("Updated Name"),
-		Description: String("Updated Description"),
+		Name:        Ptr("Updated Name"),
+		Description: Ptr("Updated Description"),
 	}
 	gotRoles, _, err := client.Organizations.UpdateCustomOrgRole(ctx, "o", 8030, opts)
 	if err != nil {
 		t.Errorf("Organizations.UpdateCustomOrgRole returned error: %v", err)
 	}
 
-	want := &CustomOrgRoles{ID: Int64(8030), Name: String("Updated Name"), Permissions: []string{"read_organization_custom_org_role"}, Description: String("Updated Description")}
+	want := &CustomOrgRoles{ID: Ptr(int64(8030)), Name: Ptr("Updated Name"), Permissions: []string{"read_organization_custom_org_role"}, Description: Ptr("Updated Description")}
 
 	if !cmp.Equal(gotRoles, want) {
 		t.Errorf("Organizations.UpdateCustomOrgRole returned %+v, want %+v", gotRoles, want)
@@ -178,7 +178,7 @@ func TestOrganizationsService_ListCustomRepoRoles(t *testing.T) {
 		t.Errorf("Organizations.ListCustomRepoRoles returned error: %v", err)
 	}
 
-	want := &OrganizationCustomRepoRoles{TotalCount: Int(1), CustomRepoRoles: []*CustomRepoRoles{{ID: Int64(1), Name: String("Developer"), BaseRole: String("write"), Permissions: []string{"delete_alerts_code_scanning"}}}}
+	want := &OrganizationCustomRepoRoles{TotalCount: Ptr(1), CustomRepoRoles: []*CustomRepoRoles{{ID: Ptr(int64(1)), Name: Ptr("Developer"), BaseRole: Ptr("write"), Permissions: []string{"delete_alerts_code_scanning"}}}}
 	if !cmp.Equal(apps, want) {
 		t.Errorf("Organizations.ListCustomRepoRoles returned %+v, want %+v", apps, want)
 	}
@@ -210,9 +210,9 @@ func TestOrganizationsService_CreateCustomRepoRole(t *testing.T) {
 	ctx := context.Background()
 
 	opts := &CreateOrUpdateCustomRepoRoleOptions{
-		Name:        String("Labeler"),
-		Description: String("A role for issue and PR labelers"),
-		BaseRole:    String("read"),
+		Name:        Ptr("Labeler"),
+		Description: Ptr("A role for issue and PR labelers"),
+		BaseRole:    Ptr("read"),
 		Permissions: []string{"add_label"},
 	}
 	apps, _, err := client.Organizations.CreateCustomRepoRole(ctx, "o", opts)
@@ -220,7 +220,7 @@ func TestOrganizationsService_CreateCustomRepoRole(t *testing.T) {
 		t.Errorf("Organizations.CreateCustomRepoRole returned error: %v", err)
 	}
 
-	want := &CustomRepoRoles{ID: Int64(8030), Name: String("Labeler"), BaseRole: String("read"), Permissions: []string{"add_label"}, Description: String("A role for issue and PR labelers")}
+	want := &CustomRepoRoles{ID: Ptr(int64(8030)), Name: Ptr("Labeler"), BaseRole: Ptr("read"), Permissions: []string{"add_label"}, Description: Ptr("A role for issue and PR labelers")}
 
 	if !cmp.Equal(apps, want) {
 		t.Errorf("Organizations.CreateCustomRepoRole returned %+v, want %+v", apps, want)
@@ -253,15 +253,15 @@ func TestOrganizationsService_UpdateCustomRepoRole(t *testing.T) {
 	ctx := context.Background()
 
 	opts := &CreateOrUpdateCustomRepoRoleOptions{
-		Name:        String("Updated Name"),
-		Description: String("Updated Description"),
+		Name:        Ptr("Updated Name"),
+		Description: Ptr("Updated Description"),
 	}
 	apps, _, err := client.Organizations.UpdateCustomRepoRole(ctx, "o", 8030, opts)
 	if err != nil {
 		t.Errorf("Organizations.UpdateCustomRepoRole returned error: %v", err)
 	}
 
-	want := &CustomRepoRoles{ID: Int64(8030), Name: String("Updated Name"), BaseRole: String("read"), Permissions: []string{"add_label"}, Description: String("Updated Description")}
+	want := &CustomRepoRoles{ID: Ptr(int64(8030)), Name: Ptr("Updated Name"), BaseRole: Ptr("read"), Permissions: []string{"add_label"}, Description: Ptr("Updated Description")}
 
 	if !cmp.Equal(apps, want) {
 		t.Errorf("Organizations.UpdateCustomRepoRole returned %+v, want %+v", apps, want)
@@ -324,7 +324,7 @@ func TestOrganizationsService_ListTeamsAssignedToOrgRole(t *testing.T) {
 		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned error: %v", err)
 	}
 
-	want := []*Team{{ID: Int64(1)}}
+	want := []*Team{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(apps, want) {
 		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned %+v, want %+v", apps, want)
 	}
@@ -359,7 +359,7 @@ func TestOrganizationsService_ListUsersAssignedToOrgRole(t *testing.T) {
 		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned error: %v", err)
 	}
 
-	want := []*User{{ID: Int64(1)}}
+	want := []*User{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(apps, want) {
 		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned %+v, want %+v", apps, want)
 	}
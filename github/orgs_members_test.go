@@ -42,7 +42,7 @@ func TestOrganizationsService_ListMembers(t *testing.T) {
 		t.Errorf("Organizations.ListMembers returned error: %v", err)
 	}
 
-	want := []*User{{ID: Int64(1)}}
+	want := []*User{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(members, want) {
 		t.Errorf("Organizations.ListMembers returned %+v, want %+v", members, want)
 	}
@@ -87,7 +87,7 @@ func TestOrganizationsService_ListMembers_public(t *testing.T) {
 		t.Errorf("Organizations.ListMembers returned error: %v", err)
 	}
 
-	want := []*User{{ID: Int64(1)}}
+	want := []*User{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(members, want) {
 		t.Errorf("Organizations.ListMembers returned %+v, want %+v", members, want)
 	}
@@ -366,7 +366,7 @@ func TestOrganizationsService_ListOrgMemberships(t *testing.T) {
 		t.Errorf("Organizations.ListOrgMemberships returned error: %v", err)
 	}
 
-	want := []*Membership{{URL: String("u")}}
+	want := []*Membership{{URL: Ptr("u")}}
 	if !cmp.Equal(memberships, want) {
 		t.Errorf("Organizations.ListOrgMemberships returned %+v, want %+v", memberships, want)
 	}
@@ -396,7 +396,7 @@ func TestOrganizationsService_GetOrgMembership_AuthenticatedUser(t *testing.T) {
 		t.Errorf("Organizations.GetOrgMembership returned error: %v", err)
 	}
 
-	want := &Membership{URL: String("u")}
+	want := &Membership{URL: Ptr("u")}
 	if !cmp.Equal(membership, want) {
 		t.Errorf("Organizations.GetOrgMembership returned %+v, want %+v", membership, want)
 	}
@@ -431,7 +431,7 @@ func TestOrganizationsService_GetOrgMembership_SpecifiedUser(t *testing.T) {
 		t.Errorf("Organizations.GetOrgMembership returned error: %v", err)
 	}
 
-	want := &Membership{URL: String("u")}
+	want := &Membership{URL: Ptr("u")}
 	if !cmp.Equal(membership, want) {
 		t.Errorf("Organizations.GetOrgMembership returned %+v, want %+v", membership, want)
 	}
@@ -441,7 +441,7 @@ func TestOrganizationsService_EditOrgMembership_AuthenticatedUser(t *testing.T)
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &Membership{State: String("active")}
+	input := &Membership{State: Ptr("active")}
 
 	mux.HandleFunc("/user/memberships/orgs/o", func(w http.ResponseWriter, r *http.Request) {
 		v := new(Membership)
@@ -461,7 +461,7 @@ func TestOrganizationsService_EditOrgMembership_AuthenticatedUser(t *testing.T)
 		t.Errorf("Organizations.EditOrgMembership returned error: %v", err)
 	}
 
-	want := &Membership{URL: String("u")}
+	want := &Membership{URL: Ptr("u")}
 	if !cmp.Equal(membership, want) {
 		t.Errorf("Organizations.EditOrgMembership returned %+v, want %+v", membership, want)
 	}
@@ -485,7 +485,7 @@ func TestOrganizationsService_EditOrgMembership_SpecifiedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &Membership{State: String("active")}
+	input := &Membership{State: Ptr("active")}
 
 	mux.HandleFunc("/orgs/o/memberships/u", func(w http.ResponseWriter, r *http.Request) {
 		v := new(Membership)
@@ -505,7 +505,7 @@ func TestOrganizationsService_EditOrgMembership_SpecifiedUser(t *testing.T) {
 		t.Errorf("Organizations.EditOrgMembership returned error: %v", err)
 	}
 
-	want := &Membership{URL: String("u")}
+	want := &Membership{URL: Ptr("u")}
 	if !cmp.Equal(membership, want) {
 		t.Errorf("Organizations.EditOrgMembership returned %+v, want %+v", membership, want)
 	}
@@ -586,32 +586,32 @@ func TestOrganizationsService_ListPendingOrgInvitations(t *testing.T) {
 	createdAt := time.Date(2017, time.January, 21, 0, 0, 0, 0, time.UTC)
 	want := []*Invitation{
 		{
-			ID:        Int64(1),
-			Login:     String("monalisa"),
-			Email:     String("octocat@github.com"),
-			Role:      String("direct_member"),
+			ID:        Ptr(int64(1)),
+			Login:     Ptr("monalisa"),
+			Email:     Ptr("octocat@github.com"),
+			Role:      Ptr("direct_member"),
 			CreatedAt: &Timestamp{createdAt},
 			Inviter: &User{
-				Login:             String("other_user"),
-				ID:                Int64(1),
-				AvatarURL:         String("https://github.com/images/error/other_user_happy.gif"),
-				GravatarID:        String(""),
-				URL:               String("https://api.github.com/users/other_user"),
-				HTMLURL:           String("https://github.com/other_user"),
-				FollowersURL:      String("https://api.github.com/users/other_user/followers"),
-				FollowingURL:      String("https://api.github.com/users/other_user/following/other_user"),
-				GistsURL:          String("https://api.github.com/users/other_user/gists/gist_id"),
-				StarredURL:        String("https://api.github.com/users/other_user/starred/owner/repo"),
-				SubscriptionsURL:  String("https://api.github.com/users/other_user/subscriptions"),
-				OrganizationsURL:  String("https://api.github.com/users/other_user/orgs"),
-				ReposURL:          String("https://api.github.com/users/other_user/repos"),
-				EventsURL:         String("https://api.github.com/users/other_user/events/privacy"),
-				ReceivedEventsURL: String("https://api.github.com/users/other_user/received_events/privacy"),
-				Type:              String("User"),
-				SiteAdmin:         Bool(false),
+				Login:             Ptr("other_user"),
+				ID:                Ptr(int64(1)),
+				AvatarURL:         Ptr("https://github.com/images/error/other_user_happy.gif"),
+				GravatarID:        Ptr(""),
+				URL:               Ptr("https://api.github.com/users/other_user"),
+				HTMLURL:           Ptr("https://github.com/other_user"),
+				FollowersURL:      Ptr("https://api.github.com/users/other_user/followers"),
+				FollowingURL:      Ptr("https://api.github.com/users/other_user/following/other_user"),
+				GistsURL:          Ptr("https://api.github.com/users/other_user/gists/gist_id"),
+				StarredURL:        Ptr("https://api.github.com/users/other_user/starred/owner/repo"),
+				SubscriptionsURL:  Ptr("https://api.github.com/users/other_user/subscriptions"),
+				OrganizationsURL:  Ptr("https://api.github.com/users/other_user/orgs"),
+				ReposURL:          Ptr("https://api.github.com/users/other_user/repos"),
+				EventsURL:         Ptr("https://api.github.com/users/other_user/events/privacy"),
+				ReceivedEventsURL: Ptr("https://api.github.com/users/other_user/received_events/privacy"),
+				Type:              Ptr("User"),
+				SiteAdmin:         Ptr(false),
 			},
-			TeamCount:         Int(2),
-			InvitationTeamURL: String("https://api.github.com/organizations/2/invitations/1/teams"),
+			TeamCount:         Ptr(2),
+			InvitationTeamURL: Ptr("https://api.github.com/organizations/2/invitations/1/teams"),
 		}}
 
 	if !cmp.Equal(invitations, want) {
@@ -637,8 +637,8 @@ func TestOrganizationsService_CreateOrgInvitation(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 	input := &CreateOrgInvitationOptions{
-		Email: String("octocat@github.com"),
-		Role:  String("direct_member"),
+		Email: Ptr("octocat@github.com"),
+		Role:  Ptr("direct_member"),
 		TeamID: []int64{
 			12,
 			26,
@@ -663,7 +663,7 @@ func TestOrganizationsService_CreateOrgInvitation(t *testing.T) {
 		t.Errorf("Organizations.CreateOrgInvitation returned error: %v", err)
 	}
 
-	want := &Invitation{Email: String("octocat@github.com")}
+	want := &Invitation{Email: Ptr("octocat@github.com")}
 	if !cmp.Equal(invitations, want) {
 		t.Errorf("Organizations.ListPendingOrgInvitations returned %+v, want %+v", invitations, want)
 	}
@@ -714,15 +714,15 @@ func TestOrganizationsService_ListOrgInvitationTeams(t *testing.T) {
 
 	want := []*Team{
 		{
-			ID:              Int64(1),
-			URL:             String("https://api.github.com/teams/1"),
-			Name:            String("Justice League"),
-			Slug:            String("justice-league"),
-			Description:     String("A great team."),
-			Privacy:         String("closed"),
-			Permission:      String("admin"),
-			MembersURL:      String("https://api.github.com/teams/1/members{/member}"),
-			RepositoriesURL: String("https://api.github.com/teams/1/repos"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("https://api.github.com/teams/1"),
+			Name:            Ptr("Justice League"),
+			Slug:            Ptr("justice-league"),
+			Description:     Ptr("A great team."),
+			Privacy:         Ptr("closed"),
+			Permission:      Ptr("admin"),
+			MembersURL:      Ptr("https://api.github.com/teams/1/members{/member}"),
+			RepositoriesURL: Ptr("https://api.github.com/teams/1/repos"),
 		},
 	}
 
@@ -798,36 +798,36 @@ func TestOrganizationsService_ListFailedOrgInvitations(t *testing.T) {
 	createdAt := time.Date(2016, time.November, 30, 6, 46, 10, 0, time.UTC)
 	want := []*Invitation{
 		{
-			ID:           Int64(1),
-			Login:        String("monalisa"),
-			NodeID:       String("MDQ6VXNlcjE="),
-			Email:        String("octocat@github.com"),
-			Role:         String("direct_member"),
+			ID:           Ptr(int64(1)),
+			Login:        Ptr("monalisa"),
+			NodeID:       Ptr("MDQ6VXNlcjE="),
+			Email:        Ptr("octocat@github.com"),
+			Role:         Ptr("direct_member"),
 			FailedAt:     &Timestamp{time.Date(2017, time.January, 2, 1, 10, 0, 0, time.UTC)},
-			FailedReason: String("the reason"),
+			FailedReason: Ptr("the reason"),
 			CreatedAt:    &Timestamp{createdAt},
 			Inviter: &User{
-				Login:             String("other_user"),
-				ID:                Int64(1),
-				NodeID:            String("MDQ6VXNlcjE="),
-				AvatarURL:         String("https://github.com/images/error/other_user_happy.gif"),
-				GravatarID:        String(""),
-				URL:               String("https://api.github.com/users/other_user"),
-				HTMLURL:           String("https://github.com/other_user"),
-				FollowersURL:      String("https://api.github.com/users/other_user/followers"),
-				FollowingURL:      String("https://api.github.com/users/other_user/following{/other_user}"),
-				GistsURL:          String("https://api.github.com/users/other_user/gists{/gist_id}"),
-				StarredURL:        String("https://api.github.com/users/other_user/starred{/owner}{/repo}"),
-				SubscriptionsURL:  String("https://api.github.com/users/other_user/subscriptions"),
-				OrganizationsURL:  String("https://api.github.com/users/other_user/orgs"),
-				ReposURL:          String("https://api.github.com/users/other_user/repos"),
-				EventsURL:         String("https://api.github.com/users/other_user/events{/privacy}"),
-				ReceivedEventsURL: String("https://api.github.com/users/other_user/received_events"),
-				Type:              String("User"),
-				SiteAdmin:         Bool(false),
+				Login:             Ptr("other_user"),
+				ID:                Ptr(int64(1)),
+				NodeID:            Ptr("MDQ6VXNlcjE="),
+				AvatarURL:         Ptr("https://github.com/images/error/other_user_happy.gif"),
+				GravatarID:        Ptr(""),
+				URL:               Ptr("https://api.github.com/users/other_user"),
+				HTMLURL:           Ptr("https://github.com/other_user"),
+				FollowersURL:      Ptr("https://api.github.com/users/other_user/followers"),
+				FollowingURL:      Ptr("https://api.github.com/users/other_user/following{/other_user}"),
+				GistsURL:          Ptr("https://api.github.com/users/other_user/gists{/gist_id}"),
+				StarredURL:        Ptr("https://api.github.com/users/other_user/starred{/owner}{/repo}"),
+				SubscriptionsURL:  Ptr("https://api.github.com/users/other_user/subscriptions"),
+				OrganizationsURL:  Ptr("https://api.github.com/users/other_user/orgs"),
+				ReposURL:          Ptr("https://api.github.com/users/other_user/repos"),
+				EventsURL:         Ptr("https://api.github.com/users/other_user/events{/privacy}"),
+				ReceivedEventsURL: Ptr("https://api.github.com/users/other_user/received_events"),
+				Type:              Ptr("User"),
+				SiteAdmin:         Ptr(false),
 			},
-			TeamCount:         Int(2),
-			InvitationTeamURL: String("https://api.github.com/organizations/2/invitations/1/teams"),
+			TeamCount:         Ptr(2),
+			InvitationTeamURL: Ptr("https://api.github.com/organizations/2/invitations/1/teams"),
 		},
 	}
 
@@ -854,40 +854,40 @@ func TestMembership_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Membership{}, "{}")
 
 	u := &Membership{
-		URL:             String("url"),
-		State:           String("state"),
-		Role:            String("email"),
-		OrganizationURL: String("orgurl"),
+		URL:             Ptr("url"),
+		State:           Ptr("state"),
+		Role:            Ptr("email"),
+		OrganizationURL: Ptr("orgurl"),
 		Organization: &Organization{
-			BillingEmail:                         String("be"),
-			Blog:                                 String("b"),
-			Company:                              String("c"),
-			Email:                                String("e"),
-			TwitterUsername:                      String("tu"),
-			Location:                             String("loc"),
-			Name:                                 String("n"),
-			Description:                          String("d"),
-			IsVerified:                           Bool(true),
-			HasOrganizationProjects:              Bool(true),
-			HasRepositoryProjects:                Bool(true),
-			DefaultRepoPermission:                String("drp"),
-			MembersCanCreateRepos:                Bool(true),
-			MembersCanCreateInternalRepos:        Bool(true),
-			MembersCanCreatePrivateRepos:         Bool(true),
-			MembersCanCreatePublicRepos:          Bool(false),
-			MembersAllowedRepositoryCreationType: String("marct"),
-			MembersCanCreatePages:                Bool(true),
-			MembersCanCreatePublicPages:          Bool(false),
-			MembersCanCreatePrivatePages:         Bool(true),
+			BillingEmail:                         Ptr("be"),
+			Blog:                                 Ptr("b"),
+			Company:                              Ptr("c"),
+			Email:                                Ptr("e"),
+			TwitterUsername:                      Ptr("tu"),
+			Location:                             Ptr("loc"),
+			Name:                                 Ptr("n"),
+			Description:                          Ptr("d"),
+			IsVerified:                           Ptr(true),
+			HasOrganizationProjects:              Ptr(true),
+			HasRepositoryProjects:                Ptr(true),
+			DefaultRepoPermission:                Ptr("drp"),
+			MembersCanCreateRepos:                Ptr(true),
+			MembersCanCreateInternalRepos:        Ptr(true),
+			MembersCanCreatePrivateRepos:         Ptr(true),
+			MembersCanCreatePublicRepos:          Ptr(false),
+			MembersAllowedRepositoryCreationType: Ptr("marct"),
+			MembersCanCreatePages:                Ptr(true),
+			MembersCanCreatePublicPages:          Ptr(false),
+			MembersCanCreatePrivatePages:         Ptr(true),
 		},
 		User: &User{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			URL:       String("u"),
-			ReposURL:  String("r"),
-			EventsURL: String("e"),
-			AvatarURL: String("a"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			URL:       Ptr("u"),
+			ReposURL:  Ptr("r"),
+			EventsURL: Ptr("e"),
+			AvatarURL: Ptr("a"),
 		},
 	}
 
@@ -936,9 +936,9 @@ func TestCreateOrgInvitationOptions_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CreateOrgInvitationOptions{}, "{}")
 
 	u := &CreateOrgInvitationOptions{
-		InviteeID: Int64(1),
-		Email:     String("email"),
-		Role:      String("role"),
+		InviteeID: Ptr(int64(1)),
+		Email:     Ptr("email"),
+		Role:      Ptr("role"),
 		TeamID:    []int64{1},
 	}
 
@@ -37,6 +37,28 @@ func (s *OrganizationsService) ListPackages(ctx context.Context, org string, opt
 	return packages, resp, nil
 }
 
+// ListDockerMigrationConflicts lists all packages in an organization that
+// conflict with a Docker migration.
+//
+// GitHub API docs: https://docs.github.com/rest/packages/packages#get-list-of-conflicting-packages-during-docker-migration-for-organization
+//
+//meta:operation GET /orgs/{org}/docker/conflicts
+func (s *OrganizationsService) ListDockerMigrationConflicts(ctx context.Context, org string) ([]*Package, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/docker/conflicts", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var packages []*Package
+	resp, err := s.client.Do(ctx, req, &packages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return packages, resp, nil
+}
+
 // GetPackage gets a package by name from an organization.
 //
 // Note that packageName is escaped for the URL path so that you don't need to.
@@ -64,34 +64,34 @@ func TestOrganizationsService_ListPackages(t *testing.T) {
 	}
 
 	want := []*Package{{
-		ID:           Int64(197),
-		Name:         String("hello_docker"),
-		PackageType:  String("container"),
-		VersionCount: Int64(1),
-		Visibility:   String("private"),
-		URL:          String("https://api.github.com/orgs/github/packages/container/hello_docker"),
-		HTMLURL:      String("https://github.com/orgs/github/packages/container/package/hello_docker"),
+		ID:           Ptr(int64(197)),
+		Name:         Ptr("hello_docker"),
+		PackageType:  Ptr("container"),
+		VersionCount: Ptr(int64(1)),
+		Visibility:   Ptr("private"),
+		URL:          Ptr("https://api.github.com/orgs/github/packages/container/hello_docker"),
+		HTMLURL:      Ptr("https://github.com/orgs/github/packages/container/package/hello_docker"),
 		CreatedAt:    &Timestamp{referenceTime},
 		UpdatedAt:    &Timestamp{referenceTime},
 		Owner: &User{
-			Login:             String("github"),
-			ID:                Int64(9919),
-			NodeID:            String("MDEyOk9yZ2FuaXphdGlvbjk5MTk="),
-			AvatarURL:         String("https://avatars.githubusercontent.com/u/9919?v=4"),
-			GravatarID:        String(""),
-			URL:               String("https://api.github.com/users/github"),
-			HTMLURL:           String("https://github.com/github"),
-			FollowersURL:      String("https://api.github.com/users/github/followers"),
-			FollowingURL:      String("https://api.github.com/users/github/following{/other_user}"),
-			GistsURL:          String("https://api.github.com/users/github/gists{/gist_id}"),
-			StarredURL:        String("https://api.github.com/users/github/starred{/owner}{/repo}"),
-			SubscriptionsURL:  String("https://api.github.com/users/github/subscriptions"),
-			OrganizationsURL:  String("https://api.github.com/users/github/orgs"),
-			ReposURL:          String("https://api.github.com/users/github/repos"),
-			EventsURL:         String("https://api.github.com/users/github/events{/privacy}"),
-			ReceivedEventsURL: String("https://api.github.com/users/github/received_events"),
-			Type:              String("Organization"),
-			SiteAdmin:         Bool(false),
+			Login:             Ptr("github"),
+			ID:                Ptr(int64(9919)),
+			NodeID:            Ptr("MDEyOk9yZ2FuaXphdGlvbjk5MTk="),
+			AvatarURL:         Ptr("https://avatars.githubusercontent.com/u/9919?v=4"),
+			GravatarID:        Ptr(""),
+			URL:               Ptr("https://api.github.com/users/github"),
+			HTMLURL:           Ptr("https://github.com/github"),
+			FollowersURL:      Ptr("https://api.github.com/users/github/followers"),
+			FollowingURL:      Ptr("https://api.github.com/users/github/following{/other_user}"),
+			GistsURL:          Ptr("https://api.github.com/users/github/gists{/gist_id}"),
+			StarredURL:        Ptr("https://api.github.com/users/github/starred{/owner}{/repo}"),
+			SubscriptionsURL:  Ptr("https://api.github.com/users/github/subscriptions"),
+			OrganizationsURL:  Ptr("https://api.github.com/users/github/orgs"),
+			ReposURL:          Ptr("https://api.github.com/users/github/repos"),
+			EventsURL:         Ptr("https://api.github.com/users/github/events{/privacy}"),
+			ReceivedEventsURL: Ptr("https://api.github.com/users/github/received_events"),
+			Type:              Ptr("Organization"),
+			SiteAdmin:         Ptr(false),
 		},
 	}}
 	if !cmp.Equal(packages, want) {
@@ -113,6 +113,48 @@ func TestOrganizationsService_ListPackages(t *testing.T) {
 	})
 }
 
+func TestOrganizationsService_ListDockerMigrationConflicts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/docker/conflicts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, err := io.WriteString(w, `[{"id":197,"name":"hello_docker","package_type":"container"}]`)
+		if err != nil {
+			t.Fatal("Failed to write test response: ", err)
+		}
+	})
+
+	ctx := context.Background()
+	packages, _, err := client.Organizations.ListDockerMigrationConflicts(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.ListDockerMigrationConflicts returned error: %v", err)
+	}
+
+	want := []*Package{{
+		ID:          Ptr(int64(197)),
+		Name:        Ptr("hello_docker"),
+		PackageType: Ptr("container"),
+	}}
+	if !cmp.Equal(packages, want) {
+		t.Errorf("Organizations.ListDockerMigrationConflicts returned %+v, want %+v", packages, want)
+	}
+
+	const methodName = "ListDockerMigrationConflicts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListDockerMigrationConflicts(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListDockerMigrationConflicts(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestOrganizationsService_GetPackage(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -143,13 +185,13 @@ func TestOrganizationsService_GetPackage(t *testing.T) {
 	}
 
 	want := &Package{
-		ID:           Int64(197),
-		Name:         String("hello/hello_docker"),
-		PackageType:  String("container"),
-		VersionCount: Int64(1),
-		Visibility:   String("private"),
-		URL:          String("https://api.github.com/orgs/github/packages/container/hello%2Fhello_docker"),
-		HTMLURL:      String("https://github.com/orgs/github/packages/container/package/hello%2Fhello_docker"),
+		ID:           Ptr(int64(197)),
+		Name:         Ptr("hello/hello_docker"),
+		PackageType:  Ptr("container"),
+		VersionCount: Ptr(int64(1)),
+		Visibility:   Ptr("private"),
+		URL:          Ptr("https://api.github.com/orgs/github/packages/container/hello%2Fhello_docker"),
+		HTMLURL:      Ptr("https://github.com/orgs/github/packages/container/package/hello%2Fhello_docker"),
 		CreatedAt:    &Timestamp{referenceTime},
 		UpdatedAt:    &Timestamp{referenceTime},
 	}
@@ -261,7 +303,7 @@ func TestOrganizationsService_ListPackagesVersions(t *testing.T) {
 
 	ctx := context.Background()
 	opts := &PackageListOptions{
-		String("internal"), String("container"), String("deleted"), ListOptions{Page: 1, PerPage: 2},
+		Ptr("internal"), Ptr("container"), Ptr("deleted"), ListOptions{Page: 1, PerPage: 2},
 	}
 	packages, _, err := client.Organizations.PackageGetAllVersions(ctx, "o", "container", "hello/hello_docker", opts)
 	if err != nil {
@@ -269,15 +311,15 @@ func TestOrganizationsService_ListPackagesVersions(t *testing.T) {
 	}
 
 	want := []*PackageVersion{{
-		ID:             Int64(45763),
-		Name:           String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
-		URL:            String("https://api.github.com/users/octocat/packages/container/hello%2Fhello_docker/versions/45763"),
-		PackageHTMLURL: String("https://github.com/users/octocat/packages/container/package/hello%2Fhello_docker"),
+		ID:             Ptr(int64(45763)),
+		Name:           Ptr("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		URL:            Ptr("https://api.github.com/users/octocat/packages/container/hello%2Fhello_docker/versions/45763"),
+		PackageHTMLURL: Ptr("https://github.com/users/octocat/packages/container/package/hello%2Fhello_docker"),
 		CreatedAt:      &Timestamp{referenceTime},
 		UpdatedAt:      &Timestamp{referenceTime},
-		HTMLURL:        String("https://github.com/users/octocat/packages/container/hello%2Fhello_docker/45763"),
+		HTMLURL:        Ptr("https://github.com/users/octocat/packages/container/hello%2Fhello_docker/45763"),
 		Metadata: &PackageMetadata{
-			PackageType: String("container"),
+			PackageType: Ptr("container"),
 			Container: &PackageContainerMetadata{
 				Tags: []string{"latest"},
 			},
@@ -339,15 +381,15 @@ func TestOrganizationsService_PackageGetVersion(t *testing.T) {
 	}
 
 	want := &PackageVersion{
-		ID:             Int64(45763),
-		Name:           String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
-		URL:            String("https://api.github.com/users/octocat/packages/container/hello%2Fhello_docker/versions/45763"),
-		PackageHTMLURL: String("https://github.com/users/octocat/packages/container/package/hello%2Fhello_docker"),
+		ID:             Ptr(int64(45763)),
+		Name:           Ptr("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		URL:            Ptr("https://api.github.com/users/octocat/packages/container/hello%2Fhello_docker/versions/45763"),
+		PackageHTMLURL: Ptr("https://github.com/users/octocat/packages/container/package/hello%2Fhello_docker"),
 		CreatedAt:      &Timestamp{referenceTime},
 		UpdatedAt:      &Timestamp{referenceTime},
-		HTMLURL:        String("https://github.com/users/octocat/packages/container/hello%2Fhello_docker/45763"),
+		HTMLURL:        Ptr("https://github.com/users/octocat/packages/container/hello%2Fhello_docker/45763"),
 		Metadata: &PackageMetadata{
-			PackageType: String("container"),
+			PackageType: Ptr("container"),
 			Container: &PackageContainerMetadata{
 				Tags: []string{"latest"},
 			},
@@ -20,7 +20,7 @@ func TestOrganizationsService_ReviewPersonalAccessTokenRequest(t *testing.T) {
 
 	input := ReviewPersonalAccessTokenRequestOptions{
 		Action: "a",
-		Reason: String("r"),
+		Reason: Ptr("r"),
 	}
 
 	mux.HandleFunc("/orgs/o/personal-access-token-requests/1", func(w http.ResponseWriter, r *http.Request) {
@@ -61,7 +61,7 @@ func TestReviewPersonalAccessTokenRequestOptions_Marshal(t *testing.T) {
 
 	u := &ReviewPersonalAccessTokenRequestOptions{
 		Action: "a",
-		Reason: String("r"),
+		Reason: Ptr("r"),
 	}
 
 	want := `{
@@ -38,6 +38,10 @@ func (s *OrganizationsService) GetAllOrganizationRulesets(ctx context.Context, o
 //
 //meta:operation POST /orgs/{org}/rulesets
 func (s *OrganizationsService) CreateOrganizationRuleset(ctx context.Context, org string, rs *Ruleset) (*Ruleset, *Response, error) {
+	if err := s.client.validate(rs); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("orgs/%v/rulesets", org)
 
 	req, err := s.client.NewRequest("POST", u, rs)
@@ -82,6 +86,10 @@ func (s *OrganizationsService) GetOrganizationRuleset(ctx context.Context, org s
 //
 //meta:operation PUT /orgs/{org}/rulesets/{ruleset_id}
 func (s *OrganizationsService) UpdateOrganizationRuleset(ctx context.Context, org string, rulesetID int64, rs *Ruleset) (*Ruleset, *Response, error) {
+	if err := s.client.validate(rs); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID)
 
 	req, err := s.client.NewRequest("PUT", u, rs)
@@ -44,15 +44,15 @@ func TestOrganizationsService_GetAllOrganizationRulesets(t *testing.T) {
 	}
 
 	want := []*Ruleset{{
-		ID:          Int64(26110),
+		ID:          Ptr(int64(26110)),
 		Name:        "test ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
-		NodeID:      String("nid"),
+		NodeID:      Ptr("nid"),
 		Links: &RulesetLinks{
-			Self: &RulesetLink{HRef: String("https://api.github.com/orgs/o/rulesets/26110")},
+			Self: &RulesetLink{HRef: Ptr("https://api.github.com/orgs/o/rulesets/26110")},
 		},
 	}}
 	if !cmp.Equal(rulesets, want) {
@@ -212,16 +212,16 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 
 	ctx := context.Background()
 	ruleset, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", &Ruleset{
-		ID:          Int64(21),
+		ID:          Ptr(int64(21)),
 		Name:        "ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
 		BypassActors: []*BypassActor{
 			{
-				ActorID:   Int64(234),
-				ActorType: String("Team"),
+				ActorID:   Ptr(int64(234)),
+				ActorType: Ptr("Team"),
 			},
 		},
 		Conditions: &RulesetConditions{
@@ -232,7 +232,7 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 			RepositoryName: &RulesetRepositoryNamesConditionParameters{
 				Include:   []string{"important_repository", "another_important_repository"},
 				Exclude:   []string{"unimportant_repository"},
-				Protected: Bool(true),
+				Protected: Ptr(true),
 			},
 		},
 		Rules: []*RepositoryRule{
@@ -258,15 +258,15 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 				RequiredStatusChecks: []RuleRequiredStatusChecks{
 					{
 						Context:       "test",
-						IntegrationID: Int64(1),
+						IntegrationID: Ptr(int64(1)),
 					},
 				},
 				StrictRequiredStatusChecksPolicy: true,
 			}),
 			NewNonFastForwardRule(),
 			NewCommitMessagePatternRule(&RulePatternParameters{
-				Name:     String("avoid test commits"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid test commits"),
+				Negate:   Ptr(true),
 				Operator: "starts_with",
 				Pattern:  "[test]",
 			}),
@@ -275,20 +275,20 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 				Pattern:  "github",
 			}),
 			NewCommitterEmailPatternRule(&RulePatternParameters{
-				Name:     String("avoid commit emails"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid commit emails"),
+				Negate:   Ptr(true),
 				Operator: "ends_with",
 				Pattern:  "abc",
 			}),
 			NewBranchNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid branch names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid branch names"),
+				Negate:   Ptr(true),
 				Operator: "regex",
 				Pattern:  "github$",
 			}),
 			NewTagNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid tag names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid tag names"),
+				Negate:   Ptr(true),
 				Operator: "contains",
 				Pattern:  "github",
 			}),
@@ -299,16 +299,16 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 	}
 
 	want := &Ruleset{
-		ID:          Int64(21),
+		ID:          Ptr(int64(21)),
 		Name:        "ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
 		BypassActors: []*BypassActor{
 			{
-				ActorID:   Int64(234),
-				ActorType: String("Team"),
+				ActorID:   Ptr(int64(234)),
+				ActorType: Ptr("Team"),
 			},
 		},
 		Conditions: &RulesetConditions{
@@ -319,7 +319,7 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 			RepositoryName: &RulesetRepositoryNamesConditionParameters{
 				Include:   []string{"important_repository", "another_important_repository"},
 				Exclude:   []string{"unimportant_repository"},
-				Protected: Bool(true),
+				Protected: Ptr(true),
 			},
 		},
 		Rules: []*RepositoryRule{
@@ -345,15 +345,15 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 				RequiredStatusChecks: []RuleRequiredStatusChecks{
 					{
 						Context:       "test",
-						IntegrationID: Int64(1),
+						IntegrationID: Ptr(int64(1)),
 					},
 				},
 				StrictRequiredStatusChecksPolicy: true,
 			}),
 			NewNonFastForwardRule(),
 			NewCommitMessagePatternRule(&RulePatternParameters{
-				Name:     String("avoid test commits"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid test commits"),
+				Negate:   Ptr(true),
 				Operator: "starts_with",
 				Pattern:  "[test]",
 			}),
@@ -362,20 +362,20 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T)
 				Pattern:  "github",
 			}),
 			NewCommitterEmailPatternRule(&RulePatternParameters{
-				Name:     String("avoid commit emails"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid commit emails"),
+				Negate:   Ptr(true),
 				Operator: "ends_with",
 				Pattern:  "abc",
 			}),
 			NewBranchNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid branch names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid branch names"),
+				Negate:   Ptr(true),
 				Operator: "regex",
 				Pattern:  "github$",
 			}),
 			NewTagNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid tag names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid tag names"),
+				Negate:   Ptr(true),
 				Operator: "contains",
 				Pattern:  "github",
 			}),
@@ -538,16 +538,16 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoProperty(t *testing.
 
 	ctx := context.Background()
 	ruleset, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", &Ruleset{
-		ID:          Int64(21),
+		ID:          Ptr(int64(21)),
 		Name:        "ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
 		BypassActors: []*BypassActor{
 			{
-				ActorID:   Int64(234),
-				ActorType: String("Team"),
+				ActorID:   Ptr(int64(234)),
+				ActorType: Ptr("Team"),
 			},
 		},
 		Conditions: &RulesetConditions{
@@ -589,15 +589,15 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoProperty(t *testing.
 				RequiredStatusChecks: []RuleRequiredStatusChecks{
 					{
 						Context:       "test",
-						IntegrationID: Int64(1),
+						IntegrationID: Ptr(int64(1)),
 					},
 				},
 				StrictRequiredStatusChecksPolicy: true,
 			}),
 			NewNonFastForwardRule(),
 			NewCommitMessagePatternRule(&RulePatternParameters{
-				Name:     String("avoid test commits"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid test commits"),
+				Negate:   Ptr(true),
 				Operator: "starts_with",
 				Pattern:  "[test]",
 			}),
@@ -606,20 +606,20 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoProperty(t *testing.
 				Pattern:  "github",
 			}),
 			NewCommitterEmailPatternRule(&RulePatternParameters{
-				Name:     String("avoid commit emails"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid commit emails"),
+				Negate:   Ptr(true),
 				Operator: "ends_with",
 				Pattern:  "abc",
 			}),
 			NewBranchNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid branch names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid branch names"),
+				Negate:   Ptr(true),
 				Operator: "regex",
 				Pattern:  "github$",
 			}),
 			NewTagNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid tag names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid tag names"),
+				Negate:   Ptr(true),
 				Operator: "contains",
 				Pattern:  "github",
 			}),
@@ -630,16 +630,16 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoProperty(t *testing.
 	}
 
 	want := &Ruleset{
-		ID:          Int64(21),
+		ID:          Ptr(int64(21)),
 		Name:        "ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
 		BypassActors: []*BypassActor{
 			{
-				ActorID:   Int64(234),
-				ActorType: String("Team"),
+				ActorID:   Ptr(int64(234)),
+				ActorType: Ptr("Team"),
 			},
 		},
 		Conditions: &RulesetConditions{
@@ -681,15 +681,15 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoProperty(t *testing.
 				RequiredStatusChecks: []RuleRequiredStatusChecks{
 					{
 						Context:       "test",
-						IntegrationID: Int64(1),
+						IntegrationID: Ptr(int64(1)),
 					},
 				},
 				StrictRequiredStatusChecksPolicy: true,
 			}),
 			NewNonFastForwardRule(),
 			NewCommitMessagePatternRule(&RulePatternParameters{
-				Name:     String("avoid test commits"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid test commits"),
+				Negate:   Ptr(true),
 				Operator: "starts_with",
 				Pattern:  "[test]",
 			}),
@@ -698,20 +698,20 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoProperty(t *testing.
 				Pattern:  "github",
 			}),
 			NewCommitterEmailPatternRule(&RulePatternParameters{
-				Name:     String("avoid commit emails"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid commit emails"),
+				Negate:   Ptr(true),
 				Operator: "ends_with",
 				Pattern:  "abc",
 			}),
 			NewBranchNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid branch names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid branch names"),
+				Negate:   Ptr(true),
 				Operator: "regex",
 				Pattern:  "github$",
 			}),
 			NewTagNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid tag names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid tag names"),
+				Negate:   Ptr(true),
 				Operator: "contains",
 				Pattern:  "github",
 			}),
@@ -866,16 +866,16 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoIDs(t *testing.T) {
 
 	ctx := context.Background()
 	ruleset, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", &Ruleset{
-		ID:          Int64(21),
+		ID:          Ptr(int64(21)),
 		Name:        "ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
 		BypassActors: []*BypassActor{
 			{
-				ActorID:   Int64(234),
-				ActorType: String("Team"),
+				ActorID:   Ptr(int64(234)),
+				ActorType: Ptr("Team"),
 			},
 		},
 		Conditions: &RulesetConditions{
@@ -910,15 +910,15 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoIDs(t *testing.T) {
 				RequiredStatusChecks: []RuleRequiredStatusChecks{
 					{
 						Context:       "test",
-						IntegrationID: Int64(1),
+						IntegrationID: Ptr(int64(1)),
 					},
 				},
 				StrictRequiredStatusChecksPolicy: true,
 			}),
 			NewNonFastForwardRule(),
 			NewCommitMessagePatternRule(&RulePatternParameters{
-				Name:     String("avoid test commits"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid test commits"),
+				Negate:   Ptr(true),
 				Operator: "starts_with",
 				Pattern:  "[test]",
 			}),
@@ -927,20 +927,20 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoIDs(t *testing.T) {
 				Pattern:  "github",
 			}),
 			NewCommitterEmailPatternRule(&RulePatternParameters{
-				Name:     String("avoid commit emails"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid commit emails"),
+				Negate:   Ptr(true),
 				Operator: "ends_with",
 				Pattern:  "abc",
 			}),
 			NewBranchNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid branch names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid branch names"),
+				Negate:   Ptr(true),
 				Operator: "regex",
 				Pattern:  "github$",
 			}),
 			NewTagNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid tag names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid tag names"),
+				Negate:   Ptr(true),
 				Operator: "contains",
 				Pattern:  "github",
 			}),
@@ -951,16 +951,16 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoIDs(t *testing.T) {
 	}
 
 	want := &Ruleset{
-		ID:          Int64(21),
+		ID:          Ptr(int64(21)),
 		Name:        "ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
 		BypassActors: []*BypassActor{
 			{
-				ActorID:   Int64(234),
-				ActorType: String("Team"),
+				ActorID:   Ptr(int64(234)),
+				ActorType: Ptr("Team"),
 			},
 		},
 		Conditions: &RulesetConditions{
@@ -995,15 +995,15 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoIDs(t *testing.T) {
 				RequiredStatusChecks: []RuleRequiredStatusChecks{
 					{
 						Context:       "test",
-						IntegrationID: Int64(1),
+						IntegrationID: Ptr(int64(1)),
 					},
 				},
 				StrictRequiredStatusChecksPolicy: true,
 			}),
 			NewNonFastForwardRule(),
 			NewCommitMessagePatternRule(&RulePatternParameters{
-				Name:     String("avoid test commits"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid test commits"),
+				Negate:   Ptr(true),
 				Operator: "starts_with",
 				Pattern:  "[test]",
 			}),
@@ -1012,20 +1012,20 @@ func TestOrganizationsService_CreateOrganizationRuleset_RepoIDs(t *testing.T) {
 				Pattern:  "github",
 			}),
 			NewCommitterEmailPatternRule(&RulePatternParameters{
-				Name:     String("avoid commit emails"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid commit emails"),
+				Negate:   Ptr(true),
 				Operator: "ends_with",
 				Pattern:  "abc",
 			}),
 			NewBranchNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid branch names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid branch names"),
+				Negate:   Ptr(true),
 				Operator: "regex",
 				Pattern:  "github$",
 			}),
 			NewTagNamePatternRule(&RulePatternParameters{
-				Name:     String("avoid tag names"),
-				Negate:   Bool(true),
+				Name:     Ptr("avoid tag names"),
+				Negate:   Ptr(true),
 				Operator: "contains",
 				Pattern:  "github",
 			}),
@@ -1102,15 +1102,15 @@ func TestOrganizationsService_GetOrganizationRuleset(t *testing.T) {
 	}
 
 	want := &Ruleset{
-		ID:          Int64(26110),
+		ID:          Ptr(int64(26110)),
 		Name:        "test ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
-		NodeID:      String("nid"),
+		NodeID:      Ptr("nid"),
 		Links: &RulesetLinks{
-			Self: &RulesetLink{HRef: String("https://api.github.com/orgs/o/rulesets/26110")},
+			Self: &RulesetLink{HRef: Ptr("https://api.github.com/orgs/o/rulesets/26110")},
 		},
 		Conditions: &RulesetConditions{
 			RefName: &RulesetRefConditionParameters{
@@ -1120,7 +1120,7 @@ func TestOrganizationsService_GetOrganizationRuleset(t *testing.T) {
 			RepositoryName: &RulesetRepositoryNamesConditionParameters{
 				Include:   []string{"important_repository", "another_important_repository"},
 				Exclude:   []string{"unimportant_repository"},
-				Protected: Bool(true),
+				Protected: Ptr(true),
 			},
 		},
 		Rules: []*RepositoryRule{
@@ -1191,15 +1191,15 @@ func TestOrganizationsService_GetOrganizationRulesetWithRepoPropCondition(t *tes
 	}
 
 	want := &Ruleset{
-		ID:          Int64(26110),
+		ID:          Ptr(int64(26110)),
 		Name:        "test ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
-		NodeID:      String("nid"),
+		NodeID:      Ptr("nid"),
 		Links: &RulesetLinks{
-			Self: &RulesetLink{HRef: String("https://api.github.com/orgs/o/rulesets/26110")},
+			Self: &RulesetLink{HRef: Ptr("https://api.github.com/orgs/o/rulesets/26110")},
 		},
 		Conditions: &RulesetConditions{
 			RepositoryProperty: &RulesetRepositoryPropertyConditionParameters{
@@ -1282,7 +1282,7 @@ func TestOrganizationsService_UpdateOrganizationRuleset(t *testing.T) {
 	ctx := context.Background()
 	rulesets, _, err := client.Organizations.UpdateOrganizationRuleset(ctx, "o", 26110, &Ruleset{
 		Name:        "test ruleset",
-		Target:      String("branch"),
+		Target:      Ptr("branch"),
 		Enforcement: "active",
 		Conditions: &RulesetConditions{
 			RefName: &RulesetRefConditionParameters{
@@ -1292,7 +1292,7 @@ func TestOrganizationsService_UpdateOrganizationRuleset(t *testing.T) {
 			RepositoryName: &RulesetRepositoryNamesConditionParameters{
 				Include:   []string{"important_repository", "another_important_repository"},
 				Exclude:   []string{"unimportant_repository"},
-				Protected: Bool(true),
+				Protected: Ptr(true),
 			},
 		},
 		Rules: []*RepositoryRule{
@@ -1305,15 +1305,15 @@ func TestOrganizationsService_UpdateOrganizationRuleset(t *testing.T) {
 	}
 
 	want := &Ruleset{
-		ID:          Int64(26110),
+		ID:          Ptr(int64(26110)),
 		Name:        "test ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
-		NodeID:      String("nid"),
+		NodeID:      Ptr("nid"),
 		Links: &RulesetLinks{
-			Self: &RulesetLink{HRef: String("https://api.github.com/orgs/o/rulesets/26110")},
+			Self: &RulesetLink{HRef: Ptr("https://api.github.com/orgs/o/rulesets/26110")},
 		},
 		Conditions: &RulesetConditions{
 			RefName: &RulesetRefConditionParameters{
@@ -1323,7 +1323,7 @@ func TestOrganizationsService_UpdateOrganizationRuleset(t *testing.T) {
 			RepositoryName: &RulesetRepositoryNamesConditionParameters{
 				Include:   []string{"important_repository", "another_important_repository"},
 				Exclude:   []string{"unimportant_repository"},
-				Protected: Bool(true),
+				Protected: Ptr(true),
 			},
 		},
 		Rules: []*RepositoryRule{
@@ -1390,7 +1390,7 @@ func TestOrganizationsService_UpdateOrganizationRulesetWithRepoProp(t *testing.T
 	ctx := context.Background()
 	rulesets, _, err := client.Organizations.UpdateOrganizationRuleset(ctx, "o", 26110, &Ruleset{
 		Name:        "test ruleset",
-		Target:      String("branch"),
+		Target:      Ptr("branch"),
 		Enforcement: "active",
 		Conditions: &RulesetConditions{
 			RepositoryProperty: &RulesetRepositoryPropertyConditionParameters{
@@ -1413,15 +1413,15 @@ func TestOrganizationsService_UpdateOrganizationRulesetWithRepoProp(t *testing.T
 	}
 
 	want := &Ruleset{
-		ID:          Int64(26110),
+		ID:          Ptr(int64(26110)),
 		Name:        "test ruleset",
-		Target:      String("branch"),
-		SourceType:  String("Organization"),
+		Target:      Ptr("branch"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "active",
-		NodeID:      String("nid"),
+		NodeID:      Ptr("nid"),
 		Links: &RulesetLinks{
-			Self: &RulesetLink{HRef: String("https://api.github.com/orgs/o/rulesets/26110")},
+			Self: &RulesetLink{HRef: Ptr("https://api.github.com/orgs/o/rulesets/26110")},
 		},
 		Conditions: &RulesetConditions{
 			RepositoryProperty: &RulesetRepositoryPropertyConditionParameters{
@@ -1472,3 +1472,25 @@ func TestOrganizationsService_DeleteOrganizationRuleset(t *testing.T) {
 		return client.Organizations.DeleteOrganizationRuleset(ctx, "0", 26110)
 	})
 }
+
+func TestOrganizationsService_CreateOrganizationRuleset_validation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client = client.WithValidation()
+
+	mux.HandleFunc("/orgs/o/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("CreateOrganizationRuleset sent a request with an invalid enforcement")
+	})
+	mux.HandleFunc("/orgs/o/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("UpdateOrganizationRuleset sent a request with an invalid enforcement")
+	})
+
+	ctx := context.Background()
+	rs := &Ruleset{Name: "r", Enforcement: "enabled"}
+	if _, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", rs); err == nil {
+		t.Error("Organizations.CreateOrganizationRuleset returned nil error, want error")
+	}
+	if _, _, err := client.Organizations.UpdateOrganizationRuleset(ctx, "o", 42, rs); err == nil {
+		t.Error("Organizations.UpdateOrganizationRuleset returned nil error, want error")
+	}
+}
@@ -11,11 +11,11 @@ func TestPackageRegistry_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PackageRegistry{}, "{}")
 
 	o := &PackageRegistry{
-		AboutURL: String("aurl"),
-		Name:     String("name"),
-		Type:     String("type"),
-		URL:      String("url"),
-		Vendor:   String("vendor"),
+		AboutURL: Ptr("aurl"),
+		Name:     Ptr("name"),
+		Type:     Ptr("type"),
+		URL:      Ptr("url"),
+		Vendor:   Ptr("vendor"),
 	}
 	want := `{
 		"about_url": "aurl",
@@ -32,35 +32,35 @@ func TestPackageFile_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PackageFile{}, "{}")
 
 	o := &PackageFile{
-		DownloadURL: String("durl"),
-		ID:          Int64(1),
-		Name:        String("name"),
-		SHA256:      String("sha256"),
-		SHA1:        String("sha1"),
-		MD5:         String("md5"),
-		ContentType: String("ct"),
-		State:       String("state"),
+		DownloadURL: Ptr("durl"),
+		ID:          Ptr(int64(1)),
+		Name:        Ptr("name"),
+		SHA256:      Ptr("sha256"),
+		SHA1:        Ptr("sha1"),
+		MD5:         Ptr("md5"),
+		ContentType: Ptr("ct"),
+		State:       Ptr("state"),
 		Author: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Size:      Int64(1),
+		Size:      Ptr(int64(1)),
 		CreatedAt: &Timestamp{referenceTime},
 		UpdatedAt: &Timestamp{referenceTime},
 	}
@@ -106,34 +106,34 @@ func TestPackageRelease_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PackageRelease{}, "{}")
 
 	o := &PackageRelease{
-		URL:             String("url"),
-		HTMLURL:         String("hurl"),
-		ID:              Int64(1),
-		TagName:         String("tn"),
-		TargetCommitish: String("tcs"),
-		Name:            String("name"),
-		Draft:           Bool(true),
+		URL:             Ptr("url"),
+		HTMLURL:         Ptr("hurl"),
+		ID:              Ptr(int64(1)),
+		TagName:         Ptr("tn"),
+		TargetCommitish: Ptr("tcs"),
+		Name:            Ptr("name"),
+		Draft:           Ptr(true),
 		Author: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Prerelease:  Bool(true),
+		Prerelease:  Ptr(true),
 		CreatedAt:   &Timestamp{referenceTime},
 		PublishedAt: &Timestamp{referenceTime},
 	}
@@ -178,108 +178,108 @@ func TestPackageVersion_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PackageVersion{}, "{}")
 
 	o := &PackageVersion{
-		ID:       Int64(1),
-		Version:  String("ver"),
-		Summary:  String("sum"),
-		Body:     String("body"),
-		BodyHTML: String("btnhtml"),
+		ID:       Ptr(int64(1)),
+		Version:  Ptr("ver"),
+		Summary:  Ptr("sum"),
+		Body:     Ptr("body"),
+		BodyHTML: Ptr("btnhtml"),
 		Release: &PackageRelease{
-			URL:             String("url"),
-			HTMLURL:         String("hurl"),
-			ID:              Int64(1),
-			TagName:         String("tn"),
-			TargetCommitish: String("tcs"),
-			Name:            String("name"),
-			Draft:           Bool(true),
+			URL:             Ptr("url"),
+			HTMLURL:         Ptr("hurl"),
+			ID:              Ptr(int64(1)),
+			TagName:         Ptr("tn"),
+			TargetCommitish: Ptr("tcs"),
+			Name:            Ptr("name"),
+			Draft:           Ptr(true),
 			Author: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			Prerelease:  Bool(true),
+			Prerelease:  Ptr(true),
 			CreatedAt:   &Timestamp{referenceTime},
 			PublishedAt: &Timestamp{referenceTime},
 		},
-		Manifest:        String("mani"),
-		HTMLURL:         String("hurl"),
-		TagName:         String("tn"),
-		TargetCommitish: String("tcs"),
-		TargetOID:       String("tid"),
-		Draft:           Bool(true),
-		Prerelease:      Bool(true),
+		Manifest:        Ptr("mani"),
+		HTMLURL:         Ptr("hurl"),
+		TagName:         Ptr("tn"),
+		TargetCommitish: Ptr("tcs"),
+		TargetOID:       Ptr("tid"),
+		Draft:           Ptr(true),
+		Prerelease:      Ptr(true),
 		CreatedAt:       &Timestamp{referenceTime},
 		UpdatedAt:       &Timestamp{referenceTime},
 		PackageFiles: []*PackageFile{
 			{
-				DownloadURL: String("durl"),
-				ID:          Int64(1),
-				Name:        String("name"),
-				SHA256:      String("sha256"),
-				SHA1:        String("sha1"),
-				MD5:         String("md5"),
-				ContentType: String("ct"),
-				State:       String("state"),
+				DownloadURL: Ptr("durl"),
+				ID:          Ptr(int64(1)),
+				Name:        Ptr("name"),
+				SHA256:      Ptr("sha256"),
+				SHA1:        Ptr("sha1"),
+				MD5:         Ptr("md5"),
+				ContentType: Ptr("ct"),
+				State:       Ptr("state"),
 				Author: &User{
-					Login:           String("l"),
-					ID:              Int64(1),
-					URL:             String("u"),
-					AvatarURL:       String("a"),
-					GravatarID:      String("g"),
-					Name:            String("n"),
-					Company:         String("c"),
-					Blog:            String("b"),
-					Location:        String("l"),
-					Email:           String("e"),
-					Hireable:        Bool(true),
-					Bio:             String("b"),
-					TwitterUsername: String("t"),
-					PublicRepos:     Int(1),
-					Followers:       Int(1),
-					Following:       Int(1),
+					Login:           Ptr("l"),
+					ID:              Ptr(int64(1)),
+					URL:             Ptr("u"),
+					AvatarURL:       Ptr("a"),
+					GravatarID:      Ptr("g"),
+					Name:            Ptr("n"),
+					Company:         Ptr("c"),
+					Blog:            Ptr("b"),
+					Location:        Ptr("l"),
+					Email:           Ptr("e"),
+					Hireable:        Ptr(true),
+					Bio:             Ptr("b"),
+					TwitterUsername: Ptr("t"),
+					PublicRepos:     Ptr(1),
+					Followers:       Ptr(1),
+					Following:       Ptr(1),
 					CreatedAt:       &Timestamp{referenceTime},
 					SuspendedAt:     &Timestamp{referenceTime},
 				},
-				Size:      Int64(1),
+				Size:      Ptr(int64(1)),
 				CreatedAt: &Timestamp{referenceTime},
 				UpdatedAt: &Timestamp{referenceTime},
 			},
 		},
 		Author: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		InstallationCommand: String("ic"),
+		InstallationCommand: Ptr("ic"),
 	}
 
 	want := `{
@@ -394,143 +394,143 @@ func TestPackage_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Package{}, "{}")
 
 	o := &Package{
-		ID:          Int64(1),
-		Name:        String("name"),
-		PackageType: String("pt"),
-		HTMLURL:     String("hurl"),
+		ID:          Ptr(int64(1)),
+		Name:        Ptr("name"),
+		PackageType: Ptr("pt"),
+		HTMLURL:     Ptr("hurl"),
 		CreatedAt:   &Timestamp{referenceTime},
 		UpdatedAt:   &Timestamp{referenceTime},
-		Visibility:  String("private"),
+		Visibility:  Ptr("private"),
 		Owner: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
 		PackageVersion: &PackageVersion{
-			ID:       Int64(1),
-			Version:  String("ver"),
-			Summary:  String("sum"),
-			Body:     String("body"),
-			BodyHTML: String("btnhtml"),
+			ID:       Ptr(int64(1)),
+			Version:  Ptr("ver"),
+			Summary:  Ptr("sum"),
+			Body:     Ptr("body"),
+			BodyHTML: Ptr("btnhtml"),
 			Release: &PackageRelease{
-				URL:             String("url"),
-				HTMLURL:         String("hurl"),
-				ID:              Int64(1),
-				TagName:         String("tn"),
-				TargetCommitish: String("tcs"),
-				Name:            String("name"),
-				Draft:           Bool(true),
+				URL:             Ptr("url"),
+				HTMLURL:         Ptr("hurl"),
+				ID:              Ptr(int64(1)),
+				TagName:         Ptr("tn"),
+				TargetCommitish: Ptr("tcs"),
+				Name:            Ptr("name"),
+				Draft:           Ptr(true),
 				Author: &User{
-					Login:           String("l"),
-					ID:              Int64(1),
-					URL:             String("u"),
-					AvatarURL:       String("a"),
-					GravatarID:      String("g"),
-					Name:            String("n"),
-					Company:         String("c"),
-					Blog:            String("b"),
-					Location:        String("l"),
-					Email:           String("e"),
-					Hireable:        Bool(true),
-					Bio:             String("b"),
-					TwitterUsername: String("t"),
-					PublicRepos:     Int(1),
-					Followers:       Int(1),
-					Following:       Int(1),
+					Login:           Ptr("l"),
+					ID:              Ptr(int64(1)),
+					URL:             Ptr("u"),
+					AvatarURL:       Ptr("a"),
+					GravatarID:      Ptr("g"),
+					Name:            Ptr("n"),
+					Company:         Ptr("c"),
+					Blog:            Ptr("b"),
+					Location:        Ptr("l"),
+					Email:           Ptr("e"),
+					Hireable:        Ptr(true),
+					Bio:             Ptr("b"),
+					TwitterUsername: Ptr("t"),
+					PublicRepos:     Ptr(1),
+					Followers:       Ptr(1),
+					Following:       Ptr(1),
 					CreatedAt:       &Timestamp{referenceTime},
 					SuspendedAt:     &Timestamp{referenceTime},
 				},
-				Prerelease:  Bool(true),
+				Prerelease:  Ptr(true),
 				CreatedAt:   &Timestamp{referenceTime},
 				PublishedAt: &Timestamp{referenceTime},
 			},
-			Manifest:        String("mani"),
-			HTMLURL:         String("hurl"),
-			TagName:         String("tn"),
-			TargetCommitish: String("tcs"),
-			TargetOID:       String("tid"),
-			Draft:           Bool(true),
-			Prerelease:      Bool(true),
+			Manifest:        Ptr("mani"),
+			HTMLURL:         Ptr("hurl"),
+			TagName:         Ptr("tn"),
+			TargetCommitish: Ptr("tcs"),
+			TargetOID:       Ptr("tid"),
+			Draft:           Ptr(true),
+			Prerelease:      Ptr(true),
 			CreatedAt:       &Timestamp{referenceTime},
 			UpdatedAt:       &Timestamp{referenceTime},
 			PackageFiles: []*PackageFile{
 				{
-					DownloadURL: String("durl"),
-					ID:          Int64(1),
-					Name:        String("name"),
-					SHA256:      String("sha256"),
-					SHA1:        String("sha1"),
-					MD5:         String("md5"),
-					ContentType: String("ct"),
-					State:       String("state"),
+					DownloadURL: Ptr("durl"),
+					ID:          Ptr(int64(1)),
+					Name:        Ptr("name"),
+					SHA256:      Ptr("sha256"),
+					SHA1:        Ptr("sha1"),
+					MD5:         Ptr("md5"),
+					ContentType: Ptr("ct"),
+					State:       Ptr("state"),
 					Author: &User{
-						Login:           String("l"),
-						ID:              Int64(1),
-						URL:             String("u"),
-						AvatarURL:       String("a"),
-						GravatarID:      String("g"),
-						Name:            String("n"),
-						Company:         String("c"),
-						Blog:            String("b"),
-						Location:        String("l"),
-						Email:           String("e"),
-						Hireable:        Bool(true),
-						Bio:             String("b"),
-						TwitterUsername: String("t"),
-						PublicRepos:     Int(1),
-						Followers:       Int(1),
-						Following:       Int(1),
+						Login:           Ptr("l"),
+						ID:              Ptr(int64(1)),
+						URL:             Ptr("u"),
+						AvatarURL:       Ptr("a"),
+						GravatarID:      Ptr("g"),
+						Name:            Ptr("n"),
+						Company:         Ptr("c"),
+						Blog:            Ptr("b"),
+						Location:        Ptr("l"),
+						Email:           Ptr("e"),
+						Hireable:        Ptr(true),
+						Bio:             Ptr("b"),
+						TwitterUsername: Ptr("t"),
+						PublicRepos:     Ptr(1),
+						Followers:       Ptr(1),
+						Following:       Ptr(1),
 						CreatedAt:       &Timestamp{referenceTime},
 						SuspendedAt:     &Timestamp{referenceTime},
 					},
-					Size:      Int64(1),
+					Size:      Ptr(int64(1)),
 					CreatedAt: &Timestamp{referenceTime},
 					UpdatedAt: &Timestamp{referenceTime},
 				},
 			},
 			Author: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			InstallationCommand: String("ic"),
+			InstallationCommand: Ptr("ic"),
 		},
 		Registry: &PackageRegistry{
-			AboutURL: String("aurl"),
-			Name:     String("name"),
-			Type:     String("type"),
-			URL:      String("url"),
-			Vendor:   String("vendor"),
+			AboutURL: Ptr("aurl"),
+			Name:     Ptr("name"),
+			Type:     Ptr("type"),
+			URL:      Ptr("url"),
+			Vendor:   Ptr("vendor"),
 		},
 	}
 
@@ -41,7 +41,7 @@ func TestPullRequestsService_List(t *testing.T) {
 		t.Errorf("PullRequests.List returned error: %v", err)
 	}
 
-	want := []*PullRequest{{Number: Int(1)}}
+	want := []*PullRequest{{Number: Ptr(1)}}
 	if !cmp.Equal(pulls, want) {
 		t.Errorf("PullRequests.List returned %+v, want %+v", pulls, want)
 	}
@@ -81,7 +81,7 @@ func TestPullRequestsService_ListPullRequestsWithCommit(t *testing.T) {
 		t.Errorf("PullRequests.ListPullRequestsWithCommit returned error: %v", err)
 	}
 
-	want := []*PullRequest{{Number: Int(1)}}
+	want := []*PullRequest{{Number: Ptr(1)}}
 	if !cmp.Equal(pulls, want) {
 		t.Errorf("PullRequests.ListPullRequestsWithCommit returned %+v, want %+v", pulls, want)
 	}
@@ -125,7 +125,7 @@ func TestPullRequestsService_Get(t *testing.T) {
 		t.Errorf("PullRequests.Get returned error: %v", err)
 	}
 
-	want := &PullRequest{Number: Int(1)}
+	want := &PullRequest{Number: Ptr(1)}
 	if !cmp.Equal(pull, want) {
 		t.Errorf("PullRequests.Get returned %+v, want %+v", pull, want)
 	}
@@ -247,24 +247,24 @@ func TestPullRequestsService_Get_links(t *testing.T) {
 	}
 
 	want := &PullRequest{
-		Number: Int(1),
+		Number: Ptr(1),
 		Links: &PRLinks{
 			Self: &PRLink{
-				HRef: String("https://api.github.com/repos/octocat/Hello-World/pulls/1347"),
+				HRef: Ptr("https://api.github.com/repos/octocat/Hello-World/pulls/1347"),
 			}, HTML: &PRLink{
-				HRef: String("https://github.com/octocat/Hello-World/pull/1347"),
+				HRef: Ptr("https://github.com/octocat/Hello-World/pull/1347"),
 			}, Issue: &PRLink{
-				HRef: String("https://api.github.com/repos/octocat/Hello-World/issues/1347"),
+				HRef: Ptr("https://api.github.com/repos/octocat/Hello-World/issues/1347"),
 			}, Comments: &PRLink{
-				HRef: String("https://api.github.com/repos/octocat/Hello-World/issues/1347/comments"),
+				HRef: Ptr("https://api.github.com/repos/octocat/Hello-World/issues/1347/comments"),
 			}, ReviewComments: &PRLink{
-				HRef: String("https://api.github.com/repos/octocat/Hello-World/pulls/1347/comments"),
+				HRef: Ptr("https://api.github.com/repos/octocat/Hello-World/pulls/1347/comments"),
 			}, ReviewComment: &PRLink{
-				HRef: String("https://api.github.com/repos/octocat/Hello-World/pulls/comments{/number}"),
+				HRef: Ptr("https://api.github.com/repos/octocat/Hello-World/pulls/comments{/number}"),
 			}, Commits: &PRLink{
-				HRef: String("https://api.github.com/repos/octocat/Hello-World/pulls/1347/commits"),
+				HRef: Ptr("https://api.github.com/repos/octocat/Hello-World/pulls/1347/commits"),
 			}, Statuses: &PRLink{
-				HRef: String("https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+				HRef: Ptr("https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e"),
 			},
 		},
 	}
@@ -289,14 +289,14 @@ func TestPullRequestsService_Get_headAndBase(t *testing.T) {
 	}
 
 	want := &PullRequest{
-		Number: Int(1),
+		Number: Ptr(1),
 		Head: &PullRequestBranch{
-			Ref:  String("r2"),
-			Repo: &Repository{ID: Int64(2)},
+			Ref:  Ptr("r2"),
+			Repo: &Repository{ID: Ptr(int64(2))},
 		},
 		Base: &PullRequestBranch{
-			Ref:  String("r1"),
-			Repo: &Repository{ID: Int64(1)},
+			Ref:  Ptr("r1"),
+			Repo: &Repository{ID: Ptr(int64(1))},
 		},
 	}
 	if !cmp.Equal(pull, want) {
@@ -328,15 +328,15 @@ func TestPullRequestsService_Get_urlFields(t *testing.T) {
 	}
 
 	want := &PullRequest{
-		Number:            Int(1),
-		URL:               String("https://api.github.com/repos/octocat/Hello-World/pulls/1347"),
-		HTMLURL:           String("https://github.com/octocat/Hello-World/pull/1347"),
-		IssueURL:          String("https://api.github.com/repos/octocat/Hello-World/issues/1347"),
-		StatusesURL:       String("https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e"),
-		DiffURL:           String("https://github.com/octocat/Hello-World/pull/1347.diff"),
-		PatchURL:          String("https://github.com/octocat/Hello-World/pull/1347.patch"),
-		ReviewCommentsURL: String("https://api.github.com/repos/octocat/Hello-World/pulls/1347/comments"),
-		ReviewCommentURL:  String("https://api.github.com/repos/octocat/Hello-World/pulls/comments{/number}"),
+		Number:            Ptr(1),
+		URL:               Ptr("https://api.github.com/repos/octocat/Hello-World/pulls/1347"),
+		HTMLURL:           Ptr("https://github.com/octocat/Hello-World/pull/1347"),
+		IssueURL:          Ptr("https://api.github.com/repos/octocat/Hello-World/issues/1347"),
+		StatusesURL:       Ptr("https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+		DiffURL:           Ptr("https://github.com/octocat/Hello-World/pull/1347.diff"),
+		PatchURL:          Ptr("https://github.com/octocat/Hello-World/pull/1347.patch"),
+		ReviewCommentsURL: Ptr("https://api.github.com/repos/octocat/Hello-World/pulls/1347/comments"),
+		ReviewCommentURL:  Ptr("https://api.github.com/repos/octocat/Hello-World/pulls/comments{/number}"),
 	}
 
 	if !cmp.Equal(pull, want) {
@@ -357,7 +357,7 @@ func TestPullRequestsService_Create(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &NewPullRequest{Title: String("t")}
+	input := &NewPullRequest{Title: Ptr("t")}
 
 	mux.HandleFunc("/repos/o/r/pulls", func(w http.ResponseWriter, r *http.Request) {
 		v := new(NewPullRequest)
@@ -377,7 +377,7 @@ func TestPullRequestsService_Create(t *testing.T) {
 		t.Errorf("PullRequests.Create returned error: %v", err)
 	}
 
-	want := &PullRequest{Number: Int(1)}
+	want := &PullRequest{Number: Ptr(1)}
 	if !cmp.Equal(pull, want) {
 		t.Errorf("PullRequests.Create returned %+v, want %+v", pull, want)
 	}
@@ -421,7 +421,7 @@ func TestPullRequestsService_UpdateBranch(t *testing.T) {
 	})
 
 	opts := &PullRequestBranchUpdateOptions{
-		ExpectedHeadSHA: String("s"),
+		ExpectedHeadSHA: Ptr("s"),
 	}
 
 	ctx := context.Background()
@@ -431,8 +431,8 @@ func TestPullRequestsService_UpdateBranch(t *testing.T) {
 	}
 
 	want := &PullRequestBranchUpdateResponse{
-		Message: String("Updating pull request branch."),
-		URL:     String("https://github.com/repos/o/r/pulls/1"),
+		Message: Ptr("Updating pull request branch."),
+		URL:     Ptr("https://github.com/repos/o/r/pulls/1"),
 	}
 
 	if !cmp.Equal(pull, want) {
@@ -466,19 +466,19 @@ func TestPullRequestsService_Edit(t *testing.T) {
 		want       *PullRequest
 	}{
 		{
-			input:        &PullRequest{Title: String("t")},
+			input:        &PullRequest{Title: Ptr("t")},
 			sendResponse: `{"number":1}`,
 			wantUpdate:   `{"title":"t"}`,
-			want:         &PullRequest{Number: Int(1)},
+			want:         &PullRequest{Number: Ptr(1)},
 		},
 		{
 			// base update
-			input:        &PullRequest{Base: &PullRequestBranch{Ref: String("master")}},
+			input:        &PullRequest{Base: &PullRequestBranch{Ref: Ptr("master")}},
 			sendResponse: `{"number":1,"base":{"ref":"master"}}`,
 			wantUpdate:   `{"base":"master"}`,
 			want: &PullRequest{
-				Number: Int(1),
-				Base:   &PullRequestBranch{Ref: String("master")},
+				Number: Ptr(1),
+				Base:   &PullRequestBranch{Ref: Ptr("master")},
 			},
 		},
 	}
@@ -561,18 +561,18 @@ func TestPullRequestsService_ListCommits(t *testing.T) {
 
 	want := []*RepositoryCommit{
 		{
-			SHA: String("3"),
+			SHA: Ptr("3"),
 			Parents: []*Commit{
 				{
-					SHA: String("2"),
+					SHA: Ptr("2"),
 				},
 			},
 		},
 		{
-			SHA: String("2"),
+			SHA: Ptr("2"),
 			Parents: []*Commit{
 				{
-					SHA: String("1"),
+					SHA: Ptr("1"),
 				},
 			},
 		},
@@ -635,22 +635,22 @@ func TestPullRequestsService_ListFiles(t *testing.T) {
 
 	want := []*CommitFile{
 		{
-			SHA:       String("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
-			Filename:  String("file1.txt"),
-			Additions: Int(103),
-			Deletions: Int(21),
-			Changes:   Int(124),
-			Status:    String("added"),
-			Patch:     String("@@ -132,7 +132,7 @@ module Test @@ -1000,7 +1000,7 @@ module Test"),
+			SHA:       Ptr("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+			Filename:  Ptr("file1.txt"),
+			Additions: Ptr(103),
+			Deletions: Ptr(21),
+			Changes:   Ptr(124),
+			Status:    Ptr("added"),
+			Patch:     Ptr("@@ -132,7 +132,7 @@ module Test @@ -1000,7 +1000,7 @@ module Test"),
 		},
 		{
-			SHA:       String("f61aebed695e2e4193db5e6dcb09b5b57875f334"),
-			Filename:  String("file2.txt"),
-			Additions: Int(5),
-			Deletions: Int(3),
-			Changes:   Int(103),
-			Status:    String("modified"),
-			Patch:     String("@@ -132,7 +132,7 @@ module Test @@ -1000,7 +1000,7 @@ module Test"),
+			SHA:       Ptr("f61aebed695e2e4193db5e6dcb09b5b57875f334"),
+			Filename:  Ptr("file2.txt"),
+			Additions: Ptr(5),
+			Deletions: Ptr(3),
+			Changes:   Ptr(103),
+			Status:    Ptr("modified"),
+			Patch:     Ptr("@@ -132,7 +132,7 @@ module Test @@ -1000,7 +1000,7 @@ module Test"),
 		},
 	}
 
@@ -730,9 +730,9 @@ func TestPullRequestsService_Merge(t *testing.T) {
 	}
 
 	want := &PullRequestMergeResult{
-		SHA:     String("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
-		Merged:  Bool(true),
-		Message: String("Pull Request successfully merged"),
+		SHA:     Ptr("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+		Merged:  Ptr(true),
+		Message: Ptr("Pull Request successfully merged"),
 	}
 	if !cmp.Equal(merge, want) {
 		t.Errorf("PullRequests.Merge returned %+v, want %+v", merge, want)
@@ -842,7 +842,7 @@ func TestPullRequestMergeRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &pullRequestMergeRequest{}, "{}")
 
 	u := &pullRequestMergeRequest{
-		CommitMessage: String("cm"),
+		CommitMessage: Ptr("cm"),
 		CommitTitle:   "ct",
 		MergeMethod:   "mm",
 		SHA:           "sha",
@@ -862,9 +862,9 @@ func TestPullRequestMergeResult_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestMergeResult{}, "{}")
 
 	u := &PullRequestMergeResult{
-		SHA:     String("sha"),
-		Merged:  Bool(false),
-		Message: String("msg"),
+		SHA:     Ptr("sha"),
+		Merged:  Ptr(false),
+		Message: Ptr("msg"),
 	}
 
 	want := `{
@@ -880,11 +880,11 @@ func TestPullRequestUpdate_Marshal(t *testing.T) {
 	testJSONMarshal(t, &pullRequestUpdate{}, "{}")
 
 	u := &pullRequestUpdate{
-		Title:               String("title"),
-		Body:                String("body"),
-		State:               String("state"),
-		Base:                String("base"),
-		MaintainerCanModify: Bool(false),
+		Title:               Ptr("title"),
+		Body:                Ptr("body"),
+		State:               Ptr("state"),
+		Base:                Ptr("base"),
+		MaintainerCanModify: Ptr(false),
 	}
 
 	want := `{
@@ -902,8 +902,8 @@ func TestPullRequestBranchUpdateResponse_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestBranchUpdateResponse{}, "{}")
 
 	u := &PullRequestBranchUpdateResponse{
-		Message: String("message"),
-		URL:     String("url"),
+		Message: Ptr("message"),
+		URL:     Ptr("url"),
 	}
 
 	want := `{
@@ -918,7 +918,7 @@ func TestPullRequestBranchUpdateOptions_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestBranchUpdateOptions{}, "{}")
 
 	u := &PullRequestBranchUpdateOptions{
-		ExpectedHeadSHA: String("eh"),
+		ExpectedHeadSHA: Ptr("eh"),
 	}
 
 	want := `{
@@ -932,14 +932,14 @@ func TestNewPullRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &NewPullRequest{}, "{}")
 
 	u := &NewPullRequest{
-		Title:               String("eh"),
-		Head:                String("eh"),
-		HeadRepo:            String("eh"),
-		Base:                String("eh"),
-		Body:                String("eh"),
-		Issue:               Int(1),
-		MaintainerCanModify: Bool(false),
-		Draft:               Bool(false),
+		Title:               Ptr("eh"),
+		Head:                Ptr("eh"),
+		HeadRepo:            Ptr("eh"),
+		Base:                Ptr("eh"),
+		Body:                Ptr("eh"),
+		Issue:               Ptr(1),
+		MaintainerCanModify: Ptr(false),
+		Draft:               Ptr(false),
 	}
 
 	want := `{
@@ -960,27 +960,27 @@ func TestPullRequestBranch_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequestBranch{}, "{}")
 
 	u := &PullRequestBranch{
-		Label: String("label"),
-		Ref:   String("ref"),
-		SHA:   String("sha"),
-		Repo:  &Repository{ID: Int64(1)},
+		Label: Ptr("label"),
+		Ref:   Ptr("ref"),
+		SHA:   Ptr("sha"),
+		Repo:  &Repository{ID: Ptr(int64(1))},
 		User: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
@@ -1022,7 +1022,7 @@ func TestPRLink_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PRLink{}, "{}")
 
 	u := &PRLink{
-		HRef: String("href"),
+		HRef: Ptr("href"),
 	}
 
 	want := `{
@@ -1037,28 +1037,28 @@ func TestPRLinks_Marshal(t *testing.T) {
 
 	u := &PRLinks{
 		Self: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 		HTML: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 		Issue: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 		Comments: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 		ReviewComments: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 		ReviewComment: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 		Commits: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 		Statuses: &PRLink{
-			HRef: String("href"),
+			HRef: Ptr("href"),
 		},
 	}
 
@@ -1097,28 +1097,28 @@ func TestPullRequestAutoMerge_Marshal(t *testing.T) {
 
 	u := &PullRequestAutoMerge{
 		EnabledBy: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		MergeMethod:   String("mm"),
-		CommitTitle:   String("ct"),
-		CommitMessage: String("cm"),
+		MergeMethod:   Ptr("mm"),
+		CommitTitle:   Ptr("ct"),
+		CommitMessage: Ptr("cm"),
 	}
 
 	want := `{
@@ -1154,208 +1154,208 @@ func TestPullRequest_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PullRequest{}, "{}")
 
 	u := &PullRequest{
-		ID:        Int64(1),
-		Number:    Int(1),
-		State:     String("state"),
-		Locked:    Bool(false),
-		Title:     String("title"),
-		Body:      String("body"),
+		ID:        Ptr(int64(1)),
+		Number:    Ptr(1),
+		State:     Ptr("state"),
+		Locked:    Ptr(false),
+		Title:     Ptr("title"),
+		Body:      Ptr("body"),
 		CreatedAt: &Timestamp{referenceTime},
 		UpdatedAt: &Timestamp{referenceTime},
 		ClosedAt:  &Timestamp{referenceTime},
 		MergedAt:  &Timestamp{referenceTime},
-		Labels:    []*Label{{ID: Int64(1)}},
+		Labels:    []*Label{{ID: Ptr(int64(1))}},
 		User: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		Draft:          Bool(false),
-		Merged:         Bool(false),
-		Mergeable:      Bool(false),
-		MergeableState: String("ms"),
+		Draft:          Ptr(false),
+		Merged:         Ptr(false),
+		Mergeable:      Ptr(false),
+		MergeableState: Ptr("ms"),
 		MergedBy: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
-		MergeCommitSHA:    String("mcs"),
-		Rebaseable:        Bool(false),
-		Comments:          Int(1),
-		Commits:           Int(1),
-		Additions:         Int(1),
-		Deletions:         Int(1),
-		ChangedFiles:      Int(1),
-		URL:               String("url"),
-		HTMLURL:           String("hurl"),
-		IssueURL:          String("iurl"),
-		StatusesURL:       String("surl"),
-		DiffURL:           String("durl"),
-		PatchURL:          String("purl"),
-		CommitsURL:        String("curl"),
-		CommentsURL:       String("comurl"),
-		ReviewCommentsURL: String("rcurls"),
-		ReviewCommentURL:  String("rcurl"),
-		ReviewComments:    Int(1),
+		MergeCommitSHA:    Ptr("mcs"),
+		Rebaseable:        Ptr(false),
+		Comments:          Ptr(1),
+		Commits:           Ptr(1),
+		Additions:         Ptr(1),
+		Deletions:         Ptr(1),
+		ChangedFiles:      Ptr(1),
+		URL:               Ptr("url"),
+		HTMLURL:           Ptr("hurl"),
+		IssueURL:          Ptr("iurl"),
+		StatusesURL:       Ptr("surl"),
+		DiffURL:           Ptr("durl"),
+		PatchURL:          Ptr("purl"),
+		CommitsURL:        Ptr("curl"),
+		CommentsURL:       Ptr("comurl"),
+		ReviewCommentsURL: Ptr("rcurls"),
+		ReviewCommentURL:  Ptr("rcurl"),
+		ReviewComments:    Ptr(1),
 		Assignee: &User{
-			Login:           String("l"),
-			ID:              Int64(1),
-			URL:             String("u"),
-			AvatarURL:       String("a"),
-			GravatarID:      String("g"),
-			Name:            String("n"),
-			Company:         String("c"),
-			Blog:            String("b"),
-			Location:        String("l"),
-			Email:           String("e"),
-			Hireable:        Bool(true),
-			Bio:             String("b"),
-			TwitterUsername: String("t"),
-			PublicRepos:     Int(1),
-			Followers:       Int(1),
-			Following:       Int(1),
+			Login:           Ptr("l"),
+			ID:              Ptr(int64(1)),
+			URL:             Ptr("u"),
+			AvatarURL:       Ptr("a"),
+			GravatarID:      Ptr("g"),
+			Name:            Ptr("n"),
+			Company:         Ptr("c"),
+			Blog:            Ptr("b"),
+			Location:        Ptr("l"),
+			Email:           Ptr("e"),
+			Hireable:        Ptr(true),
+			Bio:             Ptr("b"),
+			TwitterUsername: Ptr("t"),
+			PublicRepos:     Ptr(1),
+			Followers:       Ptr(1),
+			Following:       Ptr(1),
 			CreatedAt:       &Timestamp{referenceTime},
 			SuspendedAt:     &Timestamp{referenceTime},
 		},
 		Assignees: []*User{
 			{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 		},
-		Milestone:           &Milestone{ID: Int64(1)},
-		MaintainerCanModify: Bool(true),
-		AuthorAssociation:   String("aa"),
-		NodeID:              String("nid"),
+		Milestone:           &Milestone{ID: Ptr(int64(1))},
+		MaintainerCanModify: Ptr(true),
+		AuthorAssociation:   Ptr("aa"),
+		NodeID:              Ptr("nid"),
 		RequestedReviewers: []*User{
 			{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
 		},
 		AutoMerge: &PullRequestAutoMerge{
 			EnabledBy: &User{
-				Login:           String("l"),
-				ID:              Int64(1),
-				URL:             String("u"),
-				AvatarURL:       String("a"),
-				GravatarID:      String("g"),
-				Name:            String("n"),
-				Company:         String("c"),
-				Blog:            String("b"),
-				Location:        String("l"),
-				Email:           String("e"),
-				Hireable:        Bool(true),
-				Bio:             String("b"),
-				TwitterUsername: String("t"),
-				PublicRepos:     Int(1),
-				Followers:       Int(1),
-				Following:       Int(1),
+				Login:           Ptr("l"),
+				ID:              Ptr(int64(1)),
+				URL:             Ptr("u"),
+				AvatarURL:       Ptr("a"),
+				GravatarID:      Ptr("g"),
+				Name:            Ptr("n"),
+				Company:         Ptr("c"),
+				Blog:            Ptr("b"),
+				Location:        Ptr("l"),
+				Email:           Ptr("e"),
+				Hireable:        Ptr(true),
+				Bio:             Ptr("b"),
+				TwitterUsername: Ptr("t"),
+				PublicRepos:     Ptr(1),
+				Followers:       Ptr(1),
+				Following:       Ptr(1),
 				CreatedAt:       &Timestamp{referenceTime},
 				SuspendedAt:     &Timestamp{referenceTime},
 			},
-			MergeMethod:   String("mm"),
-			CommitTitle:   String("ct"),
-			CommitMessage: String("cm"),
+			MergeMethod:   Ptr("mm"),
+			CommitTitle:   Ptr("ct"),
+			CommitMessage: Ptr("cm"),
 		},
-		RequestedTeams: []*Team{{ID: Int64(1)}},
+		RequestedTeams: []*Team{{ID: Ptr(int64(1))}},
 		Links: &PRLinks{
 			Self: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 			HTML: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 			Issue: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 			Comments: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 			ReviewComments: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 			ReviewComment: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 			Commits: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 			Statuses: &PRLink{
-				HRef: String("href"),
+				HRef: Ptr("href"),
 			},
 		},
 		Head: &PullRequestBranch{
-			Ref:  String("r2"),
-			Repo: &Repository{ID: Int64(2)},
+			Ref:  Ptr("r2"),
+			Repo: &Repository{ID: Ptr(int64(2))},
 		},
 		Base: &PullRequestBranch{
-			Ref:  String("r2"),
-			Repo: &Repository{ID: Int64(2)},
+			Ref:  Ptr("r2"),
+			Repo: &Repository{ID: Ptr(int64(2))},
 		},
-		ActiveLockReason: String("alr"),
+		ActiveLockReason: Ptr("alr"),
 	}
 
 	want := `{
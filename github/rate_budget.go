@@ -0,0 +1,190 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateBudget tracks the remaining rate limit quota of each RateLimitCategory
+// across all clients and goroutines that share it, and holds back requests
+// that would exceed it. Unlike the per-client check that only stops requests
+// once the limit has been hit, a RateBudget also lets batch jobs reserve part
+// of the quota so that other callers cannot use it up.
+//
+// The remaining quota is learned from the rate limit headers of responses,
+// so a RateBudget allows all requests in a category until the first response
+// in that category has been received.
+//
+// Use Client.WithRateBudget to apply a RateBudget to a client.
+type RateBudget struct {
+	// Block makes requests that would exceed the budget wait until the rate
+	// limit resets, or their context is done, instead of failing with a
+	// *RateBudgetError.
+	Block bool
+
+	mu         sync.Mutex
+	categories [Categories]rateBudgetCategory
+}
+
+type rateBudgetCategory struct {
+	known     bool // whether remaining and reset have been learned from a response
+	remaining int
+	reset     time.Time
+	reserved  int // requests set aside by outstanding reservations
+}
+
+// RateBudgetError is returned for requests that would exceed a RateBudget.
+type RateBudgetError struct {
+	Category RateLimitCategory
+	Reset    Timestamp // when the rate limit resets
+}
+
+func (e *RateBudgetError) Error() string {
+	return fmt.Sprintf("rate limit budget for category %v exhausted until %v", e.Category, e.Reset.Time)
+}
+
+// RateReservation is a share of a RateBudget set aside by Reserve. Requests
+// made with a context returned by WithRateReservation draw from it.
+type RateReservation struct {
+	budget   *RateBudget
+	category RateLimitCategory
+	left     int // guarded by budget.mu
+}
+
+// WithRateBudget returns a copy of the client that draws every request from
+// budget. The same budget can be shared by several clients that use the same
+// credentials, since they share the same rate limits.
+func (c *Client) WithRateBudget(budget *RateBudget) *Client {
+	c2 := c.copy()
+	defer c2.initialize()
+	c2.rateBudget = budget
+	return c2
+}
+
+// Reserve sets aside n requests of category for the exclusive use of
+// requests made with a context returned by WithRateReservation. It returns a
+// *RateBudgetError if fewer than n unreserved requests remain. Call Release
+// once the reservation is no longer needed to return any unused requests.
+func (b *RateBudget) Reserve(category RateLimitCategory, n int) (*RateReservation, error) {
+	if category >= Categories {
+		return nil, fmt.Errorf("invalid rate limit category %v", category)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := &b.categories[category]
+	c.refresh()
+	if c.known && c.remaining-c.reserved < n {
+		return nil, &RateBudgetError{Category: category, Reset: Timestamp{c.reset}}
+	}
+	c.reserved += n
+	return &RateReservation{budget: b, category: category, left: n}, nil
+}
+
+// Remaining returns the number of requests of category that can be made by
+// callers without a reservation, and whether it is known yet.
+func (b *RateBudget) Remaining(category RateLimitCategory) (remaining int, known bool) {
+	if category >= Categories {
+		return 0, false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := &b.categories[category]
+	c.refresh()
+	return c.remaining - c.reserved, c.known
+}
+
+// Release returns the unused requests of r to the budget.
+func (r *RateReservation) Release() {
+	r.budget.mu.Lock()
+	defer r.budget.mu.Unlock()
+
+	r.budget.categories[r.category].reserved -= r.left
+	r.left = 0
+}
+
+// WithRateReservation returns a context that makes requests draw from r
+// before the unreserved part of the budget.
+func WithRateReservation(ctx context.Context, r *RateReservation) context.Context {
+	return context.WithValue(ctx, rateReservation, r)
+}
+
+// refresh forgets what is known about the category once its rate limit has
+// reset. Outstanding reservations are kept.
+func (c *rateBudgetCategory) refresh() {
+	if c.known && time.Now().After(c.reset) {
+		c.known = false
+		c.remaining = 0
+	}
+}
+
+// acquire takes one request of category from the budget, waiting for the
+// rate limit to reset if the budget is exhausted and b.Block is set.
+func (b *RateBudget) acquire(ctx context.Context, category RateLimitCategory) error {
+	r, _ := ctx.Value(rateReservation).(*RateReservation)
+	for {
+		reset, ok := b.tryAcquire(category, r)
+		if ok {
+			return nil
+		}
+		if !b.Block {
+			return &RateBudgetError{Category: category, Reset: Timestamp{reset}}
+		}
+		timer := time.NewTimer(time.Until(reset))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// tryAcquire takes one request of category, from r if it has any left. If
+// the budget is exhausted, it returns the time at which it resets.
+func (b *RateBudget) tryAcquire(category RateLimitCategory, r *RateReservation) (reset time.Time, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := &b.categories[category]
+	c.refresh()
+	if r != nil && r.budget == b && r.category == category && r.left > 0 {
+		r.left--
+		c.reserved--
+		c.remaining--
+		return time.Time{}, true
+	}
+	if !c.known {
+		return time.Time{}, true
+	}
+	if c.remaining-c.reserved <= 0 {
+		return c.reset, false
+	}
+	c.remaining--
+	return time.Time{}, true
+}
+
+// update records the rate limit reported by a response.
+func (b *RateBudget) update(category RateLimitCategory, rate Rate) {
+	if rate.Limit == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := &b.categories[category]
+	c.known = true
+	c.remaining = rate.Remaining
+	c.reset = rate.Reset.Time
+}
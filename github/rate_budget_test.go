@@ -0,0 +1,139 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateBudget(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	budget := &RateBudget{}
+	client = client.WithRateBudget(budget)
+
+	reset := time.Now().Add(time.Hour).Unix()
+	var remaining int32 = 3
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, strconv.Itoa(int(atomic.AddInt32(&remaining, -1))))
+		w.Header().Set(headerRateReset, strconv.FormatInt(reset, 10))
+	})
+
+	ctx := context.Background()
+	get := func(ctx context.Context) error {
+		req, _ := client.NewRequest("GET", ".", nil)
+		_, err := client.Do(ctx, req, nil)
+		return err
+	}
+
+	if _, known := budget.Remaining(CoreCategory); known {
+		t.Error("Remaining is known before any response")
+	}
+
+	// The first response reports 2 remaining requests.
+	assertNilError(t, get(ctx))
+	if got, known := budget.Remaining(CoreCategory); got != 2 || !known {
+		t.Errorf("Remaining = %v, %v, want 2, true", got, known)
+	}
+
+	// Reserve one of them for a batch job; only one is left for others.
+	r, err := budget.Reserve(CoreCategory, 1)
+	if err != nil {
+		t.Fatalf("Reserve returned error: %v", err)
+	}
+	if _, err := budget.Reserve(CoreCategory, 2); err == nil {
+		t.Error("Reserve of more than the remaining budget returned nil error")
+	}
+	assertNilError(t, get(ctx))
+
+	// The unreserved budget is exhausted.
+	err = get(ctx)
+	var budgetErr *RateBudgetError
+	if !errors.As(err, &budgetErr) {
+		t.Fatalf("Do returned error %v, want *RateBudgetError", err)
+	}
+	if budgetErr.Category != CoreCategory || budgetErr.Reset.Unix() != reset {
+		t.Errorf("RateBudgetError = %+v, want category %v and reset %v", budgetErr, CoreCategory, reset)
+	}
+	if got, want := budgetErr.Error(), fmt.Sprintf("rate limit budget for category 0 exhausted until %v", budgetErr.Reset.Time); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	// The batch job can still use its reservation.
+	assertNilError(t, get(WithRateReservation(ctx, r)))
+	r.Release()
+	if got := atomic.LoadInt32(&remaining); got != 0 {
+		t.Errorf("server saw %v remaining requests, want 0", got)
+	}
+
+	// Other categories are not affected.
+	req, _ := client.NewRequest("GET", "search/code", nil)
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {})
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Errorf("Do in another category returned error: %v", err)
+	}
+}
+
+func TestRateBudget_block(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	budget := &RateBudget{Block: true}
+	client = client.WithRateBudget(budget)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	budget.update(CoreCategory, Rate{Limit: 60, Remaining: 0, Reset: Timestamp{time.Now().Add(time.Hour)}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Once the reset time has passed, requests are allowed again.
+	budget.update(CoreCategory, Rate{Limit: 60, Remaining: 0, Reset: Timestamp{time.Now().Add(-time.Second)}})
+	req, _ = client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+}
+
+func TestRateBudget_rateLimitsGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	budget := &RateBudget{}
+	client = client.WithRateBudget(budget)
+
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resources":{"core":{"limit":60,"remaining":0}}}`)
+	})
+
+	budget.update(CoreCategory, Rate{Limit: 60, Remaining: 0, Reset: Timestamp{time.Now().Add(time.Hour)}})
+
+	// Checking the rate limit is allowed once the budget is exhausted.
+	if _, _, err := client.RateLimit.Get(context.Background()); err != nil {
+		t.Errorf("RateLimit.Get returned error: %v", err)
+	}
+}
+
+func TestRateBudget_invalidCategory(t *testing.T) {
+	budget := &RateBudget{}
+	if _, err := budget.Reserve(Categories, 1); err == nil {
+		t.Error("Reserve with an invalid category returned nil error")
+	}
+	if got, known := budget.Remaining(Categories); got != 0 || known {
+		t.Errorf("Remaining with an invalid category = %v, %v, want 0, false", got, known)
+	}
+}
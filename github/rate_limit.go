@@ -5,7 +5,10 @@
 
 package github
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // RateLimitService provides access to rate limit functions in the GitHub API.
 type RateLimitService service
@@ -20,12 +23,31 @@ type Rate struct {
 
 	// The time at which the current rate limit will reset.
 	Reset Timestamp `json:"reset"`
+
+	// The number of requests made in the current rate limit window.
+	Used int `json:"used,omitempty"`
+
+	// The rate limit resource the request counted against, such as "core"
+	// or "search". It is only set for rates parsed from response headers.
+	Resource string `json:"resource,omitempty"`
 }
 
 func (r Rate) String() string {
 	return Stringify(r)
 }
 
+// ResetIn returns the time left until the rate limit resets,
+// or 0 if the reset time is unknown or has passed.
+func (r Rate) ResetIn() time.Duration {
+	if r.Reset.IsZero() {
+		return 0
+	}
+	if d := time.Until(r.Reset.Time); d > 0 {
+		return d
+	}
+	return 0
+}
+
 // RateLimits represents the rate limits for the current client.
 type RateLimits struct {
 	// The rate limit for non-search API requests. Unauthenticated
@@ -29,7 +29,7 @@ func TestRateLimits_String(t *testing.T) {
 		CodeSearch:                &Rate{},
 		AuditLog:                  &Rate{},
 	}
-	want := `github.RateLimits{Core:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, Search:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, GraphQL:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, IntegrationManifest:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, SourceImport:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, CodeScanningUpload:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, ActionsRunnerRegistration:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, SCIM:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, DependencySnapshots:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, CodeSearch:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, AuditLog:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}}`
+	want := `github.RateLimits{Core:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, Search:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, GraphQL:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, IntegrationManifest:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, SourceImport:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, CodeScanningUpload:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, ActionsRunnerRegistration:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, SCIM:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, DependencySnapshots:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, CodeSearch:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}, AuditLog:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Used:0, Resource:""}}`
 	if got := v.String(); got != want {
 		t.Errorf("RateLimits.String = %v, want %v", got, want)
 	}
@@ -469,13 +469,30 @@ func TestRate_Marshal(t *testing.T) {
 		Limit:     1,
 		Remaining: 1,
 		Reset:     Timestamp{referenceTime},
+		Used:      1,
+		Resource:  "core",
 	}
 
 	want := `{
 		"limit": 1,
 		"remaining": 1,
-		"reset": ` + referenceTimeStr + `
+		"reset": ` + referenceTimeStr + `,
+		"used": 1,
+		"resource": "core"
 	}`
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRate_ResetIn(t *testing.T) {
+	if got := (Rate{}).ResetIn(); got != 0 {
+		t.Errorf("ResetIn without reset = %v, want 0", got)
+	}
+	if got := (Rate{Reset: Timestamp{time.Now().Add(-time.Minute)}}).ResetIn(); got != 0 {
+		t.Errorf("ResetIn with past reset = %v, want 0", got)
+	}
+	got := (Rate{Reset: Timestamp{time.Now().Add(time.Hour)}}).ResetIn()
+	if got <= 59*time.Minute || got > time.Hour {
+		t.Errorf("ResetIn = %v, want about 1h", got)
+	}
+}
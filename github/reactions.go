@@ -47,6 +47,22 @@ func (r Reaction) String() string {
 	return Stringify(r)
 }
 
+// ReactionContent is the type of a reaction, as passed to the
+// ReactionsService Create*Reaction methods.
+type ReactionContent string
+
+// Possible values of ReactionContent and Reaction.Content.
+const (
+	ReactionContentPlusOne  ReactionContent = "+1"
+	ReactionContentMinusOne ReactionContent = "-1"
+	ReactionContentLaugh    ReactionContent = "laugh"
+	ReactionContentConfused ReactionContent = "confused"
+	ReactionContentHeart    ReactionContent = "heart"
+	ReactionContentHooray   ReactionContent = "hooray"
+	ReactionContentRocket   ReactionContent = "rocket"
+	ReactionContentEyes     ReactionContent = "eyes"
+)
+
 // ListCommentReactionOptions specifies the optional parameters to the
 // ReactionsService.ListCommentReactions method.
 type ListCommentReactionOptions struct {
@@ -95,10 +111,10 @@ func (s *ReactionsService) ListCommentReactions(ctx context.Context, owner, repo
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-a-commit-comment
 //
 //meta:operation POST /repos/{owner}/{repo}/comments/{comment_id}/reactions
-func (s *ReactionsService) CreateCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
+func (s *ReactionsService) CreateCommentReaction(ctx context.Context, owner, repo string, id int64, content ReactionContent) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/comments/%v/reactions", owner, repo, id)
 
-	body := &Reaction{Content: String(content)}
+	body := &Reaction{Content: Ptr(string(content))}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
@@ -175,10 +191,10 @@ func (s *ReactionsService) ListIssueReactions(ctx context.Context, owner, repo s
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-an-issue
 //
 //meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/reactions
-func (s *ReactionsService) CreateIssueReaction(ctx context.Context, owner, repo string, number int, content string) (*Reaction, *Response, error) {
+func (s *ReactionsService) CreateIssueReaction(ctx context.Context, owner, repo string, number int, content ReactionContent) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/%v/reactions", owner, repo, number)
 
-	body := &Reaction{Content: String(content)}
+	body := &Reaction{Content: Ptr(string(content))}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
@@ -255,10 +271,10 @@ func (s *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner,
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-an-issue-comment
 //
 //meta:operation POST /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions
-func (s *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
+func (s *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner, repo string, id int64, content ReactionContent) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/issues/comments/%v/reactions", owner, repo, id)
 
-	body := &Reaction{Content: String(content)}
+	body := &Reaction{Content: Ptr(string(content))}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
@@ -335,10 +351,10 @@ func (s *ReactionsService) ListPullRequestCommentReactions(ctx context.Context,
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-a-pull-request-review-comment
 //
 //meta:operation POST /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions
-func (s *ReactionsService) CreatePullRequestCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error) {
+func (s *ReactionsService) CreatePullRequestCommentReaction(ctx context.Context, owner, repo string, id int64, content ReactionContent) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pulls/comments/%v/reactions", owner, repo, id)
 
-	body := &Reaction{Content: String(content)}
+	body := &Reaction{Content: Ptr(string(content))}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
@@ -412,10 +428,10 @@ func (s *ReactionsService) ListTeamDiscussionReactions(ctx context.Context, team
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-a-team-discussion-legacy
 //
 //meta:operation POST /teams/{team_id}/discussions/{discussion_number}/reactions
-func (s *ReactionsService) CreateTeamDiscussionReaction(ctx context.Context, teamID int64, discussionNumber int, content string) (*Reaction, *Response, error) {
+func (s *ReactionsService) CreateTeamDiscussionReaction(ctx context.Context, teamID int64, discussionNumber int, content ReactionContent) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/reactions", teamID, discussionNumber)
 
-	body := &Reaction{Content: String(content)}
+	body := &Reaction{Content: Ptr(string(content))}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
@@ -487,10 +503,10 @@ func (s *ReactionsService) ListTeamDiscussionCommentReactions(ctx context.Contex
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-a-team-discussion-comment-legacy
 //
 //meta:operation POST /teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions
-func (s *ReactionsService) CreateTeamDiscussionCommentReaction(ctx context.Context, teamID int64, discussionNumber, commentNumber int, content string) (*Reaction, *Response, error) {
+func (s *ReactionsService) CreateTeamDiscussionCommentReaction(ctx context.Context, teamID int64, discussionNumber, commentNumber int, content ReactionContent) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("teams/%v/discussions/%v/comments/%v/reactions", teamID, discussionNumber, commentNumber)
 
-	body := &Reaction{Content: String(content)}
+	body := &Reaction{Content: Ptr(string(content))}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
@@ -549,10 +565,10 @@ func (s *ReactionsService) deleteReaction(ctx context.Context, url string) (*Res
 // GitHub API docs: https://docs.github.com/rest/reactions/reactions#create-reaction-for-a-release
 //
 //meta:operation POST /repos/{owner}/{repo}/releases/{release_id}/reactions
-func (s *ReactionsService) CreateReleaseReaction(ctx context.Context, owner, repo string, releaseID int64, content string) (*Reaction, *Response, error) {
+func (s *ReactionsService) CreateReleaseReaction(ctx context.Context, owner, repo string, releaseID int64, content ReactionContent) (*Reaction, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions", owner, repo, releaseID)
 
-	body := &Reaction{Content: String(content)}
+	body := &Reaction{Content: Ptr(string(content))}
 	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
@@ -568,3 +584,42 @@ func (s *ReactionsService) CreateReleaseReaction(ctx context.Context, owner, rep
 
 	return m, resp, nil
 }
+
+// ListReleaseReactions lists the reactions for a release.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-release
+//
+//meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/reactions
+func (s *ReactionsService) ListReleaseReactions(ctx context.Context, owner, repo string, releaseID int64, opts *ListOptions) ([]*Reaction, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions", owner, repo, releaseID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeReactionsPreview)
+
+	var m []*Reaction
+	resp, err := s.client.Do(ctx, req, &m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, nil
+}
+
+// DeleteReleaseReaction deletes the reaction to a release.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#delete-a-release-reaction
+//
+//meta:operation DELETE /repos/{owner}/{repo}/releases/{release_id}/reactions/{reaction_id}
+func (s *ReactionsService) DeleteReleaseReaction(ctx context.Context, owner, repo string, releaseID, reactionID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/%v/reactions/%v", owner, repo, releaseID, reactionID)
+
+	return s.deleteReaction(ctx, u)
+}
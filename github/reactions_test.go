@@ -18,10 +18,10 @@ func TestReaction_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Reaction{}, "{}")
 
 	r := &Reaction{
-		ID:      Int64(1),
+		ID:      Ptr(int64(1)),
 		User:    nil,
-		NodeID:  String("n"),
-		Content: String("+1"),
+		NodeID:  Ptr("n"),
+		Content: Ptr("+1"),
 	}
 
 	want := `{
@@ -37,16 +37,16 @@ func TestReactions_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Reactions{}, "{}")
 
 	r := &Reactions{
-		TotalCount: Int(1),
-		PlusOne:    Int(1),
-		MinusOne:   Int(1),
-		Laugh:      Int(1),
-		Confused:   Int(1),
-		Heart:      Int(1),
-		Hooray:     Int(1),
-		Rocket:     Int(1),
-		Eyes:       Int(1),
-		URL:        String("u"),
+		TotalCount: Ptr(1),
+		PlusOne:    Ptr(1),
+		MinusOne:   Ptr(1),
+		Laugh:      Ptr(1),
+		Confused:   Ptr(1),
+		Heart:      Ptr(1),
+		Hooray:     Ptr(1),
+		Rocket:     Ptr(1),
+		Eyes:       Ptr(1),
+		URL:        Ptr("u"),
 	}
 
 	want := `{
@@ -83,7 +83,7 @@ func TestReactionsService_ListCommentReactions(t *testing.T) {
 	if err != nil {
 		t.Errorf("ListCommentReactions returned error: %v", err)
 	}
-	want := []*Reaction{{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}}
 	if !cmp.Equal(reactions, want) {
 		t.Errorf("ListCommentReactions = %+v, want %+v", reactions, want)
 	}
@@ -116,11 +116,11 @@ func TestReactionsService_CreateCommentReaction(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	got, _, err := client.Reactions.CreateCommentReaction(ctx, "o", "r", 1, "+1")
+	got, _, err := client.Reactions.CreateCommentReaction(ctx, "o", "r", 1, ReactionContentPlusOne)
 	if err != nil {
 		t.Errorf("CreateCommentReaction returned error: %v", err)
 	}
-	want := &Reaction{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}
+	want := &Reaction{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("CreateCommentReaction = %+v, want %+v", got, want)
 	}
@@ -157,7 +157,7 @@ func TestReactionsService_ListIssueReactions(t *testing.T) {
 	if err != nil {
 		t.Errorf("ListIssueReactions returned error: %v", err)
 	}
-	want := []*Reaction{{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("ListIssueReactions = %+v, want %+v", got, want)
 	}
@@ -201,7 +201,7 @@ func TestReactionsService_CreateIssueReaction(t *testing.T) {
 	if err != nil {
 		t.Errorf("CreateIssueReaction returned error: %v", err)
 	}
-	want := &Reaction{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}
+	want := &Reaction{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("CreateIssueReaction = %+v, want %+v", got, want)
 	}
@@ -238,7 +238,7 @@ func TestReactionsService_ListIssueCommentReactions(t *testing.T) {
 	if err != nil {
 		t.Errorf("ListIssueCommentReactions returned error: %v", err)
 	}
-	want := []*Reaction{{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("ListIssueCommentReactions = %+v, want %+v", got, want)
 	}
@@ -282,7 +282,7 @@ func TestReactionsService_CreateIssueCommentReaction(t *testing.T) {
 	if err != nil {
 		t.Errorf("CreateIssueCommentReaction returned error: %v", err)
 	}
-	want := &Reaction{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}
+	want := &Reaction{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("CreateIssueCommentReaction = %+v, want %+v", got, want)
 	}
@@ -319,7 +319,7 @@ func TestReactionsService_ListPullRequestCommentReactions(t *testing.T) {
 	if err != nil {
 		t.Errorf("ListPullRequestCommentReactions returned error: %v", err)
 	}
-	want := []*Reaction{{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("ListPullRequestCommentReactions = %+v, want %+v", got, want)
 	}
@@ -363,7 +363,7 @@ func TestReactionsService_CreatePullRequestCommentReaction(t *testing.T) {
 	if err != nil {
 		t.Errorf("CreatePullRequestCommentReaction returned error: %v", err)
 	}
-	want := &Reaction{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}
+	want := &Reaction{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("CreatePullRequestCommentReaction = %+v, want %+v", got, want)
 	}
@@ -400,7 +400,7 @@ func TestReactionsService_ListTeamDiscussionReactions(t *testing.T) {
 	if err != nil {
 		t.Errorf("ListTeamDiscussionReactions returned error: %v", err)
 	}
-	want := []*Reaction{{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("ListTeamDiscussionReactions = %+v, want %+v", got, want)
 	}
@@ -444,7 +444,7 @@ func TestReactionsService_CreateTeamDiscussionReaction(t *testing.T) {
 	if err != nil {
 		t.Errorf("CreateTeamDiscussionReaction returned error: %v", err)
 	}
-	want := &Reaction{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}
+	want := &Reaction{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("CreateTeamDiscussionReaction = %+v, want %+v", got, want)
 	}
@@ -481,7 +481,7 @@ func TestReactionService_ListTeamDiscussionCommentReactions(t *testing.T) {
 	if err != nil {
 		t.Errorf("ListTeamDiscussionCommentReactions returned error: %v", err)
 	}
-	want := []*Reaction{{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("ListTeamDiscussionCommentReactions = %+v, want %+v", got, want)
 	}
@@ -525,7 +525,7 @@ func TestReactionService_CreateTeamDiscussionCommentReaction(t *testing.T) {
 	if err != nil {
 		t.Errorf("CreateTeamDiscussionCommentReaction returned error: %v", err)
 	}
-	want := &Reaction{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("+1")}
+	want := &Reaction{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("+1")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("CreateTeamDiscussionCommentReaction = %+v, want %+v", got, want)
 	}
@@ -888,7 +888,7 @@ func TestReactionService_CreateReleaseReaction(t *testing.T) {
 		t.Errorf("%v returned error: %v", methodName, err)
 	}
 
-	want := &Reaction{ID: Int64(1), User: &User{Login: String("l"), ID: Int64(2)}, Content: String("rocket")}
+	want := &Reaction{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr("rocket")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("%v = %+v, want %+v", methodName, got, want)
 	}
@@ -906,3 +906,68 @@ func TestReactionService_CreateReleaseReaction(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestReactionsService_ListReleaseReactions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/reactions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
+		testFormValues(t, r, values{"page": "2"})
+
+		w.WriteHeader(http.StatusOK)
+		assertWrite(t, w, []byte(`[{"id":1,"user":{"login":"l","id":2},"content":"rocket"}]`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Reactions.ListReleaseReactions(ctx, "o", "r", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("ListReleaseReactions returned error: %v", err)
+	}
+	want := []*Reaction{{ID: Ptr(int64(1)), User: &User{Login: Ptr("l"), ID: Ptr(int64(2))}, Content: Ptr(string(ReactionContentRocket))}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ListReleaseReactions = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListReleaseReactions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Reactions.ListReleaseReactions(ctx, "\n", "\n", -1, &ListOptions{})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Reactions.ListReleaseReactions(ctx, "o", "r", 1, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestReactionsService_DeleteReleaseReaction(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/reactions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Reactions.DeleteReleaseReaction(ctx, "o", "r", 1, 2); err != nil {
+		t.Errorf("DeleteReleaseReaction returned error: %v", err)
+	}
+
+	const methodName = "DeleteReleaseReaction"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Reactions.DeleteReleaseReaction(ctx, "\n", "\n", -1, -2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Reactions.DeleteReleaseReaction(ctx, "o", "r", 1, 2)
+	})
+}
@@ -767,7 +767,7 @@ type Contributor struct {
 // RepositoriesService.ListContributors method.
 type ListContributorsOptions struct {
 	// Include anonymous contributors in results or not
-	Anon string `url:"anon,omitempty"`
+	Anon bool `url:"anon,omitempty"`
 
 	ListOptions
 }
@@ -29,7 +29,7 @@ func TestRepositoriesService_GetActionsAccessLevel(t *testing.T) {
 	if err != nil {
 		t.Errorf("Repositories.GetActionsAccessLevel returned error: %v", err)
 	}
-	want := &RepositoryActionsAccessLevel{AccessLevel: String("none")}
+	want := &RepositoryActionsAccessLevel{AccessLevel: Ptr("none")}
 	if !cmp.Equal(org, want) {
 		t.Errorf("Repositories.GetActionsAccessLevel returned %+v, want %+v", org, want)
 	}
@@ -53,7 +53,7 @@ func TestRepositoriesService_EditActionsAccessLevel(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &RepositoryActionsAccessLevel{AccessLevel: String("organization")}
+	input := &RepositoryActionsAccessLevel{AccessLevel: Ptr("organization")}
 
 	mux.HandleFunc("/repos/o/r/actions/permissions/access", func(w http.ResponseWriter, r *http.Request) {
 		v := new(RepositoryActionsAccessLevel)
@@ -87,7 +87,7 @@ func TestRepositoryActionsAccessLevel_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ActionsPermissions{}, "{}")
 
 	u := &RepositoryActionsAccessLevel{
-		AccessLevel: String("enterprise"),
+		AccessLevel: Ptr("enterprise"),
 	}
 
 	want := `{
@@ -29,7 +29,7 @@ func TestRepositoriesService_GetActionsPermissions(t *testing.T) {
 	if err != nil {
 		t.Errorf("Repositories.GetActionsPermissions returned error: %v", err)
 	}
-	want := &ActionsPermissionsRepository{Enabled: Bool(true), AllowedActions: String("all")}
+	want := &ActionsPermissionsRepository{Enabled: Ptr(true), AllowedActions: Ptr("all")}
 	if !cmp.Equal(org, want) {
 		t.Errorf("Repositories.GetActionsPermissions returned %+v, want %+v", org, want)
 	}
@@ -53,7 +53,7 @@ func TestRepositoriesService_EditActionsPermissions(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &ActionsPermissionsRepository{Enabled: Bool(true), AllowedActions: String("selected")}
+	input := &ActionsPermissionsRepository{Enabled: Ptr(true), AllowedActions: Ptr("selected")}
 
 	mux.HandleFunc("/repos/o/r/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
 		v := new(ActionsPermissionsRepository)
@@ -73,7 +73,7 @@ func TestRepositoriesService_EditActionsPermissions(t *testing.T) {
 		t.Errorf("Repositories.EditActionsPermissions returned error: %v", err)
 	}
 
-	want := &ActionsPermissionsRepository{Enabled: Bool(true), AllowedActions: String("selected")}
+	want := &ActionsPermissionsRepository{Enabled: Ptr(true), AllowedActions: Ptr("selected")}
 	if !cmp.Equal(org, want) {
 		t.Errorf("Repositories.EditActionsPermissions returned %+v, want %+v", org, want)
 	}
@@ -97,9 +97,9 @@ func TestActionsPermissionsRepository_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ActionsPermissions{}, "{}")
 
 	u := &ActionsPermissionsRepository{
-		Enabled:            Bool(true),
-		AllowedActions:     String("all"),
-		SelectedActionsURL: String("someURL"),
+		Enabled:            Ptr(true),
+		AllowedActions:     Ptr("all"),
+		SelectedActionsURL: Ptr("someURL"),
 	}
 
 	want := `{
@@ -125,7 +125,7 @@ func TestRepositoriesService_GetDefaultWorkflowPermissions(t *testing.T) {
 	if err != nil {
 		t.Errorf("Repositories.GetDefaultWorkflowPermissions returned error: %v", err)
 	}
-	want := &DefaultWorkflowPermissionRepository{DefaultWorkflowPermissions: String("read"), CanApprovePullRequestReviews: Bool(true)}
+	want := &DefaultWorkflowPermissionRepository{DefaultWorkflowPermissions: Ptr("read"), CanApprovePullRequestReviews: Ptr(true)}
 	if !cmp.Equal(org, want) {
 		t.Errorf("Repositories.GetDefaultWorkflowPermissions returned %+v, want %+v", org, want)
 	}
@@ -149,7 +149,7 @@ func TestRepositoriesService_EditDefaultWorkflowPermissions(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &DefaultWorkflowPermissionRepository{DefaultWorkflowPermissions: String("read"), CanApprovePullRequestReviews: Bool(true)}
+	input := &DefaultWorkflowPermissionRepository{DefaultWorkflowPermissions: Ptr("read"), CanApprovePullRequestReviews: Ptr(true)}
 
 	mux.HandleFunc("/repos/o/r/actions/permissions/workflow", func(w http.ResponseWriter, r *http.Request) {
 		v := new(DefaultWorkflowPermissionRepository)
@@ -169,7 +169,7 @@ func TestRepositoriesService_EditDefaultWorkflowPermissions(t *testing.T) {
 		t.Errorf("Repositories.EditDefaultWorkflowPermissions returned error: %v", err)
 	}
 
-	want := &DefaultWorkflowPermissionRepository{DefaultWorkflowPermissions: String("read"), CanApprovePullRequestReviews: Bool(true)}
+	want := &DefaultWorkflowPermissionRepository{DefaultWorkflowPermissions: Ptr("read"), CanApprovePullRequestReviews: Ptr(true)}
 	if !cmp.Equal(org, want) {
 		t.Errorf("Repositories.EditDefaultWorkflowPermissions returned %+v, want %+v", org, want)
 	}
@@ -35,8 +35,8 @@ func TestRepositoriesService_ListAutolinks(t *testing.T) {
 	}
 
 	want := []*Autolink{
-		{ID: Int64(1), KeyPrefix: String("TICKET-"), URLTemplate: String("https://example.com/TICKET?query=<num>")},
-		{ID: Int64(2), KeyPrefix: String("STORY-"), URLTemplate: String("https://example.com/STORY?query=<num>")},
+		{ID: Ptr(int64(1)), KeyPrefix: Ptr("TICKET-"), URLTemplate: Ptr("https://example.com/TICKET?query=<num>")},
+		{ID: Ptr(int64(2)), KeyPrefix: Ptr("STORY-"), URLTemplate: Ptr("https://example.com/STORY?query=<num>")},
 	}
 
 	if !cmp.Equal(autolinks, want) {
@@ -63,9 +63,9 @@ func TestRepositoriesService_AddAutolink(t *testing.T) {
 	defer teardown()
 
 	opt := &AutolinkOptions{
-		KeyPrefix:      String("TICKET-"),
-		URLTemplate:    String("https://example.com/TICKET?query=<num>"),
-		IsAlphanumeric: Bool(true),
+		KeyPrefix:      Ptr("TICKET-"),
+		URLTemplate:    Ptr("https://example.com/TICKET?query=<num>"),
+		IsAlphanumeric: Ptr(true),
 	}
 	mux.HandleFunc("/repos/o/r/autolinks", func(w http.ResponseWriter, r *http.Request) {
 		v := new(AutolinkOptions)
@@ -89,9 +89,9 @@ func TestRepositoriesService_AddAutolink(t *testing.T) {
 		t.Errorf("Repositories.AddAutolink returned error: %v", err)
 	}
 	want := &Autolink{
-		KeyPrefix:      String("TICKET-"),
-		URLTemplate:    String("https://example.com/TICKET?query=<num>"),
-		IsAlphanumeric: Bool(true),
+		KeyPrefix:      Ptr("TICKET-"),
+		URLTemplate:    Ptr("https://example.com/TICKET?query=<num>"),
+		IsAlphanumeric: Ptr(true),
 	}
 
 	if !cmp.Equal(autolink, want) {
@@ -128,7 +128,7 @@ func TestRepositoriesService_GetAutolink(t *testing.T) {
 		t.Errorf("Repositories.GetAutolink returned error: %v", err)
 	}
 
-	want := &Autolink{ID: Int64(1), KeyPrefix: String("TICKET-"), URLTemplate: String("https://example.com/TICKET?query=<num>")}
+	want := &Autolink{ID: Ptr(int64(1)), KeyPrefix: Ptr("TICKET-"), URLTemplate: Ptr("https://example.com/TICKET?query=<num>")}
 	if !cmp.Equal(autolink, want) {
 		t.Errorf("Repositories.GetAutolink returned %+v, want %+v", autolink, want)
 	}
@@ -168,9 +168,9 @@ func TestAutolinkOptions_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AutolinkOptions{}, "{}")
 
 	r := &AutolinkOptions{
-		KeyPrefix:      String("kp"),
-		URLTemplate:    String("URLT"),
-		IsAlphanumeric: Bool(true),
+		KeyPrefix:      Ptr("kp"),
+		URLTemplate:    Ptr("URLT"),
+		IsAlphanumeric: Ptr(true),
 	}
 
 	want := `{
@@ -186,10 +186,10 @@ func TestAutolink_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Autolink{}, "{}")
 
 	r := &Autolink{
-		ID:             Int64(1),
-		KeyPrefix:      String("kp"),
-		URLTemplate:    String("URLT"),
-		IsAlphanumeric: Bool(true),
+		ID:             Ptr(int64(1)),
+		KeyPrefix:      Ptr("kp"),
+		URLTemplate:    Ptr("URLT"),
+		IsAlphanumeric: Ptr(true),
 	}
 
 	want := `{
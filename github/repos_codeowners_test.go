@@ -50,7 +50,7 @@ func TestRepositoriesService_GetCodeownersErrors_noRef(t *testing.T) {
 				Column:     1,
 				Kind:       "Invalid pattern",
 				Source:     "***/*.rb @monalisa",
-				Suggestion: String("Did you mean **/*.rb?"),
+				Suggestion: Ptr("Did you mean **/*.rb?"),
 				Message:    "Invalid pattern on line 3: Did you mean **/*.rb?\n\n  ***/*.rb @monalisa\n  ^",
 				Path:       ".github/CODEOWNERS",
 			},
@@ -113,7 +113,7 @@ func TestRepositoriesService_GetCodeownersErrors_specificRef(t *testing.T) {
 				Column:     1,
 				Kind:       "Invalid pattern",
 				Source:     "***/*.rb @monalisa",
-				Suggestion: String("Did you mean **/*.rb?"),
+				Suggestion: Ptr("Did you mean **/*.rb?"),
 				Message:    "Invalid pattern on line 3: Did you mean **/*.rb?\n\n  ***/*.rb @monalisa\n  ^",
 				Path:       ".github/CODEOWNERS",
 			},
@@ -148,7 +148,7 @@ func TestCodeownersErrors_Marshal(t *testing.T) {
 				Column:     1,
 				Kind:       "Invalid pattern",
 				Source:     "***/*.rb @monalisa",
-				Suggestion: String("Did you mean **/*.rb?"),
+				Suggestion: Ptr("Did you mean **/*.rb?"),
 				Message:    "Invalid pattern on line 3: Did you mean **/*.rb?\n\n  ***/*.rb @monalisa\n  ^",
 				Path:       ".github/CODEOWNERS",
 			},
@@ -9,6 +9,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -124,6 +125,93 @@ func (s *RepositoriesService) GetReadme(ctx context.Context, owner, repo string,
 	return readme, resp, nil
 }
 
+// GetReadmeForDirectory gets the Readme file in the dir directory of the
+// repository.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-a-repository-readme-for-a-directory
+//
+//meta:operation GET /repos/{owner}/{repo}/readme/{dir}
+func (s *RepositoriesService) GetReadmeForDirectory(ctx context.Context, owner, repo, dir string, opts *RepositoryContentGetOptions) (*RepositoryContent, *Response, error) {
+	u, err := readmeURL(owner, repo, dir, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	readme := new(RepositoryContent)
+	resp, err := s.client.Do(ctx, req, readme)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return readme, resp, nil
+}
+
+// readmeURL returns the URL of the Readme file in the dir directory of the
+// repository, or of the Readme file of the repository if dir is empty.
+func readmeURL(owner, repo, dir string, opts *RepositoryContentGetOptions) (string, error) {
+	u := fmt.Sprintf("repos/%v/%v/readme", owner, repo)
+	if dir != "" {
+		u = fmt.Sprintf("%v/%v", u, dir)
+	}
+	return addOptions(u, opts)
+}
+
+// ContentFormat selects a representation of the contents of a file other
+// than the default JSON one.
+type ContentFormat string
+
+const (
+	// ContentFormatRaw returns the contents of the file as they are.
+	ContentFormatRaw ContentFormat = "raw"
+	// ContentFormatHTML returns the contents of the file rendered as HTML.
+	ContentFormatHTML ContentFormat = "html"
+)
+
+// GetReadmeFormatted gets the contents of the Readme file for the repository
+// in the given format. If dir is not empty, the Readme file in that directory
+// is returned instead.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-a-repository-readme
+// GitHub API docs: https://docs.github.com/rest/repos/contents#get-a-repository-readme-for-a-directory
+//
+//meta:operation GET /repos/{owner}/{repo}/readme
+//meta:operation GET /repos/{owner}/{repo}/readme/{dir}
+func (s *RepositoriesService) GetReadmeFormatted(ctx context.Context, owner, repo, dir string, format ContentFormat, opts *RepositoryContentGetOptions) (string, *Response, error) {
+	var mediaType string
+	switch format {
+	case ContentFormatRaw:
+		mediaType = mediaTypeRaw
+	case ContentFormatHTML:
+		mediaType = mediaTypeHTML
+	default:
+		return "", nil, fmt.Errorf("unsupported content format %q", format)
+	}
+
+	u, err := readmeURL(owner, repo, dir, opts)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Accept", mediaType)
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return buf.String(), resp, nil
+}
+
 // DownloadContents returns an io.ReadCloser that reads the contents of the
 // specified file. This function will work with files of any size, as opposed
 // to GetContents which is limited to 1 Mb files. It is the caller's
@@ -29,10 +29,10 @@ func TestRepositoriesService_ListDeploymentBranchPolicies(t *testing.T) {
 
 	want := &DeploymentBranchPolicyResponse{
 		BranchPolicies: []*DeploymentBranchPolicy{
-			{ID: Int64(1)},
-			{ID: Int64(2)},
+			{ID: Ptr(int64(1))},
+			{ID: Ptr(int64(2))},
 		},
-		TotalCount: Int(2),
+		TotalCount: Ptr(2),
 	}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Repositories.ListDeploymentBranchPolicies = %+v, want %+v", got, want)
@@ -62,7 +62,7 @@ func TestRepositoriesService_GetDeploymentBranchPolicy(t *testing.T) {
 		t.Errorf("Repositories.GetDeploymentBranchPolicy returned error: %v", err)
 	}
 
-	want := &DeploymentBranchPolicy{ID: Int64(1)}
+	want := &DeploymentBranchPolicy{ID: Ptr(int64(1))}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Repositories.GetDeploymentBranchPolicy = %+v, want %+v", got, want)
 	}
@@ -87,19 +87,19 @@ func TestRepositoriesService_CreateDeploymentBranchPolicy(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	got, _, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, "o", "r", "e", &DeploymentBranchPolicyRequest{Name: String("n"), Type: String("branch")})
+	got, _, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, "o", "r", "e", &DeploymentBranchPolicyRequest{Name: Ptr("n"), Type: Ptr("branch")})
 	if err != nil {
 		t.Errorf("Repositories.CreateDeploymentBranchPolicy returned error: %v", err)
 	}
 
-	want := &DeploymentBranchPolicy{ID: Int64(1), Type: String("branch")}
+	want := &DeploymentBranchPolicy{ID: Ptr(int64(1)), Type: Ptr("branch")}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Repositories.CreateDeploymentBranchPolicy = %+v, want %+v", got, want)
 	}
 
 	const methodName = "CreateDeploymentBranchPolicy"
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		got, resp, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, "o", "r", "e", &DeploymentBranchPolicyRequest{Name: String("n")})
+		got, resp, err := client.Repositories.CreateDeploymentBranchPolicy(ctx, "o", "r", "e", &DeploymentBranchPolicyRequest{Name: Ptr("n")})
 		if got != nil {
 			t.Errorf("got non-nil Repositories.CreateDeploymentBranchPolicy response: %+v", got)
 		}
@@ -117,19 +117,19 @@ func TestRepositoriesService_UpdateDeploymentBranchPolicy(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	got, _, err := client.Repositories.UpdateDeploymentBranchPolicy(ctx, "o", "r", "e", 1, &DeploymentBranchPolicyRequest{Name: String("n")})
+	got, _, err := client.Repositories.UpdateDeploymentBranchPolicy(ctx, "o", "r", "e", 1, &DeploymentBranchPolicyRequest{Name: Ptr("n")})
 	if err != nil {
 		t.Errorf("Repositories.UpdateDeploymentBranchPolicy returned error: %v", err)
 	}
 
-	want := &DeploymentBranchPolicy{ID: Int64(1)}
+	want := &DeploymentBranchPolicy{ID: Ptr(int64(1))}
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Repositories.UpdateDeploymentBranchPolicy = %+v, want %+v", got, want)
 	}
 
 	const methodName = "UpdateDeploymentBranchPolicy"
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
-		got, resp, err := client.Repositories.UpdateDeploymentBranchPolicy(ctx, "o", "r", "e", 1, &DeploymentBranchPolicyRequest{Name: String("n")})
+		got, resp, err := client.Repositories.UpdateDeploymentBranchPolicy(ctx, "o", "r", "e", 1, &DeploymentBranchPolicyRequest{Name: Ptr("n")})
 		if got != nil {
 			t.Errorf("got non-nil Repositories.UpdateDeploymentBranchPolicy response: %+v", got)
 		}
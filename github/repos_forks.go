@@ -11,12 +11,22 @@ import (
 	"fmt"
 )
 
+// ForkSort is the order in which RepositoriesService.ListForks returns forks.
+type ForkSort string
+
+// The orders in which forks can be listed.
+const (
+	ForkSortNewest     ForkSort = "newest"
+	ForkSortOldest     ForkSort = "oldest"
+	ForkSortStargazers ForkSort = "stargazers"
+	ForkSortWatchers   ForkSort = "watchers"
+)
+
 // RepositoryListForksOptions specifies the optional parameters to the
 // RepositoriesService.ListForks method.
 type RepositoryListForksOptions struct {
-	// How to sort the forks list. Possible values are: newest, oldest,
-	// watchers. Default is "newest".
-	Sort string `url:"sort,omitempty"`
+	// How to sort the forks list. Default is ForkSortNewest.
+	Sort ForkSort `url:"sort,omitempty"`
 
 	ListOptions
 }
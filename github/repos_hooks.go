@@ -39,15 +39,15 @@ type WebHookAuthor = CommitAuthor
 
 // Hook represents a GitHub (web and service) hook for a repository.
 type Hook struct {
-	CreatedAt    *Timestamp             `json:"created_at,omitempty"`
-	UpdatedAt    *Timestamp             `json:"updated_at,omitempty"`
-	URL          *string                `json:"url,omitempty"`
-	ID           *int64                 `json:"id,omitempty"`
-	Type         *string                `json:"type,omitempty"`
-	Name         *string                `json:"name,omitempty"`
-	TestURL      *string                `json:"test_url,omitempty"`
-	PingURL      *string                `json:"ping_url,omitempty"`
-	LastResponse map[string]interface{} `json:"last_response,omitempty"`
+	CreatedAt    *Timestamp        `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp        `json:"updated_at,omitempty"`
+	URL          *string           `json:"url,omitempty"`
+	ID           *int64            `json:"id,omitempty"`
+	Type         *string           `json:"type,omitempty"`
+	Name         *string           `json:"name,omitempty"`
+	TestURL      *string           `json:"test_url,omitempty"`
+	PingURL      *string           `json:"ping_url,omitempty"`
+	LastResponse *HookLastResponse `json:"last_response,omitempty"`
 
 	// Only the following fields are used when creating a hook.
 	// Config is required.
@@ -60,6 +60,14 @@ func (h Hook) String() string {
 	return Stringify(h)
 }
 
+// HookLastResponse represents the response GitHub received for the most recent
+// delivery of a webhook, which can be used to monitor the health of a hook.
+type HookLastResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
 // createHookRequest is a subset of Hook and is used internally
 // by CreateHook to pass only the known fields for the endpoint.
 //
@@ -40,7 +40,7 @@ func TestRepositoriesService_CreateHook(t *testing.T) {
 		t.Errorf("Repositories.CreateHook returned error: %v", err)
 	}
 
-	want := &Hook{ID: Int64(1)}
+	want := &Hook{ID: Ptr(int64(1))}
 	if !cmp.Equal(hook, want) {
 		t.Errorf("Repositories.CreateHook returned %+v, want %+v", hook, want)
 	}
@@ -78,7 +78,7 @@ func TestRepositoriesService_ListHooks(t *testing.T) {
 		t.Errorf("Repositories.ListHooks returned error: %v", err)
 	}
 
-	want := []*Hook{{ID: Int64(1)}, {ID: Int64(2)}}
+	want := []*Hook{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}
 	if !cmp.Equal(hooks, want) {
 		t.Errorf("Repositories.ListHooks returned %+v, want %+v", hooks, want)
 	}
@@ -130,7 +130,7 @@ func TestRepositoriesService_GetHook(t *testing.T) {
 		t.Errorf("Repositories.GetHook returned error: %v", err)
 	}
 
-	want := &Hook{ID: Int64(1)}
+	want := &Hook{ID: Ptr(int64(1))}
 	if !cmp.Equal(hook, want) {
 		t.Errorf("Repositories.GetHook returned %+v, want %+v", hook, want)
 	}
@@ -183,7 +183,7 @@ func TestRepositoriesService_EditHook(t *testing.T) {
 		t.Errorf("Repositories.EditHook returned error: %v", err)
 	}
 
-	want := &Hook{ID: Int64(1)}
+	want := &Hook{ID: Ptr(int64(1))}
 	if !cmp.Equal(hook, want) {
 		t.Errorf("Repositories.EditHook returned %+v, want %+v", hook, want)
 	}
@@ -309,64 +309,64 @@ func TestBranchWebHookPayload_Marshal(t *testing.T) {
 	testJSONMarshal(t, &WebHookPayload{}, "{}")
 
 	v := &WebHookPayload{
-		Action: String("action"),
-		After:  String("after"),
-		Before: String("before"),
+		Action: Ptr("action"),
+		After:  Ptr("after"),
+		Before: Ptr("before"),
 		Commits: []*WebHookCommit{
 			{
 				Added: []string{"1", "2", "3"},
 				Author: &WebHookAuthor{
-					Email: String("abc@gmail.com"),
-					Name:  String("abc"),
-					Login: String("abc_12"),
+					Email: Ptr("abc@gmail.com"),
+					Name:  Ptr("abc"),
+					Login: Ptr("abc_12"),
 				},
 				Committer: &WebHookAuthor{
-					Email: String("abc@gmail.com"),
-					Name:  String("abc"),
-					Login: String("abc_12"),
+					Email: Ptr("abc@gmail.com"),
+					Name:  Ptr("abc"),
+					Login: Ptr("abc_12"),
 				},
-				ID:       String("1"),
-				Message:  String("WebHookCommit"),
+				ID:       Ptr("1"),
+				Message:  Ptr("WebHookCommit"),
 				Modified: []string{"abc", "efg", "erd"},
 				Removed:  []string{"cmd", "rti", "duv"},
 			},
 		},
-		Compare: String("compare"),
-		Created: Bool(true),
-		Forced:  Bool(false),
+		Compare: Ptr("compare"),
+		Created: Ptr(true),
+		Forced:  Ptr(false),
 		HeadCommit: &WebHookCommit{
 			Added: []string{"1", "2", "3"},
 			Author: &WebHookAuthor{
-				Email: String("abc@gmail.com"),
-				Name:  String("abc"),
-				Login: String("abc_12"),
+				Email: Ptr("abc@gmail.com"),
+				Name:  Ptr("abc"),
+				Login: Ptr("abc_12"),
 			},
 			Committer: &WebHookAuthor{
-				Email: String("abc@gmail.com"),
-				Name:  String("abc"),
-				Login: String("abc_12"),
+				Email: Ptr("abc@gmail.com"),
+				Name:  Ptr("abc"),
+				Login: Ptr("abc_12"),
 			},
-			ID:       String("1"),
-			Message:  String("WebHookCommit"),
+			ID:       Ptr("1"),
+			Message:  Ptr("WebHookCommit"),
 			Modified: []string{"abc", "efg", "erd"},
 			Removed:  []string{"cmd", "rti", "duv"},
 		},
 		Installation: &Installation{
-			ID: Int64(12),
+			ID: Ptr(int64(12)),
 		},
 		Organization: &Organization{
-			ID: Int64(22),
+			ID: Ptr(int64(22)),
 		},
 		Pusher: &CommitAuthor{
-			Login: String("rd@yahoo.com"),
+			Login: Ptr("rd@yahoo.com"),
 		},
 		Repo: &PushEventRepository{
-			ID:     Int64(321),
-			NodeID: String("node_321"),
+			ID:     Ptr(int64(321)),
+			NodeID: Ptr("node_321"),
 		},
 		Sender: &User{
-			Login: String("st@gmail.com"),
-			ID:    Int64(202),
+			Login: Ptr("st@gmail.com"),
+			ID:    Ptr(int64(202)),
 		},
 	}
 
@@ -439,9 +439,9 @@ func TestBranchWebHookAuthor_Marshal(t *testing.T) {
 	testJSONMarshal(t, &WebHookAuthor{}, "{}")
 
 	v := &WebHookAuthor{
-		Email: String("abc@gmail.com"),
-		Name:  String("abc"),
-		Login: String("abc_12"),
+		Email: Ptr("abc@gmail.com"),
+		Name:  Ptr("abc"),
+		Login: Ptr("abc_12"),
 	}
 
 	want := `{
@@ -459,17 +459,17 @@ func TestBranchWebHookCommit_Marshal(t *testing.T) {
 	v := &WebHookCommit{
 		Added: []string{"1", "2", "3"},
 		Author: &WebHookAuthor{
-			Email: String("abc@gmail.com"),
-			Name:  String("abc"),
-			Login: String("abc_12"),
+			Email: Ptr("abc@gmail.com"),
+			Name:  Ptr("abc"),
+			Login: Ptr("abc_12"),
 		},
 		Committer: &WebHookAuthor{
-			Email: String("abc@gmail.com"),
-			Name:  String("abc"),
-			Login: String("abc_12"),
+			Email: Ptr("abc@gmail.com"),
+			Name:  Ptr("abc"),
+			Login: Ptr("abc_12"),
 		},
-		ID:       String("1"),
-		Message:  String("WebHookCommit"),
+		ID:       Ptr("1"),
+		Message:  Ptr("WebHookCommit"),
 		Modified: []string{"abc", "efg", "erd"},
 		Removed:  []string{"cmd", "rti", "duv"},
 	}
@@ -501,8 +501,8 @@ func TestBranchCreateHookRequest_Marshal(t *testing.T) {
 	v := &createHookRequest{
 		Name:   "abc",
 		Events: []string{"1", "2", "3"},
-		Active: Bool(true),
-		Config: &HookConfig{ContentType: String("json")},
+		Active: Ptr(true),
+		Config: &HookConfig{ContentType: Ptr("json")},
 	}
 
 	want := `{
@@ -523,18 +523,20 @@ func TestBranchHook_Marshal(t *testing.T) {
 	v := &Hook{
 		CreatedAt: &Timestamp{referenceTime},
 		UpdatedAt: &Timestamp{referenceTime},
-		URL:       String("url"),
-		ID:        Int64(1),
-		Type:      String("type"),
-		Name:      String("name"),
-		TestURL:   String("testurl"),
-		PingURL:   String("pingurl"),
-		LastResponse: map[string]interface{}{
-			"item": "item",
+		URL:       Ptr("url"),
+		ID:        Ptr(int64(1)),
+		Type:      Ptr("type"),
+		Name:      Ptr("name"),
+		TestURL:   Ptr("testurl"),
+		PingURL:   Ptr("pingurl"),
+		LastResponse: &HookLastResponse{
+			Code:    Ptr(200),
+			Status:  Ptr("active"),
+			Message: Ptr("OK"),
 		},
-		Config: &HookConfig{ContentType: String("json")},
+		Config: &HookConfig{ContentType: Ptr("json")},
 		Events: []string{"1", "2", "3"},
-		Active: Bool(true),
+		Active: Ptr(true),
 	}
 
 	want := `{
@@ -547,7 +549,9 @@ func TestBranchHook_Marshal(t *testing.T) {
 		"test_url": "testurl",
 		"ping_url": "pingurl",
 		"last_response":{
-			"item": "item"
+			"code": 200,
+			"status": "active",
+			"message": "OK"
 		},
 		"config":{
 			"content_type": "json"
@@ -31,7 +31,7 @@ func TestRepositoriesService_ListInvitations(t *testing.T) {
 		t.Errorf("Repositories.ListInvitations returned error: %v", err)
 	}
 
-	want := []*RepositoryInvitation{{ID: Int64(1)}, {ID: Int64(2)}}
+	want := []*RepositoryInvitation{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.ListInvitations = %+v, want %+v", got, want)
 	}
@@ -92,7 +92,7 @@ func TestRepositoriesService_UpdateInvitation(t *testing.T) {
 		t.Errorf("Repositories.UpdateInvitation returned error: %v", err)
 	}
 
-	want := &RepositoryInvitation{ID: Int64(1)}
+	want := &RepositoryInvitation{ID: Ptr(int64(1))}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.UpdateInvitation = %+v, want %+v", got, want)
 	}
@@ -116,26 +116,26 @@ func TestRepositoryInvitation_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepositoryInvitation{}, "{}")
 
 	r := &RepositoryInvitation{
-		ID: Int64(1),
+		ID: Ptr(int64(1)),
 		Repo: &Repository{
-			ID:   Int64(1),
-			Name: String("n"),
-			URL:  String("u"),
+			ID:   Ptr(int64(1)),
+			Name: Ptr("n"),
+			URL:  Ptr("u"),
 		},
 		Invitee: &User{
-			ID:   Int64(1),
-			Name: String("n"),
-			URL:  String("u"),
+			ID:   Ptr(int64(1)),
+			Name: Ptr("n"),
+			URL:  Ptr("u"),
 		},
 		Inviter: &User{
-			ID:   Int64(1),
-			Name: String("n"),
-			URL:  String("u"),
+			ID:   Ptr(int64(1)),
+			Name: Ptr("n"),
+			URL:  Ptr("u"),
 		},
-		Permissions: String("p"),
+		Permissions: Ptr("p"),
 		CreatedAt:   &Timestamp{referenceTime},
-		URL:         String("u"),
-		HTMLURL:     String("h"),
+		URL:         Ptr("u"),
+		HTMLURL:     Ptr("h"),
 	}
 
 	want := `{
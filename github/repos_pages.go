@@ -91,7 +91,7 @@ type PagesHTTPSCertificate struct {
 	Description *string  `json:"description,omitempty"`
 	Domains     []string `json:"domains,omitempty"`
 	// GitHub's API doesn't return a standard Timestamp, rather it returns a YYYY-MM-DD string.
-	ExpiresAt *string `json:"expires_at,omitempty"`
+	ExpiresAt *Timestamp `json:"expires_at,omitempty"`
 }
 
 // createPagesRequest is a subset of Pages and is used internally
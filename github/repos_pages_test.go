@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -22,12 +23,12 @@ func TestRepositoriesService_EnablePagesLegacy(t *testing.T) {
 	defer teardown()
 
 	input := &Pages{
-		BuildType: String("legacy"),
+		BuildType: Ptr("legacy"),
 		Source: &PagesSource{
-			Branch: String("master"),
-			Path:   String("/"),
+			Branch: Ptr("master"),
+			Path:   Ptr("/"),
 		},
-		CNAME: String("www.my-domain.com"), // not passed along.
+		CNAME: Ptr("www.my-domain.com"), // not passed along.
 	}
 
 	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
@@ -36,7 +37,7 @@ func TestRepositoriesService_EnablePagesLegacy(t *testing.T) {
 
 		testMethod(t, r, "POST")
 		testHeader(t, r, "Accept", mediaTypeEnablePagesAPIPreview)
-		want := &createPagesRequest{BuildType: String("legacy"), Source: &PagesSource{Branch: String("master"), Path: String("/")}}
+		want := &createPagesRequest{BuildType: Ptr("legacy"), Source: &PagesSource{Branch: Ptr("master"), Path: Ptr("/")}}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -50,7 +51,7 @@ func TestRepositoriesService_EnablePagesLegacy(t *testing.T) {
 		t.Errorf("Repositories.EnablePages returned error: %v", err)
 	}
 
-	want := &Pages{URL: String("u"), Status: String("s"), CNAME: String("c"), Custom404: Bool(false), HTMLURL: String("h"), BuildType: String("legacy"), Source: &PagesSource{Branch: String("master"), Path: String("/")}}
+	want := &Pages{URL: Ptr("u"), Status: Ptr("s"), CNAME: Ptr("c"), Custom404: Ptr(false), HTMLURL: Ptr("h"), BuildType: Ptr("legacy"), Source: &PagesSource{Branch: Ptr("master"), Path: Ptr("/")}}
 
 	if !cmp.Equal(page, want) {
 		t.Errorf("Repositories.EnablePages returned %v, want %v", page, want)
@@ -76,8 +77,8 @@ func TestRepositoriesService_EnablePagesWorkflow(t *testing.T) {
 	defer teardown()
 
 	input := &Pages{
-		BuildType: String("workflow"),
-		CNAME:     String("www.my-domain.com"), // not passed along.
+		BuildType: Ptr("workflow"),
+		CNAME:     Ptr("www.my-domain.com"), // not passed along.
 	}
 
 	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
@@ -86,7 +87,7 @@ func TestRepositoriesService_EnablePagesWorkflow(t *testing.T) {
 
 		testMethod(t, r, "POST")
 		testHeader(t, r, "Accept", mediaTypeEnablePagesAPIPreview)
-		want := &createPagesRequest{BuildType: String("workflow")}
+		want := &createPagesRequest{BuildType: Ptr("workflow")}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -100,7 +101,7 @@ func TestRepositoriesService_EnablePagesWorkflow(t *testing.T) {
 		t.Errorf("Repositories.EnablePages returned error: %v", err)
 	}
 
-	want := &Pages{URL: String("u"), Status: String("s"), CNAME: String("c"), Custom404: Bool(false), HTMLURL: String("h"), BuildType: String("workflow")}
+	want := &Pages{URL: Ptr("u"), Status: Ptr("s"), CNAME: Ptr("c"), Custom404: Ptr(false), HTMLURL: Ptr("h"), BuildType: Ptr("workflow")}
 
 	if !cmp.Equal(page, want) {
 		t.Errorf("Repositories.EnablePages returned %v, want %v", page, want)
@@ -126,9 +127,9 @@ func TestRepositoriesService_UpdatePagesLegacy(t *testing.T) {
 	defer teardown()
 
 	input := &PagesUpdate{
-		CNAME:     String("www.my-domain.com"),
-		BuildType: String("legacy"),
-		Source:    &PagesSource{Branch: String("gh-pages")},
+		CNAME:     Ptr("www.my-domain.com"),
+		BuildType: Ptr("legacy"),
+		Source:    &PagesSource{Branch: Ptr("gh-pages")},
 	}
 
 	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
@@ -136,7 +137,7 @@ func TestRepositoriesService_UpdatePagesLegacy(t *testing.T) {
 		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
 
 		testMethod(t, r, "PUT")
-		want := &PagesUpdate{CNAME: String("www.my-domain.com"), BuildType: String("legacy"), Source: &PagesSource{Branch: String("gh-pages")}}
+		want := &PagesUpdate{CNAME: Ptr("www.my-domain.com"), BuildType: Ptr("legacy"), Source: &PagesSource{Branch: Ptr("gh-pages")}}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -166,8 +167,8 @@ func TestRepositoriesService_UpdatePagesWorkflow(t *testing.T) {
 	defer teardown()
 
 	input := &PagesUpdate{
-		CNAME:     String("www.my-domain.com"),
-		BuildType: String("workflow"),
+		CNAME:     Ptr("www.my-domain.com"),
+		BuildType: Ptr("workflow"),
 	}
 
 	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
@@ -175,7 +176,7 @@ func TestRepositoriesService_UpdatePagesWorkflow(t *testing.T) {
 		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
 
 		testMethod(t, r, "PUT")
-		want := &PagesUpdate{CNAME: String("www.my-domain.com"), BuildType: String("workflow")}
+		want := &PagesUpdate{CNAME: Ptr("www.my-domain.com"), BuildType: Ptr("workflow")}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -205,7 +206,7 @@ func TestRepositoriesService_UpdatePages_NullCNAME(t *testing.T) {
 	defer teardown()
 
 	input := &PagesUpdate{
-		Source: &PagesSource{Branch: String("gh-pages")},
+		Source: &PagesSource{Branch: Ptr("gh-pages")},
 	}
 
 	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
@@ -270,7 +271,7 @@ func TestRepositoriesService_GetPagesInfo(t *testing.T) {
 		t.Errorf("Repositories.GetPagesInfo returned error: %v", err)
 	}
 
-	want := &Pages{URL: String("u"), Status: String("s"), CNAME: String("c"), Custom404: Bool(false), HTMLURL: String("h"), Public: Bool(true), HTTPSCertificate: &PagesHTTPSCertificate{State: String("approved"), Description: String("Certificate is approved"), Domains: []string{"developer.github.com"}, ExpiresAt: String("2021-05-22")}, HTTPSEnforced: Bool(true)}
+	want := &Pages{URL: Ptr("u"), Status: Ptr("s"), CNAME: Ptr("c"), Custom404: Ptr(false), HTMLURL: Ptr("h"), Public: Ptr(true), HTTPSCertificate: &PagesHTTPSCertificate{State: Ptr("approved"), Description: Ptr("Certificate is approved"), Domains: []string{"developer.github.com"}, ExpiresAt: &Timestamp{time.Date(2021, time.May, 22, 0, 0, 0, 0, time.UTC)}}, HTTPSEnforced: Ptr(true)}
 	if !cmp.Equal(page, want) {
 		t.Errorf("Repositories.GetPagesInfo returned %+v, want %+v", page, want)
 	}
@@ -305,7 +306,7 @@ func TestRepositoriesService_ListPagesBuilds(t *testing.T) {
 		t.Errorf("Repositories.ListPagesBuilds returned error: %v", err)
 	}
 
-	want := []*PagesBuild{{URL: String("u"), Status: String("s"), Commit: String("c")}}
+	want := []*PagesBuild{{URL: Ptr("u"), Status: Ptr("s"), Commit: Ptr("c")}}
 	if !cmp.Equal(pages, want) {
 		t.Errorf("Repositories.ListPagesBuilds returned %+v, want %+v", pages, want)
 	}
@@ -359,7 +360,7 @@ func TestRepositoriesService_GetLatestPagesBuild(t *testing.T) {
 		t.Errorf("Repositories.GetLatestPagesBuild returned error: %v", err)
 	}
 
-	want := &PagesBuild{URL: String("u"), Status: String("s"), Commit: String("c")}
+	want := &PagesBuild{URL: Ptr("u"), Status: Ptr("s"), Commit: Ptr("c")}
 	if !cmp.Equal(build, want) {
 		t.Errorf("Repositories.GetLatestPagesBuild returned %+v, want %+v", build, want)
 	}
@@ -394,7 +395,7 @@ func TestRepositoriesService_GetPageBuild(t *testing.T) {
 		t.Errorf("Repositories.GetPageBuild returned error: %v", err)
 	}
 
-	want := &PagesBuild{URL: String("u"), Status: String("s"), Commit: String("c")}
+	want := &PagesBuild{URL: Ptr("u"), Status: Ptr("s"), Commit: Ptr("c")}
 	if !cmp.Equal(build, want) {
 		t.Errorf("Repositories.GetPageBuild returned %+v, want %+v", build, want)
 	}
@@ -429,7 +430,7 @@ func TestRepositoriesService_RequestPageBuild(t *testing.T) {
 		t.Errorf("Repositories.RequestPageBuild returned error: %v", err)
 	}
 
-	want := &PagesBuild{URL: String("u"), Status: String("s")}
+	want := &PagesBuild{URL: Ptr("u"), Status: Ptr("s")}
 	if !cmp.Equal(build, want) {
 		t.Errorf("Repositories.RequestPageBuild returned %+v, want %+v", build, want)
 	}
@@ -466,16 +467,16 @@ func TestRepositoriesService_GetPageHealthCheck(t *testing.T) {
 
 	want := &PagesHealthCheckResponse{
 		Domain: &PagesDomain{
-			Host:        String("example.com"),
-			URI:         String("http://example.com/"),
-			Nameservers: String("default"),
-			DNSResolves: Bool(true),
+			Host:        Ptr("example.com"),
+			URI:         Ptr("http://example.com/"),
+			Nameservers: Ptr("default"),
+			DNSResolves: Ptr(true),
 		},
 		AltDomain: &PagesDomain{
-			Host:        String("www.example.com"),
-			URI:         String("http://www.example.com/"),
-			Nameservers: String("default"),
-			DNSResolves: Bool(true),
+			Host:        Ptr("www.example.com"),
+			URI:         Ptr("http://www.example.com/"),
+			Nameservers: Ptr("default"),
+			DNSResolves: Ptr(true),
 		},
 	}
 	if !cmp.Equal(healthCheckResponse, want) {
@@ -501,8 +502,8 @@ func TestPagesSource_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PagesSource{}, "{}")
 
 	u := &PagesSource{
-		Branch: String("branch"),
-		Path:   String("path"),
+		Branch: Ptr("branch"),
+		Path:   Ptr("path"),
 	}
 
 	want := `{
@@ -517,7 +518,7 @@ func TestPagesError_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PagesError{}, "{}")
 
 	u := &PagesError{
-		Message: String("message"),
+		Message: Ptr("message"),
 	}
 
 	want := `{
@@ -531,8 +532,8 @@ func TestPagesUpdate_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PagesUpdate{}, "{}")
 
 	u := &PagesUpdate{
-		CNAME:  String("cname"),
-		Source: &PagesSource{Path: String("src")},
+		CNAME:  Ptr("cname"),
+		Source: &PagesSource{Path: Ptr("src")},
 	}
 
 	want := `{
@@ -547,14 +548,14 @@ func TestPages_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Pages{}, "{}")
 
 	u := &Pages{
-		URL:       String("url"),
-		Status:    String("status"),
-		CNAME:     String("cname"),
-		Custom404: Bool(false),
-		HTMLURL:   String("hurl"),
+		URL:       Ptr("url"),
+		Status:    Ptr("status"),
+		CNAME:     Ptr("cname"),
+		Custom404: Ptr(false),
+		HTMLURL:   Ptr("hurl"),
 		Source: &PagesSource{
-			Branch: String("branch"),
-			Path:   String("path"),
+			Branch: Ptr("branch"),
+			Path:   Ptr("path"),
 		},
 	}
 
@@ -577,14 +578,14 @@ func TestPagesBuild_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PagesBuild{}, "{}")
 
 	u := &PagesBuild{
-		URL:    String("url"),
-		Status: String("status"),
+		URL:    Ptr("url"),
+		Status: Ptr("status"),
 		Error: &PagesError{
-			Message: String("message"),
+			Message: Ptr("message"),
 		},
-		Pusher:    &User{ID: Int64(1)},
-		Commit:    String("commit"),
-		Duration:  Int(1),
+		Pusher:    &User{ID: Ptr(int64(1))},
+		Commit:    Ptr("commit"),
+		Duration:  Ptr(1),
 		CreatedAt: &Timestamp{referenceTime},
 		UpdatedAt: &Timestamp{referenceTime},
 	}
@@ -612,40 +613,40 @@ func TestPagesHealthCheckResponse_Marshal(t *testing.T) {
 
 	u := &PagesHealthCheckResponse{
 		Domain: &PagesDomain{
-			Host:                          String("example.com"),
-			URI:                           String("http://example.com/"),
-			Nameservers:                   String("default"),
-			DNSResolves:                   Bool(true),
-			IsProxied:                     Bool(false),
-			IsCloudflareIP:                Bool(false),
-			IsFastlyIP:                    Bool(false),
-			IsOldIPAddress:                Bool(false),
-			IsARecord:                     Bool(true),
-			HasCNAMERecord:                Bool(false),
-			HasMXRecordsPresent:           Bool(false),
-			IsValidDomain:                 Bool(true),
-			IsApexDomain:                  Bool(true),
-			ShouldBeARecord:               Bool(true),
-			IsCNAMEToGithubUserDomain:     Bool(false),
-			IsCNAMEToPagesDotGithubDotCom: Bool(false),
-			IsCNAMEToFastly:               Bool(false),
-			IsPointedToGithubPagesIP:      Bool(true),
-			IsNonGithubPagesIPPresent:     Bool(false),
-			IsPagesDomain:                 Bool(false),
-			IsServedByPages:               Bool(true),
-			IsValid:                       Bool(true),
-			Reason:                        String("some reason"),
-			RespondsToHTTPS:               Bool(true),
-			EnforcesHTTPS:                 Bool(true),
-			HTTPSError:                    String("some error"),
-			IsHTTPSEligible:               Bool(true),
-			CAAError:                      String("some error"),
+			Host:                          Ptr("example.com"),
+			URI:                           Ptr("http://example.com/"),
+			Nameservers:                   Ptr("default"),
+			DNSResolves:                   Ptr(true),
+			IsProxied:                     Ptr(false),
+			IsCloudflareIP:                Ptr(false),
+			IsFastlyIP:                    Ptr(false),
+			IsOldIPAddress:                Ptr(false),
+			IsARecord:                     Ptr(true),
+			HasCNAMERecord:                Ptr(false),
+			HasMXRecordsPresent:           Ptr(false),
+			IsValidDomain:                 Ptr(true),
+			IsApexDomain:                  Ptr(true),
+			ShouldBeARecord:               Ptr(true),
+			IsCNAMEToGithubUserDomain:     Ptr(false),
+			IsCNAMEToPagesDotGithubDotCom: Ptr(false),
+			IsCNAMEToFastly:               Ptr(false),
+			IsPointedToGithubPagesIP:      Ptr(true),
+			IsNonGithubPagesIPPresent:     Ptr(false),
+			IsPagesDomain:                 Ptr(false),
+			IsServedByPages:               Ptr(true),
+			IsValid:                       Ptr(true),
+			Reason:                        Ptr("some reason"),
+			RespondsToHTTPS:               Ptr(true),
+			EnforcesHTTPS:                 Ptr(true),
+			HTTPSError:                    Ptr("some error"),
+			IsHTTPSEligible:               Ptr(true),
+			CAAError:                      Ptr("some error"),
 		},
 		AltDomain: &PagesDomain{
-			Host:        String("www.example.com"),
-			URI:         String("http://www.example.com/"),
-			Nameservers: String("default"),
-			DNSResolves: Bool(true),
+			Host:        Ptr("www.example.com"),
+			URI:         Ptr("http://www.example.com/"),
+			Nameservers: Ptr("default"),
+			DNSResolves: Ptr(true),
 		},
 	}
 
@@ -696,8 +697,8 @@ func TestCreatePagesRequest_Marshal(t *testing.T) {
 
 	u := &createPagesRequest{
 		Source: &PagesSource{
-			Branch: String("branch"),
-			Path:   String("path"),
+			Branch: Ptr("branch"),
+			Path:   Ptr("path"),
 		},
 	}
 
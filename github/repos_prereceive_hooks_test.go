@@ -34,7 +34,7 @@ func TestRepositoriesService_ListPreReceiveHooks(t *testing.T) {
 		t.Errorf("Repositories.ListHooks returned error: %v", err)
 	}
 
-	want := []*PreReceiveHook{{ID: Int64(1)}, {ID: Int64(2)}}
+	want := []*PreReceiveHook{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}
 	if !cmp.Equal(hooks, want) {
 		t.Errorf("Repositories.ListPreReceiveHooks returned %+v, want %+v", hooks, want)
 	}
@@ -79,7 +79,7 @@ func TestRepositoriesService_GetPreReceiveHook(t *testing.T) {
 		t.Errorf("Repositories.GetPreReceiveHook returned error: %v", err)
 	}
 
-	want := &PreReceiveHook{ID: Int64(1)}
+	want := &PreReceiveHook{ID: Ptr(int64(1))}
 	if !cmp.Equal(hook, want) {
 		t.Errorf("Repositories.GetPreReceiveHook returned %+v, want %+v", hook, want)
 	}
@@ -132,7 +132,7 @@ func TestRepositoriesService_UpdatePreReceiveHook(t *testing.T) {
 		t.Errorf("Repositories.UpdatePreReceiveHook returned error: %v", err)
 	}
 
-	want := &PreReceiveHook{ID: Int64(1)}
+	want := &PreReceiveHook{ID: Ptr(int64(1))}
 	if !cmp.Equal(hook, want) {
 		t.Errorf("Repositories.UpdatePreReceiveHook returned %+v, want %+v", hook, want)
 	}
@@ -199,10 +199,10 @@ func TestPreReceiveHook_Marshal(t *testing.T) {
 	testJSONMarshal(t, &PreReceiveHook{}, "{}")
 
 	u := &PreReceiveHook{
-		ID:          Int64(1),
-		Name:        String("name"),
-		Enforcement: String("e"),
-		ConfigURL:   String("curl"),
+		ID:          Ptr(int64(1)),
+		Name:        Ptr("name"),
+		Enforcement: Ptr("e"),
+		ConfigURL:   Ptr("curl"),
 	}
 
 	want := `{
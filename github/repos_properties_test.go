@@ -41,11 +41,11 @@ func TestRepositoriesService_GetAllCustomPropertyValues(t *testing.T) {
 	want := []*CustomPropertyValue{
 		{
 			PropertyName: "environment",
-			Value:        String("production"),
+			Value:        Ptr("production"),
 		},
 		{
 			PropertyName: "service",
-			Value:        String("web"),
+			Value:        Ptr("web"),
 		},
 	}
 
@@ -77,7 +77,7 @@ func TestRepositoriesService_CreateOrUpdateCustomProperties(t *testing.T) {
 	RepoCustomProperty := []*CustomPropertyValue{
 		{
 			PropertyName: "environment",
-			Value:        String("production"),
+			Value:        Ptr("production"),
 		},
 	}
 	_, err := client.Repositories.CreateOrUpdateCustomProperties(ctx, "usr", "r", RepoCustomProperty)
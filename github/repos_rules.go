@@ -390,6 +390,16 @@ func NewRequiredWorkflowsRule(params *RequiredWorkflowsRuleParameters) (rule *Re
 	}
 }
 
+// RulesetEnforcement specifies whether a ruleset is enforced.
+type RulesetEnforcement string
+
+// Possible values for Ruleset.Enforcement.
+const (
+	RulesetEnforcementDisabled RulesetEnforcement = "disabled"
+	RulesetEnforcementActive   RulesetEnforcement = "active"
+	RulesetEnforcementEvaluate RulesetEnforcement = "evaluate"
+)
+
 // Ruleset represents a GitHub ruleset object.
 type Ruleset struct {
 	ID   *int64 `json:"id,omitempty"`
@@ -400,7 +410,7 @@ type Ruleset struct {
 	SourceType *string `json:"source_type,omitempty"`
 	Source     string  `json:"source"`
 	// Possible values for Enforcement are: disabled, active, evaluate
-	Enforcement  string             `json:"enforcement"`
+	Enforcement  RulesetEnforcement `json:"enforcement"`
 	BypassActors []*BypassActor     `json:"bypass_actors,omitempty"`
 	NodeID       *string            `json:"node_id,omitempty"`
 	Links        *RulesetLinks      `json:"_links,omitempty"`
@@ -408,6 +418,16 @@ type Ruleset struct {
 	Rules        []*RepositoryRule  `json:"rules,omitempty"`
 }
 
+// Validate reports an error if Enforcement is not a value that the API
+// accepts. The methods that create or update a ruleset call it before making
+// a request if the client was configured with WithValidation.
+func (r *Ruleset) Validate() error {
+	if r == nil {
+		return nil
+	}
+	return validateEnum("enforcement", r.Enforcement, RulesetEnforcementDisabled, RulesetEnforcementActive, RulesetEnforcementEvaluate)
+}
+
 // GetRulesForBranch gets all the rules that apply to the specified branch.
 //
 // GitHub API docs: https://docs.github.com/rest/repos/rules#get-rules-for-a-branch
@@ -459,6 +479,10 @@ func (s *RepositoriesService) GetAllRulesets(ctx context.Context, owner, repo st
 //
 //meta:operation POST /repos/{owner}/{repo}/rulesets
 func (s *RepositoriesService) CreateRuleset(ctx context.Context, owner, repo string, rs *Ruleset) (*Ruleset, *Response, error) {
+	if err := s.client.validate(rs); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/rulesets", owner, repo)
 
 	req, err := s.client.NewRequest("POST", u, rs)
@@ -504,6 +528,10 @@ func (s *RepositoriesService) GetRuleset(ctx context.Context, owner, repo string
 //
 //meta:operation PUT /repos/{owner}/{repo}/rulesets/{ruleset_id}
 func (s *RepositoriesService) UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, rs *Ruleset) (*Ruleset, *Response, error) {
+	if err := s.client.validate(rs); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, rulesetID)
 
 	req, err := s.client.NewRequest("PUT", u, rs)
@@ -215,7 +215,7 @@ func TestRepositoryRule_UnmarshalJSON(t *testing.T) {
 				RequiredStatusChecks: []RuleRequiredStatusChecks{
 					{
 						Context:       "test",
-						IntegrationID: Int64(1),
+						IntegrationID: Ptr(int64(1)),
 					},
 				},
 				StrictRequiredStatusChecksPolicy: true,
@@ -242,7 +242,7 @@ func TestRepositoryRule_UnmarshalJSON(t *testing.T) {
 				RequiredWorkflows: []*RuleRequiredWorkflow{
 					{
 						Path:         ".github/workflows/test.yml",
-						RepositoryID: Int64(1),
+						RepositoryID: Ptr(int64(1)),
 					},
 				},
 			}),
@@ -407,16 +407,16 @@ func TestRepositoriesService_GetAllRulesets(t *testing.T) {
 
 	want := []*Ruleset{
 		{
-			ID:          Int64(42),
+			ID:          Ptr(int64(42)),
 			Name:        "ruleset",
-			SourceType:  String("Repository"),
+			SourceType:  Ptr("Repository"),
 			Source:      "o/repo",
 			Enforcement: "enabled",
 		},
 		{
-			ID:          Int64(314),
+			ID:          Ptr(int64(314)),
 			Name:        "Another ruleset",
-			SourceType:  String("Repository"),
+			SourceType:  Ptr("Repository"),
 			Source:      "o/repo",
 			Enforcement: "enabled",
 		},
@@ -461,9 +461,9 @@ func TestRepositoriesService_CreateRuleset(t *testing.T) {
 	}
 
 	want := &Ruleset{
-		ID:          Int64(42),
+		ID:          Ptr(int64(42)),
 		Name:        "ruleset",
-		SourceType:  String("Repository"),
+		SourceType:  Ptr("Repository"),
 		Source:      "o/repo",
 		Enforcement: "enabled",
 	}
@@ -504,9 +504,9 @@ func TestRepositoriesService_GetRuleset(t *testing.T) {
 	}
 
 	want := &Ruleset{
-		ID:          Int64(42),
+		ID:          Ptr(int64(42)),
 		Name:        "ruleset",
-		SourceType:  String("Organization"),
+		SourceType:  Ptr("Organization"),
 		Source:      "o",
 		Enforcement: "enabled",
 	}
@@ -550,9 +550,9 @@ func TestRepositoriesService_UpdateRuleset(t *testing.T) {
 	}
 
 	want := &Ruleset{
-		ID:          Int64(42),
+		ID:          Ptr(int64(42)),
 		Name:        "ruleset",
-		SourceType:  String("Repository"),
+		SourceType:  Ptr("Repository"),
 		Source:      "o/repo",
 		Enforcement: "enabled",
 	}
@@ -591,3 +591,74 @@ func TestRepositoriesService_DeleteRuleset(t *testing.T) {
 		return client.Repositories.DeleteRuleset(ctx, "o", "repo", 42)
 	})
 }
+
+func TestRuleset_Validate(t *testing.T) {
+	for _, enforcement := range []RulesetEnforcement{RulesetEnforcementDisabled, RulesetEnforcementActive, RulesetEnforcementEvaluate} {
+		if err := (&Ruleset{Enforcement: enforcement}).Validate(); err != nil {
+			t.Errorf("Ruleset{Enforcement: %q}.Validate() returned error: %v", enforcement, err)
+		}
+	}
+
+	err := (&Ruleset{Enforcement: "enabled"}).Validate()
+	if err == nil {
+		t.Fatal("Ruleset.Validate() returned nil error for an unknown enforcement, want error")
+	}
+	if got, want := err.Error(), `invalid enforcement "enabled": must be one of disabled, active, evaluate`; got != want {
+		t.Errorf("Ruleset.Validate() returned error %q, want %q", got, want)
+	}
+
+	if err := (*Ruleset)(nil).Validate(); err != nil {
+		t.Errorf("nil Ruleset.Validate() returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_CreateRuleset_validationNilRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client = client.WithValidation()
+
+	mux.HandleFunc("/repos/o/repo/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 42}`)
+	})
+	mux.HandleFunc("/orgs/o/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 42}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.CreateRuleset(ctx, "o", "repo", nil); err != nil {
+		t.Errorf("Repositories.CreateRuleset returned error: %v", err)
+	}
+	if _, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", nil); err != nil {
+		t.Errorf("Organizations.CreateOrganizationRuleset returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_CreateRuleset_validation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/repo/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"id": 42}`)
+	})
+
+	ctx := context.Background()
+	rs := &Ruleset{Name: "r", Enforcement: "enabled"}
+
+	// Without WithValidation the API is left to reject the value.
+	if _, _, err := client.Repositories.CreateRuleset(ctx, "o", "repo", rs); err != nil {
+		t.Errorf("Repositories.CreateRuleset returned error: %v", err)
+	}
+
+	client = client.WithValidation()
+	if _, _, err := client.Repositories.CreateRuleset(ctx, "o", "repo", rs); err == nil {
+		t.Error("Repositories.CreateRuleset returned nil error, want error")
+	}
+	if _, _, err := client.Repositories.UpdateRuleset(ctx, "o", "repo", 42, rs); err == nil {
+		t.Error("Repositories.UpdateRuleset returned nil error, want error")
+	}
+	if calls != 1 {
+		t.Errorf("server received %v requests, want 1", calls)
+	}
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 )
@@ -139,8 +140,8 @@ func (s *RepositoriesService) ListCodeFrequency(ctx context.Context, owner, repo
 		}
 		stat := &WeeklyStats{
 			Week:      &Timestamp{time.Unix(int64(week[0]), 0)},
-			Additions: Int(week[1]),
-			Deletions: Int(week[2]),
+			Additions: Ptr(week[1]),
+			Deletions: Ptr(week[2]),
 		}
 		stats = append(stats, stat)
 	}
@@ -231,12 +232,51 @@ func (s *RepositoriesService) ListPunchCard(ctx context.Context, owner, repo str
 			continue
 		}
 		card := &PunchCard{
-			Day:     Int(result[0]),
-			Hour:    Int(result[1]),
-			Commits: Int(result[2]),
+			Day:     Ptr(result[0]),
+			Hour:    Ptr(result[1]),
+			Commits: Ptr(result[2]),
 		}
 		cards = append(cards, card)
 	}
 
 	return cards, resp, nil
 }
+
+// WaitForStats calls fn until it returns something other than an
+// *AcceptedError, which the statistics endpoints return while GitHub is still
+// computing the requested statistics, or until ctx is done. Before each retry
+// it waits for backoff(attempt), where attempt starts at 1; if backoff is nil,
+// it waits one second, doubling on each attempt up to 30 seconds.
+//
+// For example:
+//
+//	stats, _, err := github.WaitForStats(ctx, func(ctx context.Context) ([]*github.ContributorStats, *github.Response, error) {
+//		return client.Repositories.ListContributorsStats(ctx, owner, repo)
+//	}, nil)
+func WaitForStats[T any](ctx context.Context, fn func(context.Context) (T, *Response, error), backoff func(attempt int) time.Duration) (T, *Response, error) {
+	if backoff == nil {
+		backoff = defaultStatsBackoff
+	}
+	for attempt := 1; ; attempt++ {
+		v, resp, err := fn(ctx)
+		var acceptedErr *AcceptedError
+		if !errors.As(err, &acceptedErr) {
+			return v, resp, err
+		}
+
+		timer := time.NewTimer(backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return v, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func defaultStatsBackoff(attempt int) time.Duration {
+	if attempt > 5 {
+		return 30 * time.Second
+	}
+	return time.Second << (attempt - 1)
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -52,16 +53,16 @@ func TestRepositoriesService_ListContributorsStats(t *testing.T) {
 	want := []*ContributorStats{
 		{
 			Author: &Contributor{
-				ID:     Int64(1),
-				NodeID: String("nodeid-1"),
+				ID:     Ptr(int64(1)),
+				NodeID: Ptr("nodeid-1"),
 			},
-			Total: Int(135),
+			Total: Ptr(135),
 			Weeks: []*WeeklyStats{
 				{
 					Week:      &Timestamp{time.Date(2013, time.May, 05, 00, 00, 00, 0, time.UTC).Local()},
-					Additions: Int(6898),
-					Deletions: Int(77),
-					Commits:   Int(10),
+					Additions: Ptr(6898),
+					Deletions: Ptr(77),
+					Commits:   Ptr(10),
 				},
 			},
 		},
@@ -113,7 +114,7 @@ func TestRepositoriesService_ListCommitActivity(t *testing.T) {
 	want := []*WeeklyCommitActivity{
 		{
 			Days:  []int{0, 3, 26, 20, 39, 1, 0},
-			Total: Int(89),
+			Total: Ptr(89),
 			Week:  &Timestamp{time.Date(2012, time.May, 06, 05, 00, 00, 0, time.UTC).Local()},
 		},
 	}
@@ -155,8 +156,8 @@ func TestRepositoriesService_ListCodeFrequency(t *testing.T) {
 
 	want := []*WeeklyStats{{
 		Week:      &Timestamp{time.Date(2011, time.April, 17, 00, 00, 00, 0, time.UTC).Local()},
-		Additions: Int(1124),
-		Deletions: Int(-435),
+		Additions: Ptr(1124),
+		Deletions: Ptr(-435),
 	}}
 
 	if !cmp.Equal(code, want) {
@@ -264,9 +265,9 @@ func TestRepositoriesService_ListPunchCard(t *testing.T) {
 	}
 
 	want := []*PunchCard{
-		{Day: Int(0), Hour: Int(0), Commits: Int(5)},
-		{Day: Int(0), Hour: Int(1), Commits: Int(43)},
-		{Day: Int(0), Hour: Int(2), Commits: Int(21)},
+		{Day: Ptr(0), Hour: Ptr(0), Commits: Ptr(5)},
+		{Day: Ptr(0), Hour: Ptr(1), Commits: Ptr(43)},
+		{Day: Ptr(0), Hour: Ptr(2), Commits: Ptr(21)},
 	}
 
 	if !cmp.Equal(card, want) {
@@ -349,7 +350,7 @@ func TestWeeklyCommitActivity_Marshal(t *testing.T) {
 
 	u := &WeeklyCommitActivity{
 		Days:  []int{1},
-		Total: Int(1),
+		Total: Ptr(1),
 		Week:  &Timestamp{referenceTime},
 	}
 
@@ -369,9 +370,9 @@ func TestWeeklyStats_Marshal(t *testing.T) {
 
 	u := &WeeklyStats{
 		Week:      &Timestamp{referenceTime},
-		Additions: Int(1),
-		Deletions: Int(1),
-		Commits:   Int(1),
+		Additions: Ptr(1),
+		Deletions: Ptr(1),
+		Commits:   Ptr(1),
 	}
 
 	want := `{
@@ -388,14 +389,14 @@ func TestContributorStats_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ContributorStats{}, "{}")
 
 	u := &ContributorStats{
-		Author: &Contributor{ID: Int64(1)},
-		Total:  Int(1),
+		Author: &Contributor{ID: Ptr(int64(1))},
+		Total:  Ptr(1),
 		Weeks: []*WeeklyStats{
 			{
 				Week:      &Timestamp{referenceTime},
-				Additions: Int(1),
-				Deletions: Int(1),
-				Commits:   Int(1),
+				Additions: Ptr(1),
+				Deletions: Ptr(1),
+				Commits:   Ptr(1),
 			},
 		},
 	}
@@ -417,3 +418,90 @@ func TestContributorStats_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestWaitForStats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/stats/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `[{"total": 1}]`)
+	})
+
+	var attempts []int
+	backoff := func(attempt int) time.Duration {
+		attempts = append(attempts, attempt)
+		return time.Millisecond
+	}
+
+	ctx := context.Background()
+	stats, resp, err := WaitForStats(ctx, func(ctx context.Context) ([]*ContributorStats, *Response, error) {
+		return client.Repositories.ListContributorsStats(ctx, "o", "r")
+	}, backoff)
+	if err != nil {
+		t.Fatalf("WaitForStats returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("WaitForStats returned status %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	want := []*ContributorStats{{Total: Ptr(1)}}
+	if !cmp.Equal(stats, want) {
+		t.Errorf("WaitForStats returned %+v, want %+v", stats, want)
+	}
+	if !cmp.Equal(attempts, []int{1, 2}) {
+		t.Errorf("backoff called with %v, want [1 2]", attempts)
+	}
+}
+
+func TestWaitForStats_contextDone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stats/participation", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, resp, err := WaitForStats(ctx, func(ctx context.Context) (*RepositoryParticipation, *Response, error) {
+		return client.Repositories.ListParticipation(ctx, "o", "r")
+	}, func(int) time.Duration { return time.Hour })
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForStats returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if resp == nil || resp.StatusCode != http.StatusAccepted {
+		t.Errorf("WaitForStats returned response %v, want status %v", resp, http.StatusAccepted)
+	}
+}
+
+func TestWaitForStats_otherError(t *testing.T) {
+	wantErr := errors.New("boom")
+	calls := 0
+	_, _, err := WaitForStats(context.Background(), func(context.Context) (int, *Response, error) {
+		calls++
+		return 0, nil, wantErr
+	}, nil)
+	if err != wantErr || calls != 1 {
+		t.Errorf("WaitForStats returned %v after %v calls, want %v after 1 call", err, calls, wantErr)
+	}
+}
+
+func TestDefaultStatsBackoff(t *testing.T) {
+	for attempt, want := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		5: 16 * time.Second,
+		6: 30 * time.Second,
+		9: 30 * time.Second,
+	} {
+		if got := defaultStatsBackoff(attempt); got != want {
+			t.Errorf("defaultStatsBackoff(%v) = %v, want %v", attempt, got, want)
+		}
+	}
+}
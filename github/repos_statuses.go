@@ -10,6 +10,17 @@ import (
 	"fmt"
 )
 
+// StatusState is the state of a commit status.
+type StatusState string
+
+// Possible values for RepoStatus.State.
+const (
+	StatusStatePending StatusState = "pending"
+	StatusStateSuccess StatusState = "success"
+	StatusStateError   StatusState = "error"
+	StatusStateFailure StatusState = "failure"
+)
+
 // RepoStatus represents the status of a repository at a particular reference.
 type RepoStatus struct {
 	ID     *int64  `json:"id,omitempty"`
@@ -18,7 +29,7 @@ type RepoStatus struct {
 
 	// State is the current state of the repository. Possible values are:
 	// pending, success, error, or failure.
-	State *string `json:"state,omitempty"`
+	State *StatusState `json:"state,omitempty"`
 
 	// TargetURL is the URL of the page representing this status. It will be
 	// linked from the GitHub UI to allow users to see the source of the status.
@@ -42,6 +53,16 @@ func (r RepoStatus) String() string {
 	return Stringify(r)
 }
 
+// Validate reports an error if State is set to a value that the API does not
+// accept. CreateStatus calls it before making a request if the client was
+// configured with WithValidation.
+func (r *RepoStatus) Validate() error {
+	if r == nil || r.State == nil {
+		return nil
+	}
+	return validateEnum("state", *r.State, StatusStatePending, StatusStateSuccess, StatusStateError, StatusStateFailure)
+}
+
 // ListStatuses lists the statuses of a repository at the specified
 // reference. ref can be a SHA, a branch name, or a tag name.
 //
@@ -76,6 +97,10 @@ func (s *RepositoriesService) ListStatuses(ctx context.Context, owner, repo, ref
 //
 //meta:operation POST /repos/{owner}/{repo}/statuses/{sha}
 func (s *RepositoriesService) CreateStatus(ctx context.Context, owner, repo, ref string, status *RepoStatus) (*RepoStatus, *Response, error) {
+	if err := s.client.validate(status); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/statuses/%v", owner, repo, refURLEscape(ref))
 	req, err := s.client.NewRequest("POST", u, status)
 	if err != nil {
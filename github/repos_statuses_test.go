@@ -32,7 +32,7 @@ func TestRepositoriesService_ListStatuses(t *testing.T) {
 		t.Errorf("Repositories.ListStatuses returned error: %v", err)
 	}
 
-	want := []*RepoStatus{{ID: Int64(1)}}
+	want := []*RepoStatus{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(statuses, want) {
 		t.Errorf("Repositories.ListStatuses returned %+v, want %+v", statuses, want)
 	}
@@ -65,7 +65,7 @@ func TestRepositoriesService_CreateStatus(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &RepoStatus{State: String("s"), TargetURL: String("t"), Description: String("d")}
+	input := &RepoStatus{State: Ptr(StatusState("s")), TargetURL: Ptr("t"), Description: Ptr("d")}
 
 	mux.HandleFunc("/repos/o/r/statuses/r", func(w http.ResponseWriter, r *http.Request) {
 		v := new(RepoStatus)
@@ -84,7 +84,7 @@ func TestRepositoriesService_CreateStatus(t *testing.T) {
 		t.Errorf("Repositories.CreateStatus returned error: %v", err)
 	}
 
-	want := &RepoStatus{ID: Int64(1)}
+	want := &RepoStatus{ID: Ptr(int64(1))}
 	if !cmp.Equal(status, want) {
 		t.Errorf("Repositories.CreateStatus returned %+v, want %+v", status, want)
 	}
@@ -130,7 +130,7 @@ func TestRepositoriesService_GetCombinedStatus(t *testing.T) {
 		t.Errorf("Repositories.GetCombinedStatus returned error: %v", err)
 	}
 
-	want := &CombinedStatus{State: String("success"), Statuses: []*RepoStatus{{ID: Int64(1)}}}
+	want := &CombinedStatus{State: Ptr("success"), Statuses: []*RepoStatus{{ID: Ptr(int64(1))}}}
 	if !cmp.Equal(status, want) {
 		t.Errorf("Repositories.GetCombinedStatus returned %+v, want %+v", status, want)
 	}
@@ -154,15 +154,15 @@ func TestRepoStatus_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepoStatus{}, "{}")
 
 	u := &RepoStatus{
-		ID:          Int64(1),
-		NodeID:      String("nid"),
-		URL:         String("url"),
-		State:       String("state"),
-		TargetURL:   String("turl"),
-		Description: String("desc"),
-		Context:     String("ctx"),
-		AvatarURL:   String("aurl"),
-		Creator:     &User{ID: Int64(1)},
+		ID:          Ptr(int64(1)),
+		NodeID:      Ptr("nid"),
+		URL:         Ptr("url"),
+		State:       Ptr(StatusState("state")),
+		TargetURL:   Ptr("turl"),
+		Description: Ptr("desc"),
+		Context:     Ptr("ctx"),
+		AvatarURL:   Ptr("aurl"),
+		Creator:     &User{ID: Ptr(int64(1))},
 		CreatedAt:   &Timestamp{referenceTime},
 		UpdatedAt:   &Timestamp{referenceTime},
 	}
@@ -190,27 +190,27 @@ func TestCombinedStatus_Marshal(t *testing.T) {
 	testJSONMarshal(t, &CombinedStatus{}, "{}")
 
 	u := &CombinedStatus{
-		State:      String("state"),
-		Name:       String("name"),
-		SHA:        String("sha"),
-		TotalCount: Int(1),
+		State:      Ptr("state"),
+		Name:       Ptr("name"),
+		SHA:        Ptr("sha"),
+		TotalCount: Ptr(1),
 		Statuses: []*RepoStatus{
 			{
-				ID:          Int64(1),
-				NodeID:      String("nid"),
-				URL:         String("url"),
-				State:       String("state"),
-				TargetURL:   String("turl"),
-				Description: String("desc"),
-				Context:     String("ctx"),
-				AvatarURL:   String("aurl"),
-				Creator:     &User{ID: Int64(1)},
+				ID:          Ptr(int64(1)),
+				NodeID:      Ptr("nid"),
+				URL:         Ptr("url"),
+				State:       Ptr(StatusState("state")),
+				TargetURL:   Ptr("turl"),
+				Description: Ptr("desc"),
+				Context:     Ptr("ctx"),
+				AvatarURL:   Ptr("aurl"),
+				Creator:     &User{ID: Ptr(int64(1))},
 				CreatedAt:   &Timestamp{referenceTime},
 				UpdatedAt:   &Timestamp{referenceTime},
 			},
 		},
-		CommitURL:     String("curl"),
-		RepositoryURL: String("rurl"),
+		CommitURL:     Ptr("curl"),
+		RepositoryURL: Ptr("rurl"),
 	}
 
 	want := `{
@@ -241,3 +241,60 @@ func TestCombinedStatus_Marshal(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRepoStatus_Validate(t *testing.T) {
+	tests := []struct {
+		state   *StatusState
+		wantErr bool
+	}{
+		{nil, false},
+		{Ptr(StatusStatePending), false},
+		{Ptr(StatusStateSuccess), false},
+		{Ptr(StatusStateError), false},
+		{Ptr(StatusStateFailure), false},
+		{Ptr(StatusState("succeeded")), true},
+		{Ptr(StatusState("")), true},
+	}
+
+	for _, tt := range tests {
+		err := (&RepoStatus{State: tt.state}).Validate()
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("RepoStatus{State: %v}.Validate() returned error %v, want error: %v", Stringify(tt.state), err, tt.wantErr)
+		}
+	}
+}
+
+func TestRepositoriesService_CreateStatus_validation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client = client.WithValidation()
+
+	mux.HandleFunc("/repos/o/r/statuses/r", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("CreateStatus sent a request with an invalid state")
+	})
+
+	ctx := context.Background()
+	input := &RepoStatus{State: Ptr(StatusState("succeeded"))}
+	if _, _, err := client.Repositories.CreateStatus(ctx, "o", "r", "r", input); err == nil {
+		t.Error("Repositories.CreateStatus returned nil error, want error")
+	}
+}
+
+func TestRepositoriesService_CreateStatus_validationNilStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client = client.WithValidation()
+
+	mux.HandleFunc("/repos/o/r/statuses/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.CreateStatus(ctx, "o", "r", "r", nil); err != nil {
+		t.Errorf("Repositories.CreateStatus returned error: %v", err)
+	}
+	if err := (*RepoStatus)(nil).Validate(); err != nil {
+		t.Errorf("nil RepoStatus.Validate() returned error: %v", err)
+	}
+}
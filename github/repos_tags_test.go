@@ -31,7 +31,7 @@ func TestRepositoriesService_ListTagProtection(t *testing.T) {
 		t.Errorf("Repositories.ListTagProtection returned error: %v", err)
 	}
 
-	want := []*TagProtection{{ID: Int64(1), Pattern: String("tag1")}, {ID: Int64(2), Pattern: String("tag2")}}
+	want := []*TagProtection{{ID: Ptr(int64(1)), Pattern: Ptr("tag1")}, {ID: Ptr(int64(2)), Pattern: Ptr("tag2")}}
 	if !cmp.Equal(tagProtections, want) {
 		t.Errorf("Repositories.ListTagProtection returned %+v, want %+v", tagProtections, want)
 	}
@@ -85,7 +85,7 @@ func TestRepositoriesService_CreateTagProtection(t *testing.T) {
 		t.Errorf("Repositories.CreateTagProtection returned error: %v", err)
 	}
 
-	want := &TagProtection{ID: Int64(1), Pattern: String("tag*")}
+	want := &TagProtection{ID: Ptr(int64(1)), Pattern: Ptr("tag*")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.CreateTagProtection returned %+v, want %+v", got, want)
 	}
@@ -135,8 +135,8 @@ func TestTagProtection_Marshal(t *testing.T) {
 	testJSONMarshal(t, &TagProtection{}, "{}")
 
 	u := &TagProtection{
-		ID:      Int64(1),
-		Pattern: String("pattern"),
+		ID:      Ptr(int64(1)),
+		Pattern: Ptr("pattern"),
 	}
 
 	want := `{
@@ -33,7 +33,7 @@ func TestRepositoriesService_ListByAuthenticatedUser(t *testing.T) {
 		t.Errorf("Repositories.List returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}, {ID: Int64(2)}}
+	want := []*Repository{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.ListByAuthenticatedUser returned %+v, want %+v", got, want)
 	}
@@ -74,7 +74,7 @@ func TestRepositoriesService_ListByUser(t *testing.T) {
 		t.Errorf("Repositories.List returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(repos, want) {
 		t.Errorf("Repositories.ListByUser returned %+v, want %+v", repos, want)
 	}
@@ -115,7 +115,7 @@ func TestRepositoriesService_ListByUser_type(t *testing.T) {
 		t.Errorf("Repositories.ListByUser returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(repos, want) {
 		t.Errorf("Repositories.ListByUser returned %+v, want %+v", repos, want)
 	}
@@ -155,7 +155,7 @@ func TestRepositoriesService_ListByOrg(t *testing.T) {
 		t.Errorf("Repositories.ListByOrg returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.ListByOrg returned %+v, want %+v", got, want)
 	}
@@ -203,7 +203,7 @@ func TestRepositoriesService_ListAll(t *testing.T) {
 		t.Errorf("Repositories.ListAll returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.ListAll returned %+v, want %+v", got, want)
 	}
@@ -223,8 +223,8 @@ func TestRepositoriesService_Create_user(t *testing.T) {
 	defer teardown()
 
 	input := &Repository{
-		Name:     String("n"),
-		Archived: Bool(true), // not passed along.
+		Name:     Ptr("n"),
+		Archived: Ptr(true), // not passed along.
 	}
 
 	wantAcceptHeaders := []string{mediaTypeRepositoryTemplatePreview, mediaTypeRepositoryVisibilityPreview}
@@ -234,7 +234,7 @@ func TestRepositoriesService_Create_user(t *testing.T) {
 
 		testMethod(t, r, "POST")
 		testHeader(t, r, "Accept", strings.Join(wantAcceptHeaders, ", "))
-		want := &createRepoRequest{Name: String("n")}
+		want := &createRepoRequest{Name: Ptr("n")}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -248,7 +248,7 @@ func TestRepositoriesService_Create_user(t *testing.T) {
 		t.Errorf("Repositories.Create returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1)}
+	want := &Repository{ID: Ptr(int64(1))}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.Create returned %+v, want %+v", got, want)
 	}
@@ -273,8 +273,8 @@ func TestRepositoriesService_Create_org(t *testing.T) {
 	defer teardown()
 
 	input := &Repository{
-		Name:     String("n"),
-		Archived: Bool(true), // not passed along.
+		Name:     Ptr("n"),
+		Archived: Ptr(true), // not passed along.
 	}
 
 	wantAcceptHeaders := []string{mediaTypeRepositoryTemplatePreview, mediaTypeRepositoryVisibilityPreview}
@@ -284,7 +284,7 @@ func TestRepositoriesService_Create_org(t *testing.T) {
 
 		testMethod(t, r, "POST")
 		testHeader(t, r, "Accept", strings.Join(wantAcceptHeaders, ", "))
-		want := &createRepoRequest{Name: String("n")}
+		want := &createRepoRequest{Name: Ptr("n")}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -298,7 +298,7 @@ func TestRepositoriesService_Create_org(t *testing.T) {
 		t.Errorf("Repositories.Create returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1)}
+	want := &Repository{ID: Ptr(int64(1))}
 	if !cmp.Equal(repo, want) {
 		t.Errorf("Repositories.Create returned %+v, want %+v", repo, want)
 	}
@@ -309,7 +309,7 @@ func TestRepositoriesService_CreateFromTemplate(t *testing.T) {
 	defer teardown()
 
 	templateRepoReq := &TemplateRepoRequest{
-		Name: String("n"),
+		Name: Ptr("n"),
 	}
 
 	mux.HandleFunc("/repos/to/tr/generate", func(w http.ResponseWriter, r *http.Request) {
@@ -318,7 +318,7 @@ func TestRepositoriesService_CreateFromTemplate(t *testing.T) {
 
 		testMethod(t, r, "POST")
 		testHeader(t, r, "Accept", mediaTypeRepositoryTemplatePreview)
-		want := &TemplateRepoRequest{Name: String("n")}
+		want := &TemplateRepoRequest{Name: Ptr("n")}
 		if !cmp.Equal(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -332,7 +332,7 @@ func TestRepositoriesService_CreateFromTemplate(t *testing.T) {
 		t.Errorf("Repositories.CreateFromTemplate returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1), Name: String("n")}
+	want := &Repository{ID: Ptr(int64(1)), Name: Ptr("n")}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.CreateFromTemplate returned %+v, want %+v", got, want)
 	}
@@ -369,7 +369,7 @@ func TestRepositoriesService_Get(t *testing.T) {
 		t.Errorf("Repositories.Get returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1), Name: String("n"), Description: String("d"), Owner: &User{Login: String("l")}, License: &License{Key: String("mit")}, SecurityAndAnalysis: &SecurityAndAnalysis{AdvancedSecurity: &AdvancedSecurity{Status: String("enabled")}, SecretScanning: &SecretScanning{String("enabled")}, SecretScanningPushProtection: &SecretScanningPushProtection{String("enabled")}, DependabotSecurityUpdates: &DependabotSecurityUpdates{String("enabled")}, SecretScanningValidityChecks: &SecretScanningValidityChecks{String("enabled")}}}
+	want := &Repository{ID: Ptr(int64(1)), Name: Ptr("n"), Description: Ptr("d"), Owner: &User{Login: Ptr("l")}, License: &License{Key: Ptr("mit")}, SecurityAndAnalysis: &SecurityAndAnalysis{AdvancedSecurity: &AdvancedSecurity{Status: Ptr("enabled")}, SecretScanning: &SecretScanning{Ptr("enabled")}, SecretScanningPushProtection: &SecretScanningPushProtection{Ptr("enabled")}, DependabotSecurityUpdates: &DependabotSecurityUpdates{Ptr("enabled")}, SecretScanningValidityChecks: &SecretScanningValidityChecks{Ptr("enabled")}}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.Get returned %+v, want %+v", got, want)
 	}
@@ -413,10 +413,10 @@ func TestRepositoriesService_GetCodeOfConduct(t *testing.T) {
 	}
 
 	want := &CodeOfConduct{
-		Key:  String("key"),
-		Name: String("name"),
-		URL:  String("url"),
-		Body: String("body"),
+		Key:  Ptr("key"),
+		Name: Ptr("name"),
+		URL:  Ptr("url"),
+		Body: Ptr("body"),
 	}
 
 	if !cmp.Equal(got, want) {
@@ -453,7 +453,7 @@ func TestRepositoriesService_GetByID(t *testing.T) {
 		t.Fatalf("Repositories.GetByID returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1), Name: String("n"), Description: String("d"), Owner: &User{Login: String("l")}, License: &License{Key: String("mit")}}
+	want := &Repository{ID: Ptr(int64(1)), Name: Ptr("n"), Description: Ptr("d"), Owner: &User{Login: Ptr("l")}, License: &License{Key: Ptr("mit")}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.GetByID returned %+v, want %+v", got, want)
 	}
@@ -494,7 +494,7 @@ func TestRepositoriesService_Edit(t *testing.T) {
 		t.Errorf("Repositories.Edit returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1)}
+	want := &Repository{ID: Ptr(int64(1))}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.Edit returned %+v, want %+v", got, want)
 	}
@@ -679,8 +679,8 @@ func TestRepositoriesService_GetAutomatedSecurityFixes(t *testing.T) {
 	}
 
 	want := &AutomatedSecurityFixes{
-		Enabled: Bool(true),
-		Paused:  Bool(false),
+		Enabled: Ptr(true),
+		Paused:  Ptr(false),
 	}
 	if !cmp.Equal(fixes, want) {
 		t.Errorf("Repositories.GetAutomatedSecurityFixes returned #{fixes}, want #{want}")
@@ -729,14 +729,14 @@ func TestRepositoriesService_ListContributors(t *testing.T) {
 		fmt.Fprint(w, `[{"contributions":42}]`)
 	})
 
-	opts := &ListContributorsOptions{Anon: "true", ListOptions: ListOptions{Page: 2}}
+	opts := &ListContributorsOptions{Anon: true, ListOptions: ListOptions{Page: 2}}
 	ctx := context.Background()
 	contributors, _, err := client.Repositories.ListContributors(ctx, "o", "r", opts)
 	if err != nil {
 		t.Errorf("Repositories.ListContributors returned error: %v", err)
 	}
 
-	want := []*Contributor{{Contributions: Int(42)}}
+	want := []*Contributor{{Contributions: Ptr(42)}}
 	if !cmp.Equal(contributors, want) {
 		t.Errorf("Repositories.ListContributors returned %+v, want %+v", contributors, want)
 	}
@@ -808,7 +808,7 @@ func TestRepositoriesService_ListTeams(t *testing.T) {
 		t.Errorf("Repositories.ListTeams returned error: %v", err)
 	}
 
-	want := []*Team{{ID: Int64(1)}}
+	want := []*Team{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(teams, want) {
 		t.Errorf("Repositories.ListTeams returned %+v, want %+v", teams, want)
 	}
@@ -847,13 +847,13 @@ func TestRepositoriesService_ListTags(t *testing.T) {
 
 	want := []*RepositoryTag{
 		{
-			Name: String("n"),
+			Name: Ptr("n"),
 			Commit: &Commit{
-				SHA: String("s"),
-				URL: String("u"),
+				SHA: Ptr("s"),
+				URL: Ptr("u"),
 			},
-			ZipballURL: String("z"),
-			TarballURL: String("t"),
+			ZipballURL: Ptr("z"),
+			TarballURL: Ptr("t"),
 		},
 	}
 	if !cmp.Equal(tags, want) {
@@ -895,7 +895,7 @@ func TestRepositoriesService_ListBranches(t *testing.T) {
 		t.Errorf("Repositories.ListBranches returned error: %v", err)
 	}
 
-	want := []*Branch{{Name: String("master"), Commit: &RepositoryCommit{SHA: String("a57781"), URL: String("https://api.github.com/repos/o/r/commits/a57781")}}}
+	want := []*Branch{{Name: Ptr("master"), Commit: &RepositoryCommit{SHA: Ptr("a57781"), URL: Ptr("https://api.github.com/repos/o/r/commits/a57781")}}}
 	if !cmp.Equal(branches, want) {
 		t.Errorf("Repositories.ListBranches returned %+v, want %+v", branches, want)
 	}
@@ -940,14 +940,14 @@ func TestRepositoriesService_GetBranch(t *testing.T) {
 		}
 
 		want := &Branch{
-			Name: String("n"),
+			Name: Ptr("n"),
 			Commit: &RepositoryCommit{
-				SHA: String("s"),
+				SHA: Ptr("s"),
 				Commit: &Commit{
-					Message: String("m"),
+					Message: Ptr("m"),
 				},
 			},
-			Protected: Bool(true),
+			Protected: Ptr(true),
 			Protection: &Protection{
 				RequiredStatusChecks: &RequiredStatusChecks{
 					Contexts: &[]string{"c"},
@@ -1017,14 +1017,14 @@ func TestRepositoriesService_GetBranch_StatusMovedPermanently_followRedirects(t
 	}
 
 	want := &Branch{
-		Name: String("n"),
+		Name: Ptr("n"),
 		Commit: &RepositoryCommit{
-			SHA: String("s"),
+			SHA: Ptr("s"),
 			Commit: &Commit{
-				Message: String("m"),
+				Message: Ptr("m"),
 			},
 		},
-		Protected: Bool(true),
+		Protected: Ptr(true),
 		Protection: &Protection{
 			RequiredStatusChecks: &RequiredStatusChecks{
 				Contexts: &[]string{"c"},
@@ -1112,7 +1112,7 @@ func TestRepositoriesService_RenameBranch(t *testing.T) {
 				t.Errorf("Repositories.RenameBranch returned error: %v", err)
 			}
 
-			want := &Branch{Name: String("nn"), Protected: Bool(true)}
+			want := &Branch{Name: Ptr("nn"), Protected: Ptr(true)}
 			if !cmp.Equal(got, want) {
 				t.Errorf("Repositories.RenameBranch returned %+v, want %+v", got, want)
 			}
@@ -1228,13 +1228,13 @@ func TestRepositoriesService_GetBranchProtection(t *testing.T) {
 					DismissStaleReviews: true,
 					DismissalRestrictions: &DismissalRestrictions{
 						Users: []*User{
-							{Login: String("u"), ID: Int64(3)},
+							{Login: Ptr("u"), ID: Ptr(int64(3))},
 						},
 						Teams: []*Team{
-							{Slug: String("t"), ID: Int64(4)},
+							{Slug: Ptr("t"), ID: Ptr(int64(4))},
 						},
 						Apps: []*App{
-							{Slug: String("a"), ID: Int64(5)},
+							{Slug: Ptr("a"), ID: Ptr(int64(5))},
 						},
 					},
 					RequireCodeOwnerReviews:      true,
@@ -1242,31 +1242,31 @@ func TestRepositoriesService_GetBranchProtection(t *testing.T) {
 					RequireLastPushApproval:      false,
 				},
 				EnforceAdmins: &AdminEnforcement{
-					URL:     String(test.enforceAdminsURLPath),
+					URL:     Ptr(test.enforceAdminsURLPath),
 					Enabled: true,
 				},
 				Restrictions: &BranchRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 				RequiredConversationResolution: &RequiredConversationResolution{
 					Enabled: true,
 				},
 				BlockCreations: &BlockCreations{
-					Enabled: Bool(false),
+					Enabled: Ptr(false),
 				},
 				LockBranch: &LockBranch{
-					Enabled: Bool(false),
+					Enabled: Ptr(false),
 				},
 				AllowForkSyncing: &AllowForkSyncing{
-					Enabled: Bool(false),
+					Enabled: Ptr(false),
 				},
 			}
 			if !cmp.Equal(protection, want) {
@@ -1358,15 +1358,15 @@ func TestRepositoriesService_GetBranchProtection_noDismissalRestrictions(t *test
 				RequiredApprovingReviewCount: 1,
 			},
 			EnforceAdmins: &AdminEnforcement{
-				URL:     String(test.enforceAdminsURLPath),
+				URL:     Ptr(test.enforceAdminsURLPath),
 				Enabled: true,
 			},
 			Restrictions: &BranchRestrictions{
 				Users: []*User{
-					{Login: String("u"), ID: Int64(1)},
+					{Login: Ptr("u"), ID: Ptr(int64(1))},
 				},
 				Teams: []*Team{
-					{Slug: String("t"), ID: Int64(2)},
+					{Slug: Ptr("t"), ID: Ptr(int64(2))},
 				},
 			},
 		}
@@ -1451,9 +1451,9 @@ func TestRepositoriesService_UpdateBranchProtection_Contexts(t *testing.T) {
 					Teams: []string{"t"},
 					Apps:  []string{"a"},
 				},
-				BlockCreations:   Bool(true),
-				LockBranch:       Bool(true),
-				AllowForkSyncing: Bool(true),
+				BlockCreations:   Ptr(true),
+				LockBranch:       Ptr(true),
+				AllowForkSyncing: Ptr(true),
 			}
 
 			mux.HandleFunc(test.urlPath, func(w http.ResponseWriter, r *http.Request) {
@@ -1538,47 +1538,47 @@ func TestRepositoriesService_UpdateBranchProtection_Contexts(t *testing.T) {
 					DismissStaleReviews: true,
 					DismissalRestrictions: &DismissalRestrictions{
 						Users: []*User{
-							{Login: String("uu"), ID: Int64(3)},
+							{Login: Ptr("uu"), ID: Ptr(int64(3))},
 						},
 						Teams: []*Team{
-							{Slug: String("tt"), ID: Int64(4)},
+							{Slug: Ptr("tt"), ID: Ptr(int64(4))},
 						},
 						Apps: []*App{
-							{Slug: String("aa"), ID: Int64(5)},
+							{Slug: Ptr("aa"), ID: Ptr(int64(5))},
 						},
 					},
 					RequireCodeOwnerReviews: true,
 					BypassPullRequestAllowances: &BypassPullRequestAllowances{
 						Users: []*User{
-							{Login: String("uuu"), ID: Int64(10)},
+							{Login: Ptr("uuu"), ID: Ptr(int64(10))},
 						},
 						Teams: []*Team{
-							{Slug: String("ttt"), ID: Int64(20)},
+							{Slug: Ptr("ttt"), ID: Ptr(int64(20))},
 						},
 						Apps: []*App{
-							{Slug: String("aaa"), ID: Int64(30)},
+							{Slug: Ptr("aaa"), ID: Ptr(int64(30))},
 						},
 					},
 				},
 				Restrictions: &BranchRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 				BlockCreations: &BlockCreations{
-					Enabled: Bool(true),
+					Enabled: Ptr(true),
 				},
 				LockBranch: &LockBranch{
-					Enabled: Bool(true),
+					Enabled: Ptr(true),
 				},
 				AllowForkSyncing: &AllowForkSyncing{
-					Enabled: Bool(true),
+					Enabled: Ptr(true),
 				},
 			}
 			if !cmp.Equal(protection, want) {
@@ -1639,9 +1639,9 @@ func TestRepositoriesService_UpdateBranchProtection_EmptyContexts(t *testing.T)
 					Teams: []string{"t"},
 					Apps:  []string{"a"},
 				},
-				BlockCreations:   Bool(true),
-				LockBranch:       Bool(true),
-				AllowForkSyncing: Bool(true),
+				BlockCreations:   Ptr(true),
+				LockBranch:       Ptr(true),
+				AllowForkSyncing: Ptr(true),
 			}
 
 			mux.HandleFunc(test.urlPath, func(w http.ResponseWriter, r *http.Request) {
@@ -1716,47 +1716,47 @@ func TestRepositoriesService_UpdateBranchProtection_EmptyContexts(t *testing.T)
 					DismissStaleReviews: true,
 					DismissalRestrictions: &DismissalRestrictions{
 						Users: []*User{
-							{Login: String("uu"), ID: Int64(3)},
+							{Login: Ptr("uu"), ID: Ptr(int64(3))},
 						},
 						Teams: []*Team{
-							{Slug: String("tt"), ID: Int64(4)},
+							{Slug: Ptr("tt"), ID: Ptr(int64(4))},
 						},
 						Apps: []*App{
-							{Slug: String("aa"), ID: Int64(5)},
+							{Slug: Ptr("aa"), ID: Ptr(int64(5))},
 						},
 					},
 					RequireCodeOwnerReviews: true,
 					BypassPullRequestAllowances: &BypassPullRequestAllowances{
 						Users: []*User{
-							{Login: String("uuu"), ID: Int64(10)},
+							{Login: Ptr("uuu"), ID: Ptr(int64(10))},
 						},
 						Teams: []*Team{
-							{Slug: String("ttt"), ID: Int64(20)},
+							{Slug: Ptr("ttt"), ID: Ptr(int64(20))},
 						},
 						Apps: []*App{
-							{Slug: String("aaa"), ID: Int64(30)},
+							{Slug: Ptr("aaa"), ID: Ptr(int64(30))},
 						},
 					},
 				},
 				Restrictions: &BranchRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 				BlockCreations: &BlockCreations{
-					Enabled: Bool(true),
+					Enabled: Ptr(true),
 				},
 				LockBranch: &LockBranch{
-					Enabled: Bool(true),
+					Enabled: Ptr(true),
 				},
 				AllowForkSyncing: &AllowForkSyncing{
-					Enabled: Bool(true),
+					Enabled: Ptr(true),
 				},
 			}
 			if !cmp.Equal(protection, want) {
@@ -1896,37 +1896,37 @@ func TestRepositoriesService_UpdateBranchProtection_Checks(t *testing.T) {
 					DismissStaleReviews: true,
 					DismissalRestrictions: &DismissalRestrictions{
 						Users: []*User{
-							{Login: String("uu"), ID: Int64(3)},
+							{Login: Ptr("uu"), ID: Ptr(int64(3))},
 						},
 						Teams: []*Team{
-							{Slug: String("tt"), ID: Int64(4)},
+							{Slug: Ptr("tt"), ID: Ptr(int64(4))},
 						},
 						Apps: []*App{
-							{Slug: String("aa"), ID: Int64(5)},
+							{Slug: Ptr("aa"), ID: Ptr(int64(5))},
 						},
 					},
 					RequireCodeOwnerReviews: true,
 					BypassPullRequestAllowances: &BypassPullRequestAllowances{
 						Users: []*User{
-							{Login: String("uuu"), ID: Int64(10)},
+							{Login: Ptr("uuu"), ID: Ptr(int64(10))},
 						},
 						Teams: []*Team{
-							{Slug: String("ttt"), ID: Int64(20)},
+							{Slug: Ptr("ttt"), ID: Ptr(int64(20))},
 						},
 						Apps: []*App{
-							{Slug: String("aaa"), ID: Int64(30)},
+							{Slug: Ptr("aaa"), ID: Ptr(int64(30))},
 						},
 					},
 				},
 				Restrictions: &BranchRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 			}
@@ -2039,37 +2039,37 @@ func TestRepositoriesService_UpdateBranchProtection_EmptyChecks(t *testing.T) {
 					DismissStaleReviews: true,
 					DismissalRestrictions: &DismissalRestrictions{
 						Users: []*User{
-							{Login: String("uu"), ID: Int64(3)},
+							{Login: Ptr("uu"), ID: Ptr(int64(3))},
 						},
 						Teams: []*Team{
-							{Slug: String("tt"), ID: Int64(4)},
+							{Slug: Ptr("tt"), ID: Ptr(int64(4))},
 						},
 						Apps: []*App{
-							{Slug: String("aa"), ID: Int64(5)},
+							{Slug: Ptr("aa"), ID: Ptr(int64(5))},
 						},
 					},
 					RequireCodeOwnerReviews: true,
 					BypassPullRequestAllowances: &BypassPullRequestAllowances{
 						Users: []*User{
-							{Login: String("uuu"), ID: Int64(10)},
+							{Login: Ptr("uuu"), ID: Ptr(int64(10))},
 						},
 						Teams: []*Team{
-							{Slug: String("ttt"), ID: Int64(20)},
+							{Slug: Ptr("ttt"), ID: Ptr(int64(20))},
 						},
 						Apps: []*App{
-							{Slug: String("aaa"), ID: Int64(30)},
+							{Slug: Ptr("aaa"), ID: Ptr(int64(30))},
 						},
 					},
 				},
 				Restrictions: &BranchRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 			}
@@ -2181,37 +2181,37 @@ func TestRepositoriesService_UpdateBranchProtection_StrictNoChecks(t *testing.T)
 					DismissStaleReviews: true,
 					DismissalRestrictions: &DismissalRestrictions{
 						Users: []*User{
-							{Login: String("uu"), ID: Int64(3)},
+							{Login: Ptr("uu"), ID: Ptr(int64(3))},
 						},
 						Teams: []*Team{
-							{Slug: String("tt"), ID: Int64(4)},
+							{Slug: Ptr("tt"), ID: Ptr(int64(4))},
 						},
 						Apps: []*App{
-							{Slug: String("aa"), ID: Int64(5)},
+							{Slug: Ptr("aa"), ID: Ptr(int64(5))},
 						},
 					},
 					RequireCodeOwnerReviews: true,
 					BypassPullRequestAllowances: &BypassPullRequestAllowances{
 						Users: []*User{
-							{Login: String("uuu"), ID: Int64(10)},
+							{Login: Ptr("uuu"), ID: Ptr(int64(10))},
 						},
 						Teams: []*Team{
-							{Slug: String("ttt"), ID: Int64(20)},
+							{Slug: Ptr("ttt"), ID: Ptr(int64(20))},
 						},
 						Apps: []*App{
-							{Slug: String("aaa"), ID: Int64(30)},
+							{Slug: Ptr("aaa"), ID: Ptr(int64(30))},
 						},
 					},
 				},
 				Restrictions: &BranchRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 			}
@@ -2238,7 +2238,7 @@ func TestRepositoriesService_UpdateBranchProtection_RequireLastPushApproval(t *t
 
 			input := &ProtectionRequest{
 				RequiredPullRequestReviews: &PullRequestReviewsEnforcementRequest{
-					RequireLastPushApproval: Bool(true),
+					RequireLastPushApproval: Ptr(true),
 				},
 			}
 
@@ -2339,14 +2339,14 @@ func TestRepositoriesService_License(t *testing.T) {
 	}
 
 	want := &RepositoryLicense{
-		Name: String("LICENSE"),
-		Path: String("LICENSE"),
+		Name: Ptr("LICENSE"),
+		Path: Ptr("LICENSE"),
 		License: &License{
-			Name:     String("MIT License"),
-			Key:      String("mit"),
-			SPDXID:   String("MIT"),
-			URL:      String("https://api.github.com/licenses/mit"),
-			Featured: Bool(true),
+			Name:     Ptr("MIT License"),
+			Key:      Ptr("mit"),
+			SPDXID:   Ptr("MIT"),
+			URL:      Ptr("https://api.github.com/licenses/mit"),
+			Featured: Ptr(true),
 		},
 	}
 
@@ -2500,7 +2500,7 @@ func TestRepositoriesService_UpdateRequiredStatusChecks_Contexts(t *testing.T) {
 			defer teardown()
 
 			input := &RequiredStatusChecksRequest{
-				Strict:   Bool(true),
+				Strict:   Ptr(true),
 				Contexts: []string{"continuous-integration"},
 			}
 
@@ -2578,7 +2578,7 @@ func TestRepositoriesService_UpdateRequiredStatusChecks_Checks(t *testing.T) {
 			appID := int64(123)
 			noAppID := int64(-1)
 			input := &RequiredStatusChecksRequest{
-				Strict: Bool(true),
+				Strict: Ptr(true),
 				Checks: []*RequiredStatusCheck{
 					{
 						Context: "continuous-integration",
@@ -2816,13 +2816,13 @@ func TestRepositoriesService_GetPullRequestReviewEnforcement(t *testing.T) {
 				DismissStaleReviews: true,
 				DismissalRestrictions: &DismissalRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 				RequireCodeOwnerReviews:      true,
@@ -2904,13 +2904,13 @@ func TestRepositoriesService_UpdatePullRequestReviewEnforcement(t *testing.T) {
 				DismissStaleReviews: true,
 				DismissalRestrictions: &DismissalRestrictions{
 					Users: []*User{
-						{Login: String("u"), ID: Int64(1)},
+						{Login: Ptr("u"), ID: Ptr(int64(1))},
 					},
 					Teams: []*Team{
-						{Slug: String("t"), ID: Int64(2)},
+						{Slug: Ptr("t"), ID: Ptr(int64(2))},
 					},
 					Apps: []*App{
-						{Slug: String("a"), ID: Int64(3)},
+						{Slug: Ptr("a"), ID: Ptr(int64(3))},
 					},
 				},
 				RequireCodeOwnerReviews:      true,
@@ -3056,7 +3056,7 @@ func TestRepositoriesService_GetAdminEnforcement(t *testing.T) {
 			}
 
 			want := &AdminEnforcement{
-				URL:     String("/repos/o/r/branches/b/protection/enforce_admins"),
+				URL:     Ptr("/repos/o/r/branches/b/protection/enforce_admins"),
 				Enabled: true,
 			}
 
@@ -3107,7 +3107,7 @@ func TestRepositoriesService_AddAdminEnforcement(t *testing.T) {
 			}
 
 			want := &AdminEnforcement{
-				URL:     String("/repos/o/r/branches/b/protection/enforce_admins"),
+				URL:     Ptr("/repos/o/r/branches/b/protection/enforce_admins"),
 				Enabled: true,
 			}
 			if !cmp.Equal(enforcement, want) {
@@ -3196,8 +3196,8 @@ func TestRepositoriesService_GetSignaturesProtectedBranch(t *testing.T) {
 			}
 
 			want := &SignaturesProtectedBranch{
-				URL:     String("/repos/o/r/branches/b/protection/required_signatures"),
-				Enabled: Bool(false),
+				URL:     Ptr("/repos/o/r/branches/b/protection/required_signatures"),
+				Enabled: Ptr(false),
 			}
 
 			if !cmp.Equal(signature, want) {
@@ -3248,8 +3248,8 @@ func TestRepositoriesService_RequireSignaturesOnProtectedBranch(t *testing.T) {
 			}
 
 			want := &SignaturesProtectedBranch{
-				URL:     String("/repos/o/r/branches/b/protection/required_signatures"),
-				Enabled: Bool(true),
+				URL:     Ptr("/repos/o/r/branches/b/protection/required_signatures"),
+				Enabled: Ptr(true),
 			}
 
 			if !cmp.Equal(signature, want) {
@@ -3345,7 +3345,7 @@ func TestPullRequestReviewsEnforcementRequest_MarshalJSON_nilDismissalRestirctio
 			Teams: &[]string{},
 			Apps:  &[]string{},
 		},
-		RequireLastPushApproval: Bool(true),
+		RequireLastPushApproval: Ptr(true),
 	}
 
 	got, err = json.Marshal(req)
@@ -3567,7 +3567,7 @@ func TestRepositoriesService_ReplaceAppRestrictions(t *testing.T) {
 				t.Errorf("Repositories.ReplaceAppRestrictions returned error: %v", err)
 			}
 			want := []*App{
-				{Name: String("octocat")},
+				{Name: Ptr("octocat")},
 			}
 			if !cmp.Equal(got, want) {
 				t.Errorf("Repositories.ReplaceAppRestrictions returned %+v, want %+v", got, want)
@@ -3617,7 +3617,7 @@ func TestRepositoriesService_AddAppRestrictions(t *testing.T) {
 				t.Errorf("Repositories.AddAppRestrictions returned error: %v", err)
 			}
 			want := []*App{
-				{Name: String("octocat")},
+				{Name: Ptr("octocat")},
 			}
 			if !cmp.Equal(got, want) {
 				t.Errorf("Repositories.AddAppRestrictions returned %+v, want %+v", got, want)
@@ -3754,7 +3754,7 @@ func TestRepositoriesService_ReplaceTeamRestrictions(t *testing.T) {
 				t.Errorf("Repositories.ReplaceTeamRestrictions returned error: %v", err)
 			}
 			want := []*Team{
-				{Name: String("octocat")},
+				{Name: Ptr("octocat")},
 			}
 			if !cmp.Equal(got, want) {
 				t.Errorf("Repositories.ReplaceTeamRestrictions returned %+v, want %+v", got, want)
@@ -3804,7 +3804,7 @@ func TestRepositoriesService_AddTeamRestrictions(t *testing.T) {
 				t.Errorf("Repositories.AddTeamRestrictions returned error: %v", err)
 			}
 			want := []*Team{
-				{Name: String("octocat")},
+				{Name: Ptr("octocat")},
 			}
 			if !cmp.Equal(got, want) {
 				t.Errorf("Repositories.AddTeamRestrictions returned %+v, want %+v", got, want)
@@ -3941,7 +3941,7 @@ func TestRepositoriesService_ReplaceUserRestrictions(t *testing.T) {
 				t.Errorf("Repositories.ReplaceUserRestrictions returned error: %v", err)
 			}
 			want := []*User{
-				{Name: String("octocat")},
+				{Name: Ptr("octocat")},
 			}
 			if !cmp.Equal(got, want) {
 				t.Errorf("Repositories.ReplaceUserRestrictions returned %+v, want %+v", got, want)
@@ -3991,7 +3991,7 @@ func TestRepositoriesService_AddUserRestrictions(t *testing.T) {
 				t.Errorf("Repositories.AddUserRestrictions returned error: %v", err)
 			}
 			want := []*User{
-				{Name: String("octocat")},
+				{Name: Ptr("octocat")},
 			}
 			if !cmp.Equal(got, want) {
 				t.Errorf("Repositories.AddUserRestrictions returned %+v, want %+v", got, want)
@@ -4064,7 +4064,7 @@ func TestRepositoriesService_Transfer(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := TransferRequest{NewOwner: "a", NewName: String("b"), TeamID: []int64{123}}
+	input := TransferRequest{NewOwner: "a", NewName: Ptr("b"), TeamID: []int64{123}}
 
 	mux.HandleFunc("/repos/o/r/transfer", func(w http.ResponseWriter, r *http.Request) {
 		var v TransferRequest
@@ -4084,7 +4084,7 @@ func TestRepositoriesService_Transfer(t *testing.T) {
 		t.Errorf("Repositories.Transfer returned error: %v", err)
 	}
 
-	want := &Repository{Owner: &User{Login: String("a")}}
+	want := &Repository{Owner: &User{Login: Ptr("a")}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Repositories.Transfer returned %+v, want %+v", got, want)
 	}
@@ -4161,7 +4161,7 @@ func TestRepositoriesService_Dispatch(t *testing.T) {
 			t.Errorf("Repositories.Dispatch returned error: %v", err)
 		}
 
-		want := &Repository{Owner: &User{Login: String("a")}}
+		want := &Repository{Owner: &User{Login: Ptr("a")}}
 		if !cmp.Equal(got, want) {
 			t.Errorf("Repositories.Dispatch returned %+v, want %+v", got, want)
 		}
@@ -4186,7 +4186,7 @@ func TestAdvancedSecurity_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AdvancedSecurity{}, "{}")
 
 	u := &AdvancedSecurity{
-		Status: String("status"),
+		Status: Ptr("status"),
 	}
 
 	want := `{
@@ -4200,7 +4200,7 @@ func TestAuthorizedActorsOnly_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AuthorizedActorsOnly{}, "{}")
 
 	u := &AuthorizedActorsOnly{
-		From: Bool(true),
+		From: Ptr(true),
 	}
 
 	want := `{
@@ -4234,7 +4234,7 @@ func TestTransferRequest_Marshal(t *testing.T) {
 
 	u := &TransferRequest{
 		NewOwner: "testOwner",
-		NewName:  String("testName"),
+		NewName:  Ptr("testName"),
 		TeamID:   []int64{1, 2},
 	}
 
@@ -4251,8 +4251,8 @@ func TestSignaturesProtectedBranch_Marshal(t *testing.T) {
 	testJSONMarshal(t, &SignaturesProtectedBranch{}, "{}")
 
 	u := &SignaturesProtectedBranch{
-		URL:     String("https://www.testURL.in"),
-		Enabled: Bool(false),
+		URL:     Ptr("https://www.testURL.in"),
+		Enabled: Ptr(false),
 	}
 
 	want := `{
@@ -4263,8 +4263,8 @@ func TestSignaturesProtectedBranch_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 
 	u2 := &SignaturesProtectedBranch{
-		URL:     String("testURL"),
-		Enabled: Bool(true),
+		URL:     Ptr("testURL"),
+		Enabled: Ptr(true),
 	}
 
 	want2 := `{
@@ -4297,7 +4297,7 @@ func TestAdminEnforcement_Marshal(t *testing.T) {
 	testJSONMarshal(t, &AdminEnforcement{}, "{}")
 
 	u := &AdminEnforcement{
-		URL:     String("https://www.test-url.in"),
+		URL:     Ptr("https://www.test-url.in"),
 		Enabled: false,
 	}
 
@@ -4318,8 +4318,8 @@ func TestPullRequestReviewsEnforcementUpdate_Marshal(t *testing.T) {
 			Teams: []string{"team1", "team2"},
 			Apps:  []string{"app1", "app2"},
 		},
-		DismissStaleReviews:          Bool(false),
-		RequireCodeOwnerReviews:      Bool(true),
+		DismissStaleReviews:          Ptr(false),
+		RequireCodeOwnerReviews:      Ptr(true),
 		RequiredApprovingReviewCount: 2,
 	}
 
@@ -4342,7 +4342,7 @@ func TestRequiredStatusCheck_Marshal(t *testing.T) {
 
 	u := &RequiredStatusCheck{
 		Context: "ctx",
-		AppID:   Int64(1),
+		AppID:   Ptr(int64(1)),
 	}
 
 	want := `{
@@ -4357,13 +4357,13 @@ func TestRepositoryTag_Marshal(t *testing.T) {
 	testJSONMarshal(t, &RepositoryTag{}, "{}")
 
 	u := &RepositoryTag{
-		Name: String("v0.1"),
+		Name: Ptr("v0.1"),
 		Commit: &Commit{
-			SHA: String("sha"),
-			URL: String("url"),
+			SHA: Ptr("sha"),
+			URL: Ptr("url"),
 		},
-		ZipballURL: String("zball"),
-		TarballURL: String("tball"),
+		ZipballURL: Ptr("zball"),
+		TarballURL: Ptr("tball"),
 	}
 
 	want := `{
@@ -0,0 +1,67 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "sync"
+
+// RetryBudget limits the retries made by all requests that share it, so that
+// during an outage clients do not multiply their load on GitHub by retrying
+// every request. Each retry spends one token, and each request sent earns
+// back Ratio tokens, up to Max. Retries are skipped while the budget has
+// less than one token.
+//
+// Set RetryPolicy.Budget to apply a RetryBudget to a client.
+type RetryBudget struct {
+	// Max is the largest number of tokens the budget holds, and the number
+	// it starts with.
+	Max float64
+
+	// Ratio is the number of tokens earned by each request sent. For
+	// example, 0.1 allows one retry for every ten requests once the initial
+	// tokens are spent.
+	Ratio float64
+
+	mu      sync.Mutex
+	started bool
+	tokens  float64
+}
+
+// NewRetryBudget returns a RetryBudget that holds up to max tokens and earns
+// ratio tokens for each request sent.
+func NewRetryBudget(max int, ratio float64) *RetryBudget {
+	return &RetryBudget{Max: float64(max), Ratio: ratio}
+}
+
+// fill must be called with b.mu held.
+func (b *RetryBudget) fill() {
+	if !b.started {
+		b.started = true
+		b.tokens = b.Max
+	}
+}
+
+// deposit records that a request was sent.
+func (b *RetryBudget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fill()
+	b.tokens += b.Ratio
+	if b.tokens > b.Max {
+		b.tokens = b.Max
+	}
+}
+
+// withdraw spends a token for a retry and reports whether there was one.
+func (b *RetryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
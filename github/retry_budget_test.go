@@ -0,0 +1,70 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryBudget(t *testing.T) {
+	b := NewRetryBudget(2, 0.5)
+	for i := 0; i < 2; i++ {
+		if !b.withdraw() {
+			t.Fatalf("withdraw %v returned false, want true", i)
+		}
+	}
+	if b.withdraw() {
+		t.Fatal("withdraw from empty budget returned true, want false")
+	}
+
+	b.deposit()
+	if b.withdraw() {
+		t.Error("withdraw after one deposit returned true, want false")
+	}
+	b.deposit()
+	b.deposit()
+	if !b.withdraw() {
+		t.Error("withdraw after two deposits returned false, want true")
+	}
+
+	for i := 0; i < 10; i++ {
+		b.deposit()
+	}
+	if b.tokens != b.Max {
+		t.Errorf("tokens = %v, want capped at %v", b.tokens, b.Max)
+	}
+}
+
+func TestWithRetryPolicy_budget(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client = client.WithRetryPolicy(RetryPolicy{
+		MaxRetries: 3,
+		Backoff:    func(int) time.Duration { return 0 },
+		Budget:     NewRetryBudget(2, 0),
+	})
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest("GET", ".", nil)
+		if _, err := client.Do(ctx, req, nil); err == nil {
+			t.Error("Do returned nil error, want error")
+		}
+	}
+	// The first request spends both tokens, so the second is not retried.
+	if want := 4; calls != want {
+		t.Errorf("server received %v requests, want %v", calls, want)
+	}
+}
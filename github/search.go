@@ -32,6 +32,13 @@ import (
 // For example, querying with "language:c++" and "leveldb", then query should be
 // "language:c++ leveldb" but not "language:c+++leveldb".
 //
+// The search API has its own rate limit, which is much lower than the core
+// one. The client tracks it separately; use Client.LastRateLimit(SearchCategory)
+// to inspect the remaining quota. To have search calls wait for the limit to
+// reset rather than fail with a *RateLimitError, pass a context created with
+//
+//	ctx = context.WithValue(ctx, github.SleepUntilPrimaryRateLimitResetWhenRateLimited, true)
+//
 // GitHub API docs: https://docs.github.com/rest/search/
 type SearchService service
 
@@ -99,7 +106,7 @@ type TopicResult struct {
 	Description      *string    `json:"description,omitempty"`
 	CreatedBy        *string    `json:"created_by,omitempty"`
 	CreatedAt        *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt        *string    `json:"updated_at,omitempty"`
+	UpdatedAt        *Timestamp `json:"updated_at,omitempty"`
 	Featured         *bool      `json:"featured,omitempty"`
 	Curated          *bool      `json:"curated,omitempty"`
 	Score            *float64   `json:"score,omitempty"`
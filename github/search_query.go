@@ -0,0 +1,148 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// searchDateLayout is the date format expected by date qualifiers.
+const searchDateLayout = "2006-01-02"
+
+// DateRange represents a range of dates used by search qualifiers such as
+// created, updated, and pushed. A zero From or To leaves that side of the
+// range open.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// String returns the range in the format used by search qualifiers, for
+// example "2024-01-01..2024-06-30", ">=2024-01-01", or "<=2024-06-30".
+// It returns the empty string if both ends of the range are zero.
+func (r DateRange) String() string {
+	switch {
+	case r.From.IsZero() && r.To.IsZero():
+		return ""
+	case r.To.IsZero():
+		return ">=" + r.From.Format(searchDateLayout)
+	case r.From.IsZero():
+		return "<=" + r.To.Format(searchDateLayout)
+	default:
+		return r.From.Format(searchDateLayout) + ".." + r.To.Format(searchDateLayout)
+	}
+}
+
+// SearchQuery builds the q parameter passed to the SearchService methods
+// from typed qualifiers, taking care of quoting values that contain
+// whitespace or quotes. Empty fields are omitted.
+//
+// For example:
+//
+//	q := SearchQuery{
+//		Terms:    []string{"http client"},
+//		Org:      "google",
+//		Language: "go",
+//		Stars:    ">100",
+//		Created:  &DateRange{From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+//	}
+//	result, _, err := client.Search.Repositories(ctx, q.String(), nil)
+//
+// GitHub API docs: https://docs.github.com/search-github/getting-started-with-searching-on-github/understanding-the-search-syntax
+type SearchQuery struct {
+	// Terms are the free text keywords to search for.
+	Terms []string
+
+	Repo     string // Repository in owner/name form.
+	Org      string
+	User     string
+	Language string
+
+	// In restricts which fields Terms are matched against, for example
+	// "name", "description", "readme", "title", "body", or "file".
+	In string
+
+	// Is and Is not qualifiers, for example "public", "open", or "pr".
+	Is    []string
+	IsNot []string
+
+	// Numeric qualifiers accept an exact value or a comparison or range,
+	// for example "100", ">100", "<=50", or "10..50".
+	Stars string
+	Forks string
+	Size  string
+
+	Created *DateRange
+	Updated *DateRange
+	Pushed  *DateRange
+
+	// Qualifiers holds any other qualifier, keyed by name (e.g. "topic",
+	// "label", "author"). They are emitted in sorted key order.
+	Qualifiers map[string]string
+}
+
+// String returns the search query string.
+func (q SearchQuery) String() string {
+	var parts []string
+	for _, t := range q.Terms {
+		if t != "" {
+			parts = append(parts, quoteSearchValue(t))
+		}
+	}
+
+	add := func(name, value string) {
+		if value != "" {
+			parts = append(parts, name+":"+quoteSearchValue(value))
+		}
+	}
+	addRange := func(name string, r *DateRange) {
+		if r != nil {
+			add(name, r.String())
+		}
+	}
+
+	add("repo", q.Repo)
+	add("org", q.Org)
+	add("user", q.User)
+	add("language", q.Language)
+	add("in", q.In)
+	for _, v := range q.Is {
+		add("is", v)
+	}
+	for _, v := range q.IsNot {
+		if v != "" {
+			parts = append(parts, "-is:"+quoteSearchValue(v))
+		}
+	}
+	add("stars", q.Stars)
+	add("forks", q.Forks)
+	add("size", q.Size)
+	addRange("created", q.Created)
+	addRange("updated", q.Updated)
+	addRange("pushed", q.Pushed)
+
+	keys := make([]string, 0, len(q.Qualifiers))
+	for k := range q.Qualifiers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		add(k, q.Qualifiers[k])
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// quoteSearchValue wraps v in double quotes if it contains whitespace or a
+// double quote, escaping any embedded double quotes.
+func quoteSearchValue(v string) string {
+	if !strings.ContainsAny(v, " \t\n\"") {
+		return v
+	}
+	return `"` + strings.ReplaceAll(v, `"`, `\"`) + `"`
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateRange_String(t *testing.T) {
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		r    DateRange
+		want string
+	}{
+		{DateRange{}, ""},
+		{DateRange{From: from}, ">=2024-01-01"},
+		{DateRange{To: to}, "<=2024-06-30"},
+		{DateRange{From: from, To: to}, "2024-01-01..2024-06-30"},
+	}
+
+	for i, tt := range tests {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("%v. DateRange.String() = %q, want %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestSearchQuery_String(t *testing.T) {
+	tests := []struct {
+		q    SearchQuery
+		want string
+	}{
+		{SearchQuery{}, ""},
+		{SearchQuery{Terms: []string{"leveldb"}, Language: "c++"}, "leveldb language:c++"},
+		{
+			SearchQuery{
+				Terms:    []string{"http client", "retry"},
+				Repo:     "google/go-github",
+				Language: "go",
+				Stars:    ">100",
+				Created:  &DateRange{From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			`"http client" retry repo:google/go-github language:go stars:>100 created:>=2024-01-01`,
+		},
+		{
+			SearchQuery{
+				Org:        "o",
+				Is:         []string{"pr", "open"},
+				IsNot:      []string{"draft"},
+				Qualifiers: map[string]string{"label": "good first issue", "author": "u"},
+			},
+			`org:o is:pr is:open -is:draft author:u label:"good first issue"`,
+		},
+		{SearchQuery{Terms: []string{`say "hi"`}}, `"say \"hi\""`},
+		{SearchQuery{Created: &DateRange{}, Terms: []string{""}}, ""},
+	}
+
+	for i, tt := range tests {
+		if got := tt.q.String(); got != tt.want {
+			t.Errorf("%v. SearchQuery.String() = %q, want %q", i, got, tt.want)
+		}
+	}
+}
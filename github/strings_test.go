@@ -44,11 +44,11 @@ func TestStringify(t *testing.T) {
 
 		// pointers
 		{nilPointer, `<nil>`},
-		{String("foo"), `"foo"`},
-		{Int(123), `123`},
-		{Bool(false), `false`},
+		{Ptr("foo"), `"foo"`},
+		{Ptr(123), `123`},
+		{Ptr(false), `false`},
 		{
-			[]*string{String("a"), String("b")},
+			[]*string{Ptr("a"), Ptr("b")},
 			`["a" "b"]`,
 		},
 
@@ -62,11 +62,11 @@ func TestStringify(t *testing.T) {
 			`github.Timestamp{2006-01-02 15:04:05 +0000 UTC}`,
 		},
 		{
-			User{ID: Int64(123), Name: String("n")},
+			User{ID: Ptr(int64(123)), Name: Ptr("n")},
 			`github.User{ID:123, Name:"n"}`,
 		},
 		{
-			Repository{Owner: &User{ID: Int64(123)}},
+			Repository{Owner: &User{ID: Ptr(int64(123))}},
 			`github.Repository{Owner:github.User{ID:123}}`,
 		},
 	}
@@ -89,47 +89,47 @@ func TestString(t *testing.T) {
 		in  interface{}
 		out string
 	}{
-		{CodeResult{Name: String("n")}, `github.CodeResult{Name:"n"}`},
-		{CommitAuthor{Name: String("n")}, `github.CommitAuthor{Name:"n"}`},
-		{CommitFile{SHA: String("s")}, `github.CommitFile{SHA:"s"}`},
-		{CommitStats{Total: Int(1)}, `github.CommitStats{Total:1}`},
-		{CommitsComparison{TotalCommits: Int(1)}, `github.CommitsComparison{TotalCommits:1}`},
-		{Commit{SHA: String("s")}, `github.Commit{SHA:"s"}`},
-		{Event{ID: String("1")}, `github.Event{ID:"1"}`},
-		{GistComment{ID: Int64(1)}, `github.GistComment{ID:1}`},
-		{GistFile{Size: Int(1)}, `github.GistFile{Size:1}`},
-		{Gist{ID: String("1")}, `github.Gist{ID:"1"}`},
-		{GitObject{SHA: String("s")}, `github.GitObject{SHA:"s"}`},
-		{Gitignore{Name: String("n")}, `github.Gitignore{Name:"n"}`},
-		{Hook{ID: Int64(1)}, `github.Hook{ID:1}`},
-		{IssueComment{ID: Int64(1)}, `github.IssueComment{ID:1}`},
-		{Issue{Number: Int(1)}, `github.Issue{Number:1}`},
-		{Key{ID: Int64(1)}, `github.Key{ID:1}`},
-		{Label{ID: Int64(1), Name: String("l")}, `github.Label{ID:1, Name:"l"}`},
-		{Organization{ID: Int64(1)}, `github.Organization{ID:1}`},
-		{PullRequestComment{ID: Int64(1)}, `github.PullRequestComment{ID:1}`},
-		{PullRequest{Number: Int(1)}, `github.PullRequest{Number:1}`},
-		{PullRequestReview{ID: Int64(1)}, `github.PullRequestReview{ID:1}`},
-		{DraftReviewComment{Position: Int(1)}, `github.DraftReviewComment{Position:1}`},
-		{PullRequestReviewRequest{Body: String("r")}, `github.PullRequestReviewRequest{Body:"r"}`},
-		{PullRequestReviewDismissalRequest{Message: String("r")}, `github.PullRequestReviewDismissalRequest{Message:"r"}`},
-		{HeadCommit{SHA: String("s")}, `github.HeadCommit{SHA:"s"}`},
-		{PushEvent{PushID: Int64(1)}, `github.PushEvent{PushID:1}`},
-		{Reference{Ref: String("r")}, `github.Reference{Ref:"r"}`},
-		{ReleaseAsset{ID: Int64(1)}, `github.ReleaseAsset{ID:1}`},
-		{RepoStatus{ID: Int64(1)}, `github.RepoStatus{ID:1}`},
-		{RepositoryComment{ID: Int64(1)}, `github.RepositoryComment{ID:1}`},
-		{RepositoryCommit{SHA: String("s")}, `github.RepositoryCommit{SHA:"s"}`},
-		{RepositoryContent{Name: String("n")}, `github.RepositoryContent{Name:"n"}`},
-		{RepositoryRelease{ID: Int64(1)}, `github.RepositoryRelease{ID:1}`},
-		{Repository{ID: Int64(1)}, `github.Repository{ID:1}`},
-		{Team{ID: Int64(1)}, `github.Team{ID:1}`},
-		{TreeEntry{SHA: String("s")}, `github.TreeEntry{SHA:"s"}`},
-		{Tree{SHA: String("s")}, `github.Tree{SHA:"s"}`},
-		{User{ID: Int64(1)}, `github.User{ID:1}`},
-		{WebHookAuthor{Name: String("n")}, `github.CommitAuthor{Name:"n"}`},
-		{WebHookCommit{ID: String("1")}, `github.HeadCommit{ID:"1"}`},
-		{WebHookPayload{Ref: String("r")}, `github.PushEvent{Ref:"r"}`},
+		{CodeResult{Name: Ptr("n")}, `github.CodeResult{Name:"n"}`},
+		{CommitAuthor{Name: Ptr("n")}, `github.CommitAuthor{Name:"n"}`},
+		{CommitFile{SHA: Ptr("s")}, `github.CommitFile{SHA:"s"}`},
+		{CommitStats{Total: Ptr(1)}, `github.CommitStats{Total:1}`},
+		{CommitsComparison{TotalCommits: Ptr(1)}, `github.CommitsComparison{TotalCommits:1}`},
+		{Commit{SHA: Ptr("s")}, `github.Commit{SHA:"s"}`},
+		{Event{ID: Ptr("1")}, `github.Event{ID:"1"}`},
+		{GistComment{ID: Ptr(int64(1))}, `github.GistComment{ID:1}`},
+		{GistFile{Size: Ptr(1)}, `github.GistFile{Size:1}`},
+		{Gist{ID: Ptr("1")}, `github.Gist{ID:"1"}`},
+		{GitObject{SHA: Ptr("s")}, `github.GitObject{SHA:"s"}`},
+		{Gitignore{Name: Ptr("n")}, `github.Gitignore{Name:"n"}`},
+		{Hook{ID: Ptr(int64(1))}, `github.Hook{ID:1}`},
+		{IssueComment{ID: Ptr(int64(1))}, `github.IssueComment{ID:1}`},
+		{Issue{Number: Ptr(1)}, `github.Issue{Number:1}`},
+		{Key{ID: Ptr(int64(1))}, `github.Key{ID:1}`},
+		{Label{ID: Ptr(int64(1)), Name: Ptr("l")}, `github.Label{ID:1, Name:"l"}`},
+		{Organization{ID: Ptr(int64(1))}, `github.Organization{ID:1}`},
+		{PullRequestComment{ID: Ptr(int64(1))}, `github.PullRequestComment{ID:1}`},
+		{PullRequest{Number: Ptr(1)}, `github.PullRequest{Number:1}`},
+		{PullRequestReview{ID: Ptr(int64(1))}, `github.PullRequestReview{ID:1}`},
+		{DraftReviewComment{Position: Ptr(1)}, `github.DraftReviewComment{Position:1}`},
+		{PullRequestReviewRequest{Body: Ptr("r")}, `github.PullRequestReviewRequest{Body:"r"}`},
+		{PullRequestReviewDismissalRequest{Message: Ptr("r")}, `github.PullRequestReviewDismissalRequest{Message:"r"}`},
+		{HeadCommit{SHA: Ptr("s")}, `github.HeadCommit{SHA:"s"}`},
+		{PushEvent{PushID: Ptr(int64(1))}, `github.PushEvent{PushID:1}`},
+		{Reference{Ref: Ptr("r")}, `github.Reference{Ref:"r"}`},
+		{ReleaseAsset{ID: Ptr(int64(1))}, `github.ReleaseAsset{ID:1}`},
+		{RepoStatus{ID: Ptr(int64(1))}, `github.RepoStatus{ID:1}`},
+		{RepositoryComment{ID: Ptr(int64(1))}, `github.RepositoryComment{ID:1}`},
+		{RepositoryCommit{SHA: Ptr("s")}, `github.RepositoryCommit{SHA:"s"}`},
+		{RepositoryContent{Name: Ptr("n")}, `github.RepositoryContent{Name:"n"}`},
+		{RepositoryRelease{ID: Ptr(int64(1))}, `github.RepositoryRelease{ID:1}`},
+		{Repository{ID: Ptr(int64(1))}, `github.Repository{ID:1}`},
+		{Team{ID: Ptr(int64(1))}, `github.Team{ID:1}`},
+		{TreeEntry{SHA: Ptr("s")}, `github.TreeEntry{SHA:"s"}`},
+		{Tree{SHA: Ptr("s")}, `github.Tree{SHA:"s"}`},
+		{User{ID: Ptr(int64(1))}, `github.User{ID:1}`},
+		{WebHookAuthor{Name: Ptr("n")}, `github.CommitAuthor{Name:"n"}`},
+		{WebHookCommit{ID: Ptr("1")}, `github.HeadCommit{ID:"1"}`},
+		{WebHookPayload{Ref: Ptr("r")}, `github.PushEvent{Ref:"r"}`},
 	}
 
 	for i, tt := range tests {
@@ -492,6 +492,7 @@ type TeamAddTeamRepoOptions struct {
 	//     maintain - team members can manage the repository without access to sensitive or destructive actions.
 	//     triage - team members can proactively manage issues and pull requests without write access.
 	//
+	// The name of a custom repository role defined by the organization can also be used.
 	// If not specified, the team's permission attribute will be used.
 	Permission string `json:"permission,omitempty"`
 }
@@ -35,7 +35,7 @@ func TestTeamsService_ListTeams(t *testing.T) {
 		t.Errorf("Teams.ListTeams returned error: %v", err)
 	}
 
-	want := []*Team{{ID: Int64(1)}}
+	want := []*Team{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(teams, want) {
 		t.Errorf("Teams.ListTeams returned %+v, want %+v", teams, want)
 	}
@@ -79,7 +79,7 @@ func TestTeamsService_GetTeamByID(t *testing.T) {
 		t.Errorf("Teams.GetTeamByID returned error: %v", err)
 	}
 
-	want := &Team{ID: Int64(1), Name: String("n"), Description: String("d"), URL: String("u"), Slug: String("s"), Permission: String("p"), LDAPDN: String("cn=n,ou=groups,dc=example,dc=com")}
+	want := &Team{ID: Ptr(int64(1)), Name: Ptr("n"), Description: Ptr("d"), URL: Ptr("u"), Slug: Ptr("s"), Permission: Ptr("p"), LDAPDN: Ptr("cn=n,ou=groups,dc=example,dc=com")}
 	if !cmp.Equal(team, want) {
 		t.Errorf("Teams.GetTeamByID returned %+v, want %+v", team, want)
 	}
@@ -136,7 +136,7 @@ func TestTeamsService_GetTeamBySlug(t *testing.T) {
 		t.Errorf("Teams.GetTeamBySlug returned error: %v", err)
 	}
 
-	want := &Team{ID: Int64(1), Name: String("n"), Description: String("d"), URL: String("u"), Slug: String("s"), Permission: String("p"), LDAPDN: String("cn=n,ou=groups,dc=example,dc=com")}
+	want := &Team{ID: Ptr(int64(1)), Name: Ptr("n"), Description: Ptr("d"), URL: Ptr("u"), Slug: Ptr("s"), Permission: Ptr("p"), LDAPDN: Ptr("cn=n,ou=groups,dc=example,dc=com")}
 	if !cmp.Equal(team, want) {
 		t.Errorf("Teams.GetTeamBySlug returned %+v, want %+v", team, want)
 	}
@@ -191,7 +191,7 @@ func TestTeamsService_CreateTeam(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := NewTeam{Name: "n", Privacy: String("closed"), RepoNames: []string{"r"}}
+	input := NewTeam{Name: "n", Privacy: Ptr("closed"), RepoNames: []string{"r"}}
 
 	mux.HandleFunc("/orgs/o/teams", func(w http.ResponseWriter, r *http.Request) {
 		v := new(NewTeam)
@@ -211,7 +211,7 @@ func TestTeamsService_CreateTeam(t *testing.T) {
 		t.Errorf("Teams.CreateTeam returned error: %v", err)
 	}
 
-	want := &Team{ID: Int64(1)}
+	want := &Team{ID: Ptr(int64(1))}
 	if !cmp.Equal(team, want) {
 		t.Errorf("Teams.CreateTeam returned %+v, want %+v", team, want)
 	}
@@ -244,7 +244,7 @@ func TestTeamsService_EditTeamByID(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := NewTeam{Name: "n", Privacy: String("closed")}
+	input := NewTeam{Name: "n", Privacy: Ptr("closed")}
 
 	mux.HandleFunc("/organizations/1/team/1", func(w http.ResponseWriter, r *http.Request) {
 		v := new(NewTeam)
@@ -264,7 +264,7 @@ func TestTeamsService_EditTeamByID(t *testing.T) {
 		t.Errorf("Teams.EditTeamByID returned error: %v", err)
 	}
 
-	want := &Team{ID: Int64(1)}
+	want := &Team{ID: Ptr(int64(1))}
 	if !cmp.Equal(team, want) {
 		t.Errorf("Teams.EditTeamByID returned %+v, want %+v", team, want)
 	}
@@ -288,7 +288,7 @@ func TestTeamsService_EditTeamByID_RemoveParent(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := NewTeam{Name: "n", Privacy: String("closed")}
+	input := NewTeam{Name: "n", Privacy: Ptr("closed")}
 	var body string
 
 	mux.HandleFunc("/organizations/1/team/1", func(w http.ResponseWriter, r *http.Request) {
@@ -314,7 +314,7 @@ func TestTeamsService_EditTeamByID_RemoveParent(t *testing.T) {
 		t.Errorf("Teams.EditTeamByID returned error: %v", err)
 	}
 
-	want := &Team{ID: Int64(1)}
+	want := &Team{ID: Ptr(int64(1))}
 	if !cmp.Equal(team, want) {
 		t.Errorf("Teams.EditTeamByID returned %+v, want %+v", team, want)
 	}
@@ -328,7 +328,7 @@ func TestTeamsService_EditTeamBySlug(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := NewTeam{Name: "n", Privacy: String("closed")}
+	input := NewTeam{Name: "n", Privacy: Ptr("closed")}
 
 	mux.HandleFunc("/orgs/o/teams/s", func(w http.ResponseWriter, r *http.Request) {
 		v := new(NewTeam)
@@ -348,7 +348,7 @@ func TestTeamsService_EditTeamBySlug(t *testing.T) {
 		t.Errorf("Teams.EditTeamBySlug returned error: %v", err)
 	}
 
-	want := &Team{ID: Int64(1)}
+	want := &Team{ID: Ptr(int64(1))}
 	if !cmp.Equal(team, want) {
 		t.Errorf("Teams.EditTeamBySlug returned %+v, want %+v", team, want)
 	}
@@ -372,7 +372,7 @@ func TestTeamsService_EditTeamBySlug_RemoveParent(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := NewTeam{Name: "n", Privacy: String("closed")}
+	input := NewTeam{Name: "n", Privacy: Ptr("closed")}
 	var body string
 
 	mux.HandleFunc("/orgs/o/teams/s", func(w http.ResponseWriter, r *http.Request) {
@@ -398,7 +398,7 @@ func TestTeamsService_EditTeamBySlug_RemoveParent(t *testing.T) {
 		t.Errorf("Teams.EditTeam returned error: %v", err)
 	}
 
-	want := &Team{ID: Int64(1)}
+	want := &Team{ID: Ptr(int64(1))}
 	if !cmp.Equal(team, want) {
 		t.Errorf("Teams.EditTeam returned %+v, want %+v", team, want)
 	}
@@ -475,7 +475,7 @@ func TestTeamsService_ListChildTeamsByParentID(t *testing.T) {
 		t.Errorf("Teams.ListChildTeamsByParentID returned error: %v", err)
 	}
 
-	want := []*Team{{ID: Int64(2)}}
+	want := []*Team{{ID: Ptr(int64(2))}}
 	if !cmp.Equal(teams, want) {
 		t.Errorf("Teams.ListChildTeamsByParentID returned %+v, want %+v", teams, want)
 	}
@@ -512,7 +512,7 @@ func TestTeamsService_ListChildTeamsByParentSlug(t *testing.T) {
 		t.Errorf("Teams.ListChildTeamsByParentSlug returned error: %v", err)
 	}
 
-	want := []*Team{{ID: Int64(2)}}
+	want := []*Team{{ID: Ptr(int64(2))}}
 	if !cmp.Equal(teams, want) {
 		t.Errorf("Teams.ListChildTeamsByParentSlug returned %+v, want %+v", teams, want)
 	}
@@ -551,7 +551,7 @@ func TestTeamsService_ListTeamReposByID(t *testing.T) {
 		t.Errorf("Teams.ListTeamReposByID returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(members, want) {
 		t.Errorf("Teams.ListTeamReposByID returned %+v, want %+v", members, want)
 	}
@@ -590,7 +590,7 @@ func TestTeamsService_ListTeamReposBySlug(t *testing.T) {
 		t.Errorf("Teams.ListTeamReposBySlug returned error: %v", err)
 	}
 
-	want := []*Repository{{ID: Int64(1)}}
+	want := []*Repository{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(members, want) {
 		t.Errorf("Teams.ListTeamReposBySlug returned %+v, want %+v", members, want)
 	}
@@ -627,7 +627,7 @@ func TestTeamsService_IsTeamRepoByID_true(t *testing.T) {
 		t.Errorf("Teams.IsTeamRepoByID returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1)}
+	want := &Repository{ID: Ptr(int64(1))}
 	if !cmp.Equal(repo, want) {
 		t.Errorf("Teams.IsTeamRepoByID returned %+v, want %+v", repo, want)
 	}
@@ -664,7 +664,7 @@ func TestTeamsService_IsTeamRepoBySlug_true(t *testing.T) {
 		t.Errorf("Teams.IsTeamRepoBySlug returned error: %v", err)
 	}
 
-	want := &Repository{ID: Int64(1)}
+	want := &Repository{ID: Ptr(int64(1))}
 	if !cmp.Equal(repo, want) {
 		t.Errorf("Teams.IsTeamRepoBySlug returned %+v, want %+v", repo, want)
 	}
@@ -997,7 +997,7 @@ func TestTeamsService_ListUserTeams(t *testing.T) {
 		t.Errorf("Teams.ListUserTeams returned error: %v", err)
 	}
 
-	want := []*Team{{ID: Int64(1)}}
+	want := []*Team{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(teams, want) {
 		t.Errorf("Teams.ListUserTeams returned %+v, want %+v", teams, want)
 	}
@@ -1029,7 +1029,7 @@ func TestTeamsService_ListProjectsByID(t *testing.T) {
 		t.Errorf("Teams.ListTeamProjectsByID returned error: %v", err)
 	}
 
-	want := []*Project{{ID: Int64(1)}}
+	want := []*Project{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(projects, want) {
 		t.Errorf("Teams.ListTeamProjectsByID returned %+v, want %+v", projects, want)
 	}
@@ -1066,7 +1066,7 @@ func TestTeamsService_ListProjectsBySlug(t *testing.T) {
 		t.Errorf("Teams.ListTeamProjectsBySlug returned error: %v", err)
 	}
 
-	want := []*Project{{ID: Int64(1)}}
+	want := []*Project{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(projects, want) {
 		t.Errorf("Teams.ListTeamProjectsBySlug returned %+v, want %+v", projects, want)
 	}
@@ -1103,7 +1103,7 @@ func TestTeamsService_ReviewProjectsByID(t *testing.T) {
 		t.Errorf("Teams.ReviewTeamProjectsByID returned error: %v", err)
 	}
 
-	want := &Project{ID: Int64(1)}
+	want := &Project{ID: Ptr(int64(1))}
 	if !cmp.Equal(project, want) {
 		t.Errorf("Teams.ReviewTeamProjectsByID returned %+v, want %+v", project, want)
 	}
@@ -1140,7 +1140,7 @@ func TestTeamsService_ReviewProjectsBySlug(t *testing.T) {
 		t.Errorf("Teams.ReviewTeamProjectsBySlug returned error: %v", err)
 	}
 
-	want := &Project{ID: Int64(1)}
+	want := &Project{ID: Ptr(int64(1))}
 	if !cmp.Equal(project, want) {
 		t.Errorf("Teams.ReviewTeamProjectsBySlug returned %+v, want %+v", project, want)
 	}
@@ -1165,7 +1165,7 @@ func TestTeamsService_AddTeamProjectByID(t *testing.T) {
 	defer teardown()
 
 	opt := &TeamProjectOptions{
-		Permission: String("admin"),
+		Permission: Ptr("admin"),
 	}
 
 	wantAcceptHeaders := []string{mediaTypeProjectsPreview}
@@ -1204,7 +1204,7 @@ func TestTeamsService_AddTeamProjectBySlug(t *testing.T) {
 	defer teardown()
 
 	opt := &TeamProjectOptions{
-		Permission: String("admin"),
+		Permission: Ptr("admin"),
 	}
 
 	wantAcceptHeaders := []string{mediaTypeProjectsPreview}
@@ -1316,9 +1316,9 @@ func TestTeamsService_ListIDPGroupsInOrganization(t *testing.T) {
 	want := &IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("1"),
-				GroupName:        String("n"),
-				GroupDescription: String("d"),
+				GroupID:          Ptr("1"),
+				GroupName:        Ptr("n"),
+				GroupDescription: Ptr("d"),
 			},
 		},
 	}
@@ -1359,9 +1359,9 @@ func TestTeamsService_ListIDPGroupsForTeamByID(t *testing.T) {
 	want := &IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("1"),
-				GroupName:        String("n"),
-				GroupDescription: String("d"),
+				GroupID:          Ptr("1"),
+				GroupName:        Ptr("n"),
+				GroupDescription: Ptr("d"),
 			},
 		},
 	}
@@ -1402,9 +1402,9 @@ func TestTeamsService_ListIDPGroupsForTeamBySlug(t *testing.T) {
 	want := &IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("1"),
-				GroupName:        String("n"),
-				GroupDescription: String("d"),
+				GroupID:          Ptr("1"),
+				GroupName:        Ptr("n"),
+				GroupDescription: Ptr("d"),
 			},
 		},
 	}
@@ -1439,9 +1439,9 @@ func TestTeamsService_CreateOrUpdateIDPGroupConnectionsByID(t *testing.T) {
 	input := IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("1"),
-				GroupName:        String("n"),
-				GroupDescription: String("d"),
+				GroupID:          Ptr("1"),
+				GroupName:        Ptr("n"),
+				GroupDescription: Ptr("d"),
 			},
 		},
 	}
@@ -1455,9 +1455,9 @@ func TestTeamsService_CreateOrUpdateIDPGroupConnectionsByID(t *testing.T) {
 	want := &IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("1"),
-				GroupName:        String("n"),
-				GroupDescription: String("d"),
+				GroupID:          Ptr("1"),
+				GroupName:        Ptr("n"),
+				GroupDescription: Ptr("d"),
 			},
 		},
 	}
@@ -1492,9 +1492,9 @@ func TestTeamsService_CreateOrUpdateIDPGroupConnectionsBySlug(t *testing.T) {
 	input := IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("1"),
-				GroupName:        String("n"),
-				GroupDescription: String("d"),
+				GroupID:          Ptr("1"),
+				GroupName:        Ptr("n"),
+				GroupDescription: Ptr("d"),
 			},
 		},
 	}
@@ -1508,9 +1508,9 @@ func TestTeamsService_CreateOrUpdateIDPGroupConnectionsBySlug(t *testing.T) {
 	want := &IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("1"),
-				GroupName:        String("n"),
-				GroupDescription: String("d"),
+				GroupID:          Ptr("1"),
+				GroupName:        Ptr("n"),
+				GroupDescription: Ptr("d"),
 			},
 		},
 	}
@@ -1591,14 +1591,14 @@ func TestNewTeam_Marshal(t *testing.T) {
 
 	u := &NewTeam{
 		Name:                "n",
-		Description:         String("d"),
+		Description:         Ptr("d"),
 		Maintainers:         []string{"m1", "m2"},
 		RepoNames:           []string{"repo1", "repo2"},
-		NotificationSetting: String("notifications_enabled"),
-		ParentTeamID:        Int64(1),
-		Permission:          String("perm"),
-		Privacy:             String("p"),
-		LDAPDN:              String("l"),
+		NotificationSetting: Ptr("notifications_enabled"),
+		ParentTeamID:        Ptr(int64(1)),
+		Permission:          Ptr("perm"),
+		Privacy:             Ptr("p"),
+		LDAPDN:              Ptr("l"),
 	}
 
 	want := `{
@@ -1620,43 +1620,43 @@ func TestTeams_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Team{}, "{}")
 
 	u := &Team{
-		ID:              Int64(1),
-		NodeID:          String("n"),
-		Name:            String("n"),
-		Description:     String("d"),
-		URL:             String("u"),
-		Slug:            String("s"),
-		Permission:      String("p"),
-		Privacy:         String("p"),
-		MembersCount:    Int(1),
-		ReposCount:      Int(1),
-		MembersURL:      String("m"),
-		RepositoriesURL: String("r"),
+		ID:              Ptr(int64(1)),
+		NodeID:          Ptr("n"),
+		Name:            Ptr("n"),
+		Description:     Ptr("d"),
+		URL:             Ptr("u"),
+		Slug:            Ptr("s"),
+		Permission:      Ptr("p"),
+		Privacy:         Ptr("p"),
+		MembersCount:    Ptr(1),
+		ReposCount:      Ptr(1),
+		MembersURL:      Ptr("m"),
+		RepositoriesURL: Ptr("r"),
 		Organization: &Organization{
-			Login:     String("l"),
-			ID:        Int64(1),
-			NodeID:    String("n"),
-			AvatarURL: String("a"),
-			HTMLURL:   String("h"),
-			Name:      String("n"),
-			Company:   String("c"),
-			Blog:      String("b"),
-			Location:  String("l"),
-			Email:     String("e"),
+			Login:     Ptr("l"),
+			ID:        Ptr(int64(1)),
+			NodeID:    Ptr("n"),
+			AvatarURL: Ptr("a"),
+			HTMLURL:   Ptr("h"),
+			Name:      Ptr("n"),
+			Company:   Ptr("c"),
+			Blog:      Ptr("b"),
+			Location:  Ptr("l"),
+			Email:     Ptr("e"),
 		},
 		Parent: &Team{
-			ID:           Int64(1),
-			NodeID:       String("n"),
-			Name:         String("n"),
-			Description:  String("d"),
-			URL:          String("u"),
-			Slug:         String("s"),
-			Permission:   String("p"),
-			Privacy:      String("p"),
-			MembersCount: Int(1),
-			ReposCount:   Int(1),
+			ID:           Ptr(int64(1)),
+			NodeID:       Ptr("n"),
+			Name:         Ptr("n"),
+			Description:  Ptr("d"),
+			URL:          Ptr("u"),
+			Slug:         Ptr("s"),
+			Permission:   Ptr("p"),
+			Privacy:      Ptr("p"),
+			MembersCount: Ptr(1),
+			ReposCount:   Ptr(1),
 		},
-		LDAPDN: String("l"),
+		LDAPDN: Ptr("l"),
 	}
 
 	want := `{
@@ -1706,14 +1706,14 @@ func TestInvitation_Marshal(t *testing.T) {
 	testJSONMarshal(t, &Invitation{}, "{}")
 
 	u := &Invitation{
-		ID:                Int64(1),
-		NodeID:            String("test node"),
-		Login:             String("login123"),
-		Email:             String("go@github.com"),
-		Role:              String("developer"),
+		ID:                Ptr(int64(1)),
+		NodeID:            Ptr("test node"),
+		Login:             Ptr("login123"),
+		Email:             Ptr("go@github.com"),
+		Role:              Ptr("developer"),
 		CreatedAt:         &Timestamp{referenceTime},
-		TeamCount:         Int(99),
-		InvitationTeamURL: String("url"),
+		TeamCount:         Ptr(99),
+		InvitationTeamURL: Ptr("url"),
 	}
 
 	want := `{
@@ -1734,9 +1734,9 @@ func TestIDPGroup_Marshal(t *testing.T) {
 	testJSONMarshal(t, &IDPGroup{}, "{}")
 
 	u := &IDPGroup{
-		GroupID:          String("abc1"),
-		GroupName:        String("test group"),
-		GroupDescription: String("test group descripation"),
+		GroupID:          Ptr("abc1"),
+		GroupName:        Ptr("test group"),
+		GroupDescription: Ptr("test group descripation"),
 	}
 
 	want := `{
@@ -1792,31 +1792,31 @@ func TestTeamsService_GetExternalGroup(t *testing.T) {
 	}
 
 	want := &ExternalGroup{
-		GroupID:   Int64(123),
-		GroupName: String("Octocat admins"),
+		GroupID:   Ptr(int64(123)),
+		GroupName: Ptr("Octocat admins"),
 		UpdatedAt: &Timestamp{Time: referenceTime},
 		Teams: []*ExternalGroupTeam{
 			{
-				TeamID:   Int64(1),
-				TeamName: String("team-test"),
+				TeamID:   Ptr(int64(1)),
+				TeamName: Ptr("team-test"),
 			},
 			{
-				TeamID:   Int64(2),
-				TeamName: String("team-test2"),
+				TeamID:   Ptr(int64(2)),
+				TeamName: Ptr("team-test2"),
 			},
 		},
 		Members: []*ExternalGroupMember{
 			{
-				MemberID:    Int64(1),
-				MemberLogin: String("mona-lisa_eocsaxrs"),
-				MemberName:  String("Mona Lisa"),
-				MemberEmail: String("mona_lisa@github.com"),
+				MemberID:    Ptr(int64(1)),
+				MemberLogin: Ptr("mona-lisa_eocsaxrs"),
+				MemberName:  Ptr("Mona Lisa"),
+				MemberEmail: Ptr("mona_lisa@github.com"),
 			},
 			{
-				MemberID:    Int64(2),
-				MemberLogin: String("octo-lisa_eocsaxrs"),
-				MemberName:  String("Octo Lisa"),
-				MemberEmail: String("octo_lisa@github.com"),
+				MemberID:    Ptr(int64(2)),
+				MemberLogin: Ptr("octo-lisa_eocsaxrs"),
+				MemberName:  Ptr("Octo Lisa"),
+				MemberEmail: Ptr("octo_lisa@github.com"),
 			},
 		},
 	}
@@ -1880,7 +1880,7 @@ func TestTeamsService_ListExternalGroups(t *testing.T) {
 
 	ctx := context.Background()
 	opts := &ListExternalGroupsOptions{
-		DisplayName: String("Octocat"),
+		DisplayName: Ptr("Octocat"),
 	}
 	list, _, err := client.Teams.ListExternalGroups(ctx, "o", opts)
 	if err != nil {
@@ -1890,8 +1890,8 @@ func TestTeamsService_ListExternalGroups(t *testing.T) {
 	want := &ExternalGroupList{
 		Groups: []*ExternalGroup{
 			{
-				GroupID:   Int64(123),
-				GroupName: String("Octocat admins"),
+				GroupID:   Ptr(int64(123)),
+				GroupName: Ptr("Octocat admins"),
 				UpdatedAt: &Timestamp{Time: referenceTime},
 			},
 		},
@@ -1963,8 +1963,8 @@ func TestTeamsService_ListExternalGroupsForTeamBySlug(t *testing.T) {
 	want := &ExternalGroupList{
 		Groups: []*ExternalGroup{
 			{
-				GroupID:   Int64(123),
-				GroupName: String("Octocat admins"),
+				GroupID:   Ptr(int64(123)),
+				GroupName: Ptr("Octocat admins"),
 				UpdatedAt: &Timestamp{Time: referenceTime},
 			},
 		},
@@ -2049,7 +2049,7 @@ func TestTeamsService_UpdateConnectedExternalGroup(t *testing.T) {
 
 	ctx := context.Background()
 	body := &ExternalGroup{
-		GroupID: Int64(123),
+		GroupID: Ptr(int64(123)),
 	}
 	externalGroup, _, err := client.Teams.UpdateConnectedExternalGroup(ctx, "o", "t", body)
 	if err != nil {
@@ -2057,31 +2057,31 @@ func TestTeamsService_UpdateConnectedExternalGroup(t *testing.T) {
 	}
 
 	want := &ExternalGroup{
-		GroupID:   Int64(123),
-		GroupName: String("Octocat admins"),
+		GroupID:   Ptr(int64(123)),
+		GroupName: Ptr("Octocat admins"),
 		UpdatedAt: &Timestamp{Time: referenceTime},
 		Teams: []*ExternalGroupTeam{
 			{
-				TeamID:   Int64(1),
-				TeamName: String("team-test"),
+				TeamID:   Ptr(int64(1)),
+				TeamName: Ptr("team-test"),
 			},
 			{
-				TeamID:   Int64(2),
-				TeamName: String("team-test2"),
+				TeamID:   Ptr(int64(2)),
+				TeamName: Ptr("team-test2"),
 			},
 		},
 		Members: []*ExternalGroupMember{
 			{
-				MemberID:    Int64(1),
-				MemberLogin: String("mona-lisa_eocsaxrs"),
-				MemberName:  String("Mona Lisa"),
-				MemberEmail: String("mona_lisa@github.com"),
+				MemberID:    Ptr(int64(1)),
+				MemberLogin: Ptr("mona-lisa_eocsaxrs"),
+				MemberName:  Ptr("Mona Lisa"),
+				MemberEmail: Ptr("mona_lisa@github.com"),
 			},
 			{
-				MemberID:    Int64(2),
-				MemberLogin: String("octo-lisa_eocsaxrs"),
-				MemberName:  String("Octo Lisa"),
-				MemberEmail: String("octo_lisa@github.com"),
+				MemberID:    Ptr(int64(2)),
+				MemberLogin: Ptr("octo-lisa_eocsaxrs"),
+				MemberName:  Ptr("Octo Lisa"),
+				MemberEmail: Ptr("octo_lisa@github.com"),
 			},
 		},
 	}
@@ -2115,7 +2115,7 @@ func TestTeamsService_UpdateConnectedExternalGroup_notFound(t *testing.T) {
 
 	ctx := context.Background()
 	body := &ExternalGroup{
-		GroupID: Int64(123),
+		GroupID: Ptr(int64(123)),
 	}
 	eg, resp, err := client.Teams.UpdateConnectedExternalGroup(ctx, "o", "t", body)
 	if err == nil {
@@ -2180,14 +2180,14 @@ func TestIDPGroupList_Marshal(t *testing.T) {
 	u := &IDPGroupList{
 		Groups: []*IDPGroup{
 			{
-				GroupID:          String("abc1"),
-				GroupName:        String("test group"),
-				GroupDescription: String("test group descripation"),
+				GroupID:          Ptr("abc1"),
+				GroupName:        Ptr("test group"),
+				GroupDescription: Ptr("test group descripation"),
 			},
 			{
-				GroupID:          String("abc2"),
-				GroupName:        String("test group2"),
-				GroupDescription: String("test group descripation2"),
+				GroupID:          Ptr("abc2"),
+				GroupName:        Ptr("test group2"),
+				GroupDescription: Ptr("test group descripation2"),
 			},
 		},
 	}
@@ -2214,10 +2214,10 @@ func TestExternalGroupMember_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ExternalGroupMember{}, "{}")
 
 	u := &ExternalGroupMember{
-		MemberID:    Int64(1),
-		MemberLogin: String("test member"),
-		MemberName:  String("test member name"),
-		MemberEmail: String("test member email"),
+		MemberID:    Ptr(int64(1)),
+		MemberLogin: Ptr("test member"),
+		MemberName:  Ptr("test member name"),
+		MemberEmail: Ptr("test member email"),
 	}
 
 	want := `{
@@ -2234,25 +2234,25 @@ func TestExternalGroup_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ExternalGroup{}, "{}")
 
 	u := &ExternalGroup{
-		GroupID:   Int64(123),
-		GroupName: String("group1"),
+		GroupID:   Ptr(int64(123)),
+		GroupName: Ptr("group1"),
 		UpdatedAt: &Timestamp{referenceTime},
 		Teams: []*ExternalGroupTeam{
 			{
-				TeamID:   Int64(1),
-				TeamName: String("team-test"),
+				TeamID:   Ptr(int64(1)),
+				TeamName: Ptr("team-test"),
 			},
 			{
-				TeamID:   Int64(2),
-				TeamName: String("team-test2"),
+				TeamID:   Ptr(int64(2)),
+				TeamName: Ptr("team-test2"),
 			},
 		},
 		Members: []*ExternalGroupMember{
 			{
-				MemberID:    Int64(1),
-				MemberLogin: String("test"),
-				MemberName:  String("test"),
-				MemberEmail: String("test@github.com"),
+				MemberID:    Ptr(int64(1)),
+				MemberLogin: Ptr("test"),
+				MemberName:  Ptr("test"),
+				MemberEmail: Ptr("test@github.com"),
 			},
 		},
 	}
@@ -2288,8 +2288,8 @@ func TestExternalGroupTeam_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ExternalGroupTeam{}, "{}")
 
 	u := &ExternalGroupTeam{
-		TeamID:   Int64(123),
-		TeamName: String("test"),
+		TeamID:   Ptr(int64(123)),
+		TeamName: Ptr("test"),
 	}
 
 	want := `{
@@ -2304,7 +2304,7 @@ func TestListExternalGroupsOptions_Marshal(t *testing.T) {
 	testJSONMarshal(t, &ListExternalGroupsOptions{}, "{}")
 
 	u := &ListExternalGroupsOptions{
-		DisplayName: String("test"),
+		DisplayName: Ptr("test"),
 		ListOptions: ListOptions{
 			Page:    1,
 			PerPage: 2,
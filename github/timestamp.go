@@ -7,11 +7,12 @@ package github
 
 import (
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Timestamp represents a time that can be unmarshalled from a JSON string
-// formatted as either an RFC3339 or Unix timestamp. This is necessary for some
+// formatted as an RFC3339 or Unix timestamp, or as a date. This is necessary for some
 // fields since the GitHub API is inconsistent in how it represents times. All
 // exported methods of time.Time can be called on Timestamp.
 type Timestamp struct {
@@ -31,19 +32,31 @@ func (t *Timestamp) GetTime() *time.Time {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 or Unix format, as a number or a string, or as
+// a date such as "2006-01-02". An empty string or null leaves t unchanged.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
+	if str == "null" || str == `""` {
+		return nil
+	}
+	if unquoted, uerr := strconv.Unquote(str); uerr == nil && unquoted != "" && strings.Trim(unquoted, "0123456789") == "" {
+		str = unquoted
+	}
 	i, err := strconv.ParseInt(str, 10, 64)
 	if err == nil {
 		t.Time = time.Unix(i, 0)
 		if t.Time.Year() > 3000 {
 			t.Time = time.Unix(0, i*1e6)
 		}
-	} else {
-		t.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
+		return nil
+	}
+	t.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
+	if err != nil {
+		if date, derr := time.Parse(`"`+time.DateOnly+`"`, str); derr == nil {
+			t.Time, err = date, nil
+		}
 	}
-	return
+	return err
 }
 
 // Equal reports whether t and u are equal based on time.Equal
@@ -68,6 +68,11 @@ func TestTimestamp_Unmarshal(t *testing.T) {
 		{"MismatchUnix", `0`, Timestamp{}, false, false},
 		{"Invalid", `"asdf"`, Timestamp{referenceTime}, true, false},
 		{"OffByMillisecond", `1136214245001`, Timestamp{referenceTime}, false, false},
+		{"QuotedUnix", `"1136214245"`, Timestamp{referenceTime}, false, true},
+		{"DateOnly", `"2006-01-02"`, Timestamp{time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)}, false, true},
+		{"EmptyString", `""`, Timestamp{}, false, true},
+		{"Null", `null`, Timestamp{}, false, true},
+		{"InvalidDate", `"2006-13-02"`, Timestamp{referenceTime}, true, false},
 	}
 	for _, tc := range testCases {
 		var got Timestamp
@@ -32,7 +32,7 @@ func TestUsersService_ListGPGKeys_authenticatedUser(t *testing.T) {
 		t.Errorf("Users.ListGPGKeys returned error: %v", err)
 	}
 
-	want := []*GPGKey{{ID: Int64(1), PrimaryKeyID: Int64(2)}}
+	want := []*GPGKey{{ID: Ptr(int64(1)), PrimaryKeyID: Ptr(int64(2))}}
 	if !cmp.Equal(keys, want) {
 		t.Errorf("Users.ListGPGKeys = %+v, want %+v", keys, want)
 	}
@@ -67,7 +67,7 @@ func TestUsersService_ListGPGKeys_specifiedUser(t *testing.T) {
 		t.Errorf("Users.ListGPGKeys returned error: %v", err)
 	}
 
-	want := []*GPGKey{{ID: Int64(1), PrimaryKeyID: Int64(2)}}
+	want := []*GPGKey{{ID: Ptr(int64(1)), PrimaryKeyID: Ptr(int64(2))}}
 	if !cmp.Equal(keys, want) {
 		t.Errorf("Users.ListGPGKeys = %+v, want %+v", keys, want)
 	}
@@ -97,7 +97,7 @@ func TestUsersService_GetGPGKey(t *testing.T) {
 		t.Errorf("Users.GetGPGKey returned error: %v", err)
 	}
 
-	want := &GPGKey{ID: Int64(1)}
+	want := &GPGKey{ID: Ptr(int64(1))}
 	if !cmp.Equal(key, want) {
 		t.Errorf("Users.GetGPGKey = %+v, want %+v", key, want)
 	}
@@ -150,7 +150,7 @@ mQINBFcEd9kBEACo54TDbGhKlXKWMvJgecEUKPPcv7XdnpKdGb3LRw5MvFwT0V0f
 		t.Errorf("Users.GetGPGKey returned error: %v", err)
 	}
 
-	want := &GPGKey{ID: Int64(1)}
+	want := &GPGKey{ID: Ptr(int64(1))}
 	if !cmp.Equal(gpgKey, want) {
 		t.Errorf("Users.GetGPGKey = %+v, want %+v", gpgKey, want)
 	}
@@ -194,8 +194,8 @@ func TestGPGEmail_Marshal(t *testing.T) {
 	testJSONMarshal(t, &GPGEmail{}, "{}")
 
 	u := &GPGEmail{
-		Email:    String("email@abc.com"),
-		Verified: Bool(false),
+		Email:    Ptr("email@abc.com"),
+		Verified: Ptr(false),
 	}
 
 	want := `{
@@ -212,24 +212,24 @@ func TestGPGKey_Marshal(t *testing.T) {
 	ti := &Timestamp{}
 
 	g := &GPGKey{
-		ID:           Int64(1),
-		PrimaryKeyID: Int64(1),
-		KeyID:        String("someKeyID"),
-		RawKey:       String("someRawKeyID"),
-		PublicKey:    String("somePublicKey"),
+		ID:           Ptr(int64(1)),
+		PrimaryKeyID: Ptr(int64(1)),
+		KeyID:        Ptr("someKeyID"),
+		RawKey:       Ptr("someRawKeyID"),
+		PublicKey:    Ptr("somePublicKey"),
 		Emails: []*GPGEmail{
 			{
-				Email:    String("someEmail"),
-				Verified: Bool(true),
+				Email:    Ptr("someEmail"),
+				Verified: Ptr(true),
 			},
 		},
 		Subkeys: []*GPGKey{
 			{},
 		},
-		CanSign:           Bool(true),
-		CanEncryptComms:   Bool(true),
-		CanEncryptStorage: Bool(true),
-		CanCertify:        Bool(true),
+		CanSign:           Ptr(true),
+		CanEncryptComms:   Ptr(true),
+		CanEncryptStorage: Ptr(true),
+		CanCertify:        Ptr(true),
 		CreatedAt:         ti,
 		ExpiresAt:         ti,
 	}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // ListPackages lists the packages for a user. Passing the empty string for "user" will
@@ -44,9 +45,42 @@ func (s *UsersService) ListPackages(ctx context.Context, user string, opts *Pack
 	return packages, resp, nil
 }
 
+// ListDockerMigrationConflicts lists all packages for a user that conflict
+// with a Docker migration. Passing the empty string for "user" will list
+// conflicting packages for the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/rest/packages/packages#get-list-of-conflicting-packages-during-docker-migration-for-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/packages/packages#get-list-of-conflicting-packages-during-docker-migration-for-user
+//
+//meta:operation GET /user/docker/conflicts
+//meta:operation GET /users/{username}/docker/conflicts
+func (s *UsersService) ListDockerMigrationConflicts(ctx context.Context, user string) ([]*Package, *Response, error) {
+	var u string
+	if user != "" {
+		u = fmt.Sprintf("users/%v/docker/conflicts", user)
+	} else {
+		u = "user/docker/conflicts"
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var packages []*Package
+	resp, err := s.client.Do(ctx, req, &packages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return packages, resp, nil
+}
+
 // GetPackage gets a package by name for a user. Passing the empty string for "user" will
 // get the package for the authenticated user.
 //
+// Note that packageName is escaped for the URL path so that you don't need to.
+//
 // GitHub API docs: https://docs.github.com/rest/packages/packages#get-a-package-for-a-user
 // GitHub API docs: https://docs.github.com/rest/packages/packages#get-a-package-for-the-authenticated-user
 //
@@ -55,9 +89,9 @@ func (s *UsersService) ListPackages(ctx context.Context, user string, opts *Pack
 func (s *UsersService) GetPackage(ctx context.Context, user, packageType, packageName string) (*Package, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v", packageType, url.PathEscape(packageName))
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -77,6 +111,8 @@ func (s *UsersService) GetPackage(ctx context.Context, user, packageType, packag
 // DeletePackage deletes a package from a user. Passing the empty string for "user" will
 // delete the package for the authenticated user.
 //
+// Note that packageName is escaped for the URL path so that you don't need to.
+//
 // GitHub API docs: https://docs.github.com/rest/packages/packages#delete-a-package-for-a-user
 // GitHub API docs: https://docs.github.com/rest/packages/packages#delete-a-package-for-the-authenticated-user
 //
@@ -85,9 +121,9 @@ func (s *UsersService) GetPackage(ctx context.Context, user, packageType, packag
 func (s *UsersService) DeletePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v", packageType, url.PathEscape(packageName))
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -101,6 +137,8 @@ func (s *UsersService) DeletePackage(ctx context.Context, user, packageType, pac
 // RestorePackage restores a package to a user. Passing the empty string for "user" will
 // restore the package for the authenticated user.
 //
+// Note that packageName is escaped for the URL path so that you don't need to.
+//
 // GitHub API docs: https://docs.github.com/rest/packages/packages#restore-a-package-for-a-user
 // GitHub API docs: https://docs.github.com/rest/packages/packages#restore-a-package-for-the-authenticated-user
 //
@@ -109,9 +147,9 @@ func (s *UsersService) DeletePackage(ctx context.Context, user, packageType, pac
 func (s *UsersService) RestorePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/restore", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/restore", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/restore", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v/restore", packageType, url.PathEscape(packageName))
 	}
 
 	req, err := s.client.NewRequest("POST", u, nil)
@@ -125,6 +163,8 @@ func (s *UsersService) RestorePackage(ctx context.Context, user, packageType, pa
 // PackageGetAllVersions gets all versions of a package for a user. Passing the empty string for "user" will
 // get versions for the authenticated user.
 //
+// Note that packageName is escaped for the URL path so that you don't need to.
+//
 // GitHub API docs: https://docs.github.com/rest/packages/packages#list-package-versions-for-a-package-owned-by-a-user
 // GitHub API docs: https://docs.github.com/rest/packages/packages#list-package-versions-for-a-package-owned-by-the-authenticated-user
 //
@@ -133,9 +173,9 @@ func (s *UsersService) RestorePackage(ctx context.Context, user, packageType, pa
 func (s *UsersService) PackageGetAllVersions(ctx context.Context, user, packageType, packageName string, opts *PackageListOptions) ([]*PackageVersion, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions", user, packageType, packageName)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions", user, packageType, url.PathEscape(packageName))
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions", packageType, packageName)
+		u = fmt.Sprintf("user/packages/%v/%v/versions", packageType, url.PathEscape(packageName))
 	}
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -159,6 +199,8 @@ func (s *UsersService) PackageGetAllVersions(ctx context.Context, user, packageT
 // PackageGetVersion gets a specific version of a package for a user. Passing the empty string for "user" will
 // get the version for the authenticated user.
 //
+// Note that packageName is escaped for the URL path so that you don't need to.
+//
 // GitHub API docs: https://docs.github.com/rest/packages/packages#get-a-package-version-for-a-user
 // GitHub API docs: https://docs.github.com/rest/packages/packages#get-a-package-version-for-the-authenticated-user
 //
@@ -167,9 +209,9 @@ func (s *UsersService) PackageGetAllVersions(ctx context.Context, user, packageT
 func (s *UsersService) PackageGetVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*PackageVersion, *Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, url.PathEscape(packageName), packageVersionID)
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, url.PathEscape(packageName), packageVersionID)
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -189,6 +231,8 @@ func (s *UsersService) PackageGetVersion(ctx context.Context, user, packageType,
 // PackageDeleteVersion deletes a package version for a user. Passing the empty string for "user" will
 // delete the version for the authenticated user.
 //
+// Note that packageName is escaped for the URL path so that you don't need to.
+//
 // GitHub API docs: https://docs.github.com/rest/packages/packages#delete-a-package-version-for-the-authenticated-user
 // GitHub API docs: https://docs.github.com/rest/packages/packages#delete-package-version-for-a-user
 //
@@ -197,9 +241,9 @@ func (s *UsersService) PackageGetVersion(ctx context.Context, user, packageType,
 func (s *UsersService) PackageDeleteVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v", user, packageType, url.PathEscape(packageName), packageVersionID)
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("user/packages/%v/%v/versions/%v", packageType, url.PathEscape(packageName), packageVersionID)
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -213,6 +257,8 @@ func (s *UsersService) PackageDeleteVersion(ctx context.Context, user, packageTy
 // PackageRestoreVersion restores a package version to a user. Passing the empty string for "user" will
 // restore the version for the authenticated user.
 //
+// Note that packageName is escaped for the URL path so that you don't need to.
+//
 // GitHub API docs: https://docs.github.com/rest/packages/packages#restore-a-package-version-for-the-authenticated-user
 // GitHub API docs: https://docs.github.com/rest/packages/packages#restore-package-version-for-a-user
 //
@@ -221,9 +267,9 @@ func (s *UsersService) PackageDeleteVersion(ctx context.Context, user, packageTy
 func (s *UsersService) PackageRestoreVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*Response, error) {
 	var u string
 	if user != "" {
-		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v/restore", user, packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("users/%v/packages/%v/%v/versions/%v/restore", user, packageType, url.PathEscape(packageName), packageVersionID)
 	} else {
-		u = fmt.Sprintf("user/packages/%v/%v/versions/%v/restore", packageType, packageName, packageVersionID)
+		u = fmt.Sprintf("user/packages/%v/%v/versions/%v/restore", packageType, url.PathEscape(packageName), packageVersionID)
 	}
 
 	req, err := s.client.NewRequest("POST", u, nil)
@@ -35,19 +35,19 @@ func TestUsersService_Authenticated_ListPackages(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	packages, _, err := client.Users.ListPackages(ctx, "", &PackageListOptions{PackageType: String("container"), Visibility: String("private")})
+	packages, _, err := client.Users.ListPackages(ctx, "", &PackageListOptions{PackageType: Ptr("container"), Visibility: Ptr("private")})
 	if err != nil {
 		t.Errorf("Users.Authenticated_ListPackages returned error: %v", err)
 	}
 
 	want := []*Package{{
-		ID:           Int64(197),
-		Name:         String("hello_docker"),
-		PackageType:  String("container"),
-		VersionCount: Int64(1),
-		Visibility:   String("private"),
-		URL:          String("https://api.github.com/orgs/github/packages/container/hello_docker"),
-		HTMLURL:      String("https://github.com/orgs/github/packages/container/package/hello_docker"),
+		ID:           Ptr(int64(197)),
+		Name:         Ptr("hello_docker"),
+		PackageType:  Ptr("container"),
+		VersionCount: Ptr(int64(1)),
+		Visibility:   Ptr("private"),
+		URL:          Ptr("https://api.github.com/orgs/github/packages/container/hello_docker"),
+		HTMLURL:      Ptr("https://github.com/orgs/github/packages/container/package/hello_docker"),
 		CreatedAt:    &Timestamp{referenceTime},
 		UpdatedAt:    &Timestamp{referenceTime},
 	}}
@@ -91,19 +91,19 @@ func TestUsersService_specifiedUser_ListPackages(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	packages, _, err := client.Users.ListPackages(ctx, "u", &PackageListOptions{PackageType: String("container"), Visibility: String("public")})
+	packages, _, err := client.Users.ListPackages(ctx, "u", &PackageListOptions{PackageType: Ptr("container"), Visibility: Ptr("public")})
 	if err != nil {
 		t.Errorf("Users.specifiedUser_ListPackages returned error: %v", err)
 	}
 
 	want := []*Package{{
-		ID:           Int64(197),
-		Name:         String("hello_docker"),
-		PackageType:  String("container"),
-		VersionCount: Int64(1),
-		Visibility:   String("public"),
-		URL:          String("https://api.github.com/orgs/github/packages/container/hello_docker"),
-		HTMLURL:      String("https://github.com/orgs/github/packages/container/package/hello_docker"),
+		ID:           Ptr(int64(197)),
+		Name:         Ptr("hello_docker"),
+		PackageType:  Ptr("container"),
+		VersionCount: Ptr(int64(1)),
+		Visibility:   Ptr("public"),
+		URL:          Ptr("https://api.github.com/orgs/github/packages/container/hello_docker"),
+		HTMLURL:      Ptr("https://github.com/orgs/github/packages/container/package/hello_docker"),
 		CreatedAt:    &Timestamp{referenceTime},
 		UpdatedAt:    &Timestamp{referenceTime},
 	}}
@@ -126,6 +126,96 @@ func TestUsersService_specifiedUser_ListPackages(t *testing.T) {
 	})
 }
 
+func TestUsersService_Authenticated_ListDockerMigrationConflicts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/docker/conflicts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":197,"name":"hello_docker","package_type":"container"}]`)
+	})
+
+	ctx := context.Background()
+	packages, _, err := client.Users.ListDockerMigrationConflicts(ctx, "")
+	if err != nil {
+		t.Errorf("Users.ListDockerMigrationConflicts returned error: %v", err)
+	}
+
+	want := []*Package{{
+		ID:          Ptr(int64(197)),
+		Name:        Ptr("hello_docker"),
+		PackageType: Ptr("container"),
+	}}
+	if !cmp.Equal(packages, want) {
+		t.Errorf("Users.ListDockerMigrationConflicts returned %+v, want %+v", packages, want)
+	}
+
+	const methodName = "ListDockerMigrationConflicts"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.ListDockerMigrationConflicts(ctx, "")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_specifiedUser_ListDockerMigrationConflicts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/docker/conflicts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":197,"name":"hello_docker","package_type":"container"}]`)
+	})
+
+	ctx := context.Background()
+	packages, _, err := client.Users.ListDockerMigrationConflicts(ctx, "u")
+	if err != nil {
+		t.Errorf("Users.ListDockerMigrationConflicts returned error: %v", err)
+	}
+
+	want := []*Package{{
+		ID:          Ptr(int64(197)),
+		Name:        Ptr("hello_docker"),
+		PackageType: Ptr("container"),
+	}}
+	if !cmp.Equal(packages, want) {
+		t.Errorf("Users.ListDockerMigrationConflicts returned %+v, want %+v", packages, want)
+	}
+
+	const methodName = "ListDockerMigrationConflicts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.ListDockerMigrationConflicts(ctx, "\n")
+		return err
+	})
+}
+
+func TestUsersService_specifiedUser_GetPackage_escapesName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// don't url escape the package name here since mux will convert it to a slash automatically
+	mux.HandleFunc("/users/u/packages/container/hello/hello_docker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/users/u/packages/container/hello%2Fhello_docker"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"id":197,"name":"hello/hello_docker"}`)
+	})
+
+	ctx := context.Background()
+	pack, _, err := client.Users.GetPackage(ctx, "u", "container", "hello/hello_docker")
+	if err != nil {
+		t.Errorf("Users.GetPackage returned error: %v", err)
+	}
+
+	want := &Package{ID: Ptr(int64(197)), Name: Ptr("hello/hello_docker")}
+	if !cmp.Equal(pack, want) {
+		t.Errorf("Users.GetPackage returned %+v, want %+v", pack, want)
+	}
+}
+
 func TestUsersService_specifiedUser_GetPackage(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -152,13 +242,13 @@ func TestUsersService_specifiedUser_GetPackage(t *testing.T) {
 	}
 
 	want := &Package{
-		ID:           Int64(197),
-		Name:         String("hello_docker"),
-		PackageType:  String("container"),
-		VersionCount: Int64(1),
-		Visibility:   String("private"),
-		URL:          String("https://api.github.com/orgs/github/packages/container/hello_docker"),
-		HTMLURL:      String("https://github.com/orgs/github/packages/container/package/hello_docker"),
+		ID:           Ptr(int64(197)),
+		Name:         Ptr("hello_docker"),
+		PackageType:  Ptr("container"),
+		VersionCount: Ptr(int64(1)),
+		Visibility:   Ptr("private"),
+		URL:          Ptr("https://api.github.com/orgs/github/packages/container/hello_docker"),
+		HTMLURL:      Ptr("https://github.com/orgs/github/packages/container/package/hello_docker"),
 		CreatedAt:    &Timestamp{referenceTime},
 		UpdatedAt:    &Timestamp{referenceTime},
 	}
@@ -207,13 +297,13 @@ func TestUsersService_Authenticated_GetPackage(t *testing.T) {
 	}
 
 	want := &Package{
-		ID:           Int64(197),
-		Name:         String("hello_docker"),
-		PackageType:  String("container"),
-		VersionCount: Int64(1),
-		Visibility:   String("private"),
-		URL:          String("https://api.github.com/orgs/github/packages/container/hello_docker"),
-		HTMLURL:      String("https://github.com/orgs/github/packages/container/package/hello_docker"),
+		ID:           Ptr(int64(197)),
+		Name:         Ptr("hello_docker"),
+		PackageType:  Ptr("container"),
+		VersionCount: Ptr(int64(1)),
+		Visibility:   Ptr("private"),
+		URL:          Ptr("https://api.github.com/orgs/github/packages/container/hello_docker"),
+		HTMLURL:      Ptr("https://github.com/orgs/github/packages/container/package/hello_docker"),
 		CreatedAt:    &Timestamp{referenceTime},
 		UpdatedAt:    &Timestamp{referenceTime},
 	}
@@ -364,7 +454,7 @@ func TestUsersService_Authenticated_ListPackagesVersions(t *testing.T) {
 
 	ctx := context.Background()
 	opts := &PackageListOptions{
-		String("internal"), String("container"), String("deleted"), ListOptions{Page: 1, PerPage: 2},
+		Ptr("internal"), Ptr("container"), Ptr("deleted"), ListOptions{Page: 1, PerPage: 2},
 	}
 	packages, _, err := client.Users.PackageGetAllVersions(ctx, "", "container", "hello_docker", opts)
 	if err != nil {
@@ -372,15 +462,15 @@ func TestUsersService_Authenticated_ListPackagesVersions(t *testing.T) {
 	}
 
 	want := []*PackageVersion{{
-		ID:             Int64(45763),
-		Name:           String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
-		URL:            String("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
-		PackageHTMLURL: String("https://github.com/users/octocat/packages/container/package/hello_docker"),
+		ID:             Ptr(int64(45763)),
+		Name:           Ptr("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		URL:            Ptr("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
+		PackageHTMLURL: Ptr("https://github.com/users/octocat/packages/container/package/hello_docker"),
 		CreatedAt:      &Timestamp{referenceTime},
 		UpdatedAt:      &Timestamp{referenceTime},
-		HTMLURL:        String("https://github.com/users/octocat/packages/container/hello_docker/45763"),
+		HTMLURL:        Ptr("https://github.com/users/octocat/packages/container/hello_docker/45763"),
 		Metadata: &PackageMetadata{
-			PackageType: String("container"),
+			PackageType: Ptr("container"),
 			Container: &PackageContainerMetadata{
 				Tags: []string{"latest"},
 			},
@@ -433,7 +523,7 @@ func TestUsersService_specifiedUser_ListPackagesVersions(t *testing.T) {
 
 	ctx := context.Background()
 	opts := &PackageListOptions{
-		String("internal"), String("container"), String("deleted"), ListOptions{Page: 1, PerPage: 2},
+		Ptr("internal"), Ptr("container"), Ptr("deleted"), ListOptions{Page: 1, PerPage: 2},
 	}
 	packages, _, err := client.Users.PackageGetAllVersions(ctx, "u", "container", "hello_docker", opts)
 	if err != nil {
@@ -441,15 +531,15 @@ func TestUsersService_specifiedUser_ListPackagesVersions(t *testing.T) {
 	}
 
 	want := []*PackageVersion{{
-		ID:             Int64(45763),
-		Name:           String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
-		URL:            String("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
-		PackageHTMLURL: String("https://github.com/users/octocat/packages/container/package/hello_docker"),
+		ID:             Ptr(int64(45763)),
+		Name:           Ptr("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		URL:            Ptr("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
+		PackageHTMLURL: Ptr("https://github.com/users/octocat/packages/container/package/hello_docker"),
 		CreatedAt:      &Timestamp{referenceTime},
 		UpdatedAt:      &Timestamp{referenceTime},
-		HTMLURL:        String("https://github.com/users/octocat/packages/container/hello_docker/45763"),
+		HTMLURL:        Ptr("https://github.com/users/octocat/packages/container/hello_docker/45763"),
 		Metadata: &PackageMetadata{
-			PackageType: String("container"),
+			PackageType: Ptr("container"),
 			Container: &PackageContainerMetadata{
 				Tags: []string{"latest"},
 			},
@@ -507,15 +597,15 @@ func TestUsersService_Authenticated_PackageGetVersion(t *testing.T) {
 	}
 
 	want := &PackageVersion{
-		ID:             Int64(45763),
-		Name:           String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
-		URL:            String("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
-		PackageHTMLURL: String("https://github.com/users/octocat/packages/container/package/hello_docker"),
+		ID:             Ptr(int64(45763)),
+		Name:           Ptr("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		URL:            Ptr("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
+		PackageHTMLURL: Ptr("https://github.com/users/octocat/packages/container/package/hello_docker"),
 		CreatedAt:      &Timestamp{referenceTime},
 		UpdatedAt:      &Timestamp{referenceTime},
-		HTMLURL:        String("https://github.com/users/octocat/packages/container/hello_docker/45763"),
+		HTMLURL:        Ptr("https://github.com/users/octocat/packages/container/hello_docker/45763"),
 		Metadata: &PackageMetadata{
-			PackageType: String("container"),
+			PackageType: Ptr("container"),
 			Container: &PackageContainerMetadata{
 				Tags: []string{"latest"},
 			},
@@ -573,15 +663,15 @@ func TestUsersService_specifiedUser_PackageGetVersion(t *testing.T) {
 	}
 
 	want := &PackageVersion{
-		ID:             Int64(45763),
-		Name:           String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
-		URL:            String("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
-		PackageHTMLURL: String("https://github.com/users/octocat/packages/container/package/hello_docker"),
+		ID:             Ptr(int64(45763)),
+		Name:           Ptr("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		URL:            Ptr("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
+		PackageHTMLURL: Ptr("https://github.com/users/octocat/packages/container/package/hello_docker"),
 		CreatedAt:      &Timestamp{referenceTime},
 		UpdatedAt:      &Timestamp{referenceTime},
-		HTMLURL:        String("https://github.com/users/octocat/packages/container/hello_docker/45763"),
+		HTMLURL:        Ptr("https://github.com/users/octocat/packages/container/hello_docker/45763"),
 		Metadata: &PackageMetadata{
-			PackageType: String("container"),
+			PackageType: Ptr("container"),
 			Container: &PackageContainerMetadata{
 				Tags: []string{"latest"},
 			},
@@ -32,7 +32,7 @@ func TestUsersService_ListSSHSigningKeys_authenticatedUser(t *testing.T) {
 		t.Errorf("Users.ListSSHSigningKeys returned error: %v", err)
 	}
 
-	want := []*SSHSigningKey{{ID: Int64(1)}}
+	want := []*SSHSigningKey{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(keys, want) {
 		t.Errorf("Users.ListSSHSigningKeys returned %+v, want %+v", keys, want)
 	}
@@ -67,7 +67,7 @@ func TestUsersService_ListSSHSigningKeys_specifiedUser(t *testing.T) {
 		t.Errorf("Users.ListSSHSigningKeys returned error: %v", err)
 	}
 
-	want := []*SSHSigningKey{{ID: Int64(1)}}
+	want := []*SSHSigningKey{{ID: Ptr(int64(1))}}
 	if !cmp.Equal(keys, want) {
 		t.Errorf("Users.ListSSHSigningKeys returned %+v, want %+v", keys, want)
 	}
@@ -97,7 +97,7 @@ func TestUsersService_GetSSHSigningKey(t *testing.T) {
 		t.Errorf("Users.GetSSHSigningKey returned error: %v", err)
 	}
 
-	want := &SSHSigningKey{ID: Int64(1)}
+	want := &SSHSigningKey{ID: Ptr(int64(1))}
 	if !cmp.Equal(key, want) {
 		t.Errorf("Users.GetSSHSigningKey returned %+v, want %+v", key, want)
 	}
@@ -121,7 +121,7 @@ func TestUsersService_CreateSSHSigningKey(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &Key{Key: String("k"), Title: String("t")}
+	input := &Key{Key: Ptr("k"), Title: Ptr("t")}
 
 	mux.HandleFunc("/user/ssh_signing_keys", func(w http.ResponseWriter, r *http.Request) {
 		v := new(Key)
@@ -141,7 +141,7 @@ func TestUsersService_CreateSSHSigningKey(t *testing.T) {
 		t.Errorf("Users.CreateSSHSigningKey returned error: %v", err)
 	}
 
-	want := &SSHSigningKey{ID: Int64(1)}
+	want := &SSHSigningKey{ID: Ptr(int64(1))}
 	if !cmp.Equal(key, want) {
 		t.Errorf("Users.CreateSSHSigningKey returned %+v, want %+v", key, want)
 	}
@@ -185,9 +185,9 @@ func TestSSHSigningKey_Marshal(t *testing.T) {
 	testJSONMarshal(t, &SSHSigningKey{}, "{}")
 
 	u := &Key{
-		ID:        Int64(1),
-		Key:       String("abc"),
-		Title:     String("title"),
+		ID:        Ptr(int64(1)),
+		Key:       Ptr("abc"),
+		Title:     Ptr("title"),
 		CreatedAt: &Timestamp{referenceTime},
 	}
 
@@ -19,22 +19,22 @@ func TestUser_Marshal(t *testing.T) {
 	testJSONMarshal(t, &User{}, "{}")
 
 	u := &User{
-		Login:           String("l"),
-		ID:              Int64(1),
-		URL:             String("u"),
-		AvatarURL:       String("a"),
-		GravatarID:      String("g"),
-		Name:            String("n"),
-		Company:         String("c"),
-		Blog:            String("b"),
-		Location:        String("l"),
-		Email:           String("e"),
-		Hireable:        Bool(true),
-		Bio:             String("b"),
-		TwitterUsername: String("t"),
-		PublicRepos:     Int(1),
-		Followers:       Int(1),
-		Following:       Int(1),
+		Login:           Ptr("l"),
+		ID:              Ptr(int64(1)),
+		URL:             Ptr("u"),
+		AvatarURL:       Ptr("a"),
+		GravatarID:      Ptr("g"),
+		Name:            Ptr("n"),
+		Company:         Ptr("c"),
+		Blog:            Ptr("b"),
+		Location:        Ptr("l"),
+		Email:           Ptr("e"),
+		Hireable:        Ptr(true),
+		Bio:             Ptr("b"),
+		TwitterUsername: Ptr("t"),
+		PublicRepos:     Ptr(1),
+		Followers:       Ptr(1),
+		Following:       Ptr(1),
 		CreatedAt:       &Timestamp{referenceTime},
 		SuspendedAt:     &Timestamp{referenceTime},
 	}
@@ -61,44 +61,44 @@ func TestUser_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 
 	u2 := &User{
-		Login:                   String("testLogin"),
-		ID:                      Int64(1),
-		NodeID:                  String("testNode123"),
-		AvatarURL:               String("https://www.my-avatar.com"),
-		HTMLURL:                 String("https://www.test-url.com"),
-		GravatarID:              String("testGravatar123"),
-		Name:                    String("myName"),
-		Company:                 String("testCompany"),
-		Blog:                    String("test Blog"),
-		Location:                String("test location"),
-		Email:                   String("test@test.com"),
-		Hireable:                Bool(true),
-		Bio:                     String("my good bio"),
-		TwitterUsername:         String("https://www.twitter.com/test"),
-		PublicRepos:             Int(1),
-		PublicGists:             Int(2),
-		Followers:               Int(100),
-		Following:               Int(29),
+		Login:                   Ptr("testLogin"),
+		ID:                      Ptr(int64(1)),
+		NodeID:                  Ptr("testNode123"),
+		AvatarURL:               Ptr("https://www.my-avatar.com"),
+		HTMLURL:                 Ptr("https://www.test-url.com"),
+		GravatarID:              Ptr("testGravatar123"),
+		Name:                    Ptr("myName"),
+		Company:                 Ptr("testCompany"),
+		Blog:                    Ptr("test Blog"),
+		Location:                Ptr("test location"),
+		Email:                   Ptr("test@test.com"),
+		Hireable:                Ptr(true),
+		Bio:                     Ptr("my good bio"),
+		TwitterUsername:         Ptr("https://www.twitter.com/test"),
+		PublicRepos:             Ptr(1),
+		PublicGists:             Ptr(2),
+		Followers:               Ptr(100),
+		Following:               Ptr(29),
 		CreatedAt:               &Timestamp{referenceTime},
 		UpdatedAt:               &Timestamp{referenceTime},
 		SuspendedAt:             &Timestamp{referenceTime},
-		Type:                    String("test type"),
-		SiteAdmin:               Bool(false),
-		TotalPrivateRepos:       Int64(2),
-		OwnedPrivateRepos:       Int64(1),
-		PrivateGists:            Int(1),
-		DiskUsage:               Int(1),
-		Collaborators:           Int(1),
-		TwoFactorAuthentication: Bool(false),
+		Type:                    Ptr("test type"),
+		SiteAdmin:               Ptr(false),
+		TotalPrivateRepos:       Ptr(int64(2)),
+		OwnedPrivateRepos:       Ptr(int64(1)),
+		PrivateGists:            Ptr(1),
+		DiskUsage:               Ptr(1),
+		Collaborators:           Ptr(1),
+		TwoFactorAuthentication: Ptr(false),
 		Plan: &Plan{
-			Name:          String("silver"),
-			Space:         Int(1024),
-			Collaborators: Int(10),
-			PrivateRepos:  Int64(4),
-			FilledSeats:   Int(24),
-			Seats:         Int(1),
+			Name:          Ptr("silver"),
+			Space:         Ptr(1024),
+			Collaborators: Ptr(10),
+			PrivateRepos:  Ptr(int64(4)),
+			FilledSeats:   Ptr(24),
+			Seats:         Ptr(1),
 		},
-		LdapDn: String("test ldap"),
+		LdapDn: Ptr("test ldap"),
 	}
 
 	want2 := `{
@@ -159,7 +159,7 @@ func TestUsersService_Get_authenticatedUser(t *testing.T) {
 		t.Errorf("Users.Get returned error: %v", err)
 	}
 
-	want := &User{ID: Int64(1)}
+	want := &User{ID: Ptr(int64(1))}
 	if !cmp.Equal(user, want) {
 		t.Errorf("Users.Get returned %+v, want %+v", user, want)
 	}
@@ -194,7 +194,7 @@ func TestUsersService_Get_specifiedUser(t *testing.T) {
 		t.Errorf("Users.Get returned error: %v", err)
 	}
 
-	want := &User{ID: Int64(1)}
+	want := &User{ID: Ptr(int64(1))}
 	if !cmp.Equal(user, want) {
 		t.Errorf("Users.Get returned %+v, want %+v", user, want)
 	}
@@ -224,7 +224,7 @@ func TestUsersService_GetByID(t *testing.T) {
 		t.Fatalf("Users.GetByID returned error: %v", err)
 	}
 
-	want := &User{ID: Int64(1)}
+	want := &User{ID: Ptr(int64(1))}
 	if !cmp.Equal(user, want) {
 		t.Errorf("Users.GetByID returned %+v, want %+v", user, want)
 	}
@@ -248,7 +248,7 @@ func TestUsersService_Edit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
 
-	input := &User{Name: String("n")}
+	input := &User{Name: Ptr("n")}
 
 	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
 		v := new(User)
@@ -268,7 +268,7 @@ func TestUsersService_Edit(t *testing.T) {
 		t.Errorf("Users.Edit returned error: %v", err)
 	}
 
-	want := &User{ID: Int64(1)}
+	want := &User{ID: Ptr(int64(1))}
 	if !cmp.Equal(user, want) {
 		t.Errorf("Users.Edit returned %+v, want %+v", user, want)
 	}
@@ -300,7 +300,7 @@ func TestUsersService_GetHovercard(t *testing.T) {
 		t.Errorf("Users.GetHovercard returned error: %v", err)
 	}
 
-	want := &Hovercard{Contexts: []*UserContext{{Message: String("Owns this repository"), Octicon: String("repo")}}}
+	want := &Hovercard{Contexts: []*UserContext{{Message: Ptr("Owns this repository"), Octicon: Ptr("repo")}}}
 	if !cmp.Equal(hovercard, want) {
 		t.Errorf("Users.GetHovercard returned %+v, want %+v", hovercard, want)
 	}
@@ -337,7 +337,7 @@ func TestUsersService_ListAll(t *testing.T) {
 		t.Errorf("Users.Get returned error: %v", err)
 	}
 
-	want := []*User{{ID: Int64(2)}}
+	want := []*User{{ID: Ptr(int64(2))}}
 	if !cmp.Equal(users, want) {
 		t.Errorf("Users.ListAll returned %+v, want %+v", users, want)
 	}
@@ -367,7 +367,7 @@ func TestUsersService_ListInvitations(t *testing.T) {
 		t.Errorf("Users.ListInvitations returned error: %v", err)
 	}
 
-	want := []*RepositoryInvitation{{ID: Int64(1)}, {ID: Int64(2)}}
+	want := []*RepositoryInvitation{{ID: Ptr(int64(1))}, {ID: Ptr(int64(2))}}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Users.ListInvitations = %+v, want %+v", got, want)
 	}
@@ -455,8 +455,8 @@ func TestUserContext_Marshal(t *testing.T) {
 	testJSONMarshal(t, &UserContext{}, "{}")
 
 	u := &UserContext{
-		Message: String("message"),
-		Octicon: String("message"),
+		Message: Ptr("message"),
+		Octicon: Ptr("message"),
 	}
 
 	want := `{
@@ -473,8 +473,8 @@ func TestHovercard_Marshal(t *testing.T) {
 	h := &Hovercard{
 		Contexts: []*UserContext{
 			{
-				Message: String("someMessage"),
-				Octicon: String("someOcticon"),
+				Message: Ptr("someMessage"),
+				Octicon: Ptr("someOcticon"),
 			},
 		},
 	}
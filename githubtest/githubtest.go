@@ -0,0 +1,109 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package githubtest provides sample webhook payloads and helpers for testing
+// code that handles GitHub webhooks.
+//
+// The payloads have the shape of the ones GitHub delivers, with the usual
+// repository, organization, sender and installation objects, so that they
+// can be parsed with github.ParseWebHook. They are samples, not captures of
+// real deliveries, and don't include every field GitHub may send.
+//
+// A handler can be tested with a request built by NewWebhookRequest:
+//
+//	req := githubtest.NewWebhookRequest(t, "pull_request", nil, secret)
+//	rec := httptest.NewRecorder()
+//	handler.ServeHTTP(rec, req)
+package githubtest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Events returns the names of the webhook events that have a sample
+// payload, such as "pull_request", in sorted order.
+func Events() []string {
+	entries, err := fs.ReadDir(fixtures, "fixtures")
+	if err != nil {
+		panic(err)
+	}
+	events := make([]string, 0, len(entries))
+	for _, e := range entries {
+		events = append(events, strings.TrimSuffix(e.Name(), ".json"))
+	}
+	sort.Strings(events)
+	return events
+}
+
+// Payload returns the sample payload of the webhook event named event, such
+// as "pull_request". It fails the test if there is no sample for event.
+func Payload(t testing.TB, event string) []byte {
+	t.Helper()
+	payload, err := fixtures.ReadFile("fixtures/" + event + ".json")
+	if err != nil {
+		t.Fatalf("githubtest: no sample payload for event %q", event)
+	}
+	return payload
+}
+
+// NewWebhookRequest returns a webhook delivery request for event, as GitHub
+// would send it to a webhook with the given secret. If payload is nil, the
+// sample payload for event is used. If secret is empty, the request is not
+// signed, like deliveries to webhooks without a secret.
+//
+// The request can be passed to github.ValidatePayload, github.WebHookType
+// and github.DeliveryID, or to an http.Handler.
+func NewWebhookRequest(t testing.TB, event string, payload, secret []byte) *http.Request {
+	t.Helper()
+	if payload == nil {
+		payload = Payload(t, event)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GitHub-Hookshot/githubtest")
+	req.Header.Set(github.EventTypeHeader, event)
+	req.Header.Set(github.DeliveryIDHeader, newDeliveryID(t))
+	if len(secret) > 0 {
+		req.Header.Set(github.SHA256SignatureHeader, Sign(payload, secret))
+	}
+	return req
+}
+
+// Sign returns the value of the X-Hub-Signature-256 header that GitHub sends
+// with payload for a webhook with the given secret.
+func Sign(payload, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newDeliveryID returns a random GUID, the format of the delivery IDs GitHub
+// sends.
+func newDeliveryID(t testing.TB) string {
+	t.Helper()
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		t.Fatalf("githubtest: %v", err)
+	}
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
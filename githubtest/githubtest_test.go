@@ -0,0 +1,116 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+)
+
+func TestEvents(t *testing.T) {
+	if got, want := Events(), github.MessageTypes(); !cmp.Equal(got, want) {
+		t.Errorf("Events and github.MessageTypes differ (-got +want):\n%v", cmp.Diff(got, want))
+	}
+}
+
+func TestPayload(t *testing.T) {
+	for _, event := range Events() {
+		payload := Payload(t, event)
+		parsed, err := github.ParseWebHook(event, payload)
+		if err != nil {
+			t.Errorf("ParseWebHook(%q) returned error: %v", event, err)
+			continue
+		}
+
+		// Every top-level key of the payload must be a field of the event.
+		fields := map[string]bool{}
+		typ := reflect.TypeOf(parsed).Elem()
+		for i := 0; i < typ.NumField(); i++ {
+			name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			fields[name] = true
+		}
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(payload, &keys); err != nil {
+			t.Fatalf("payload of %q is not a JSON object: %v", event, err)
+		}
+		for key := range keys {
+			if !fields[key] {
+				t.Errorf("payload of %q has key %q, which %v has no field for", event, key, typ)
+			}
+		}
+	}
+}
+
+func TestNewWebhookRequest(t *testing.T) {
+	secret := []byte("s3cr3t")
+	req := NewWebhookRequest(t, "pull_request", nil, secret)
+
+	payload, err := github.ValidatePayload(req, secret)
+	if err != nil {
+		t.Fatalf("ValidatePayload returned error: %v", err)
+	}
+	if want := Payload(t, "pull_request"); string(payload) != string(want) {
+		t.Errorf("ValidatePayload returned %s, want the sample payload", payload)
+	}
+	if got, want := github.WebHookType(req), "pull_request"; got != want {
+		t.Errorf("WebHookType = %q, want %q", got, want)
+	}
+	if github.DeliveryID(req) == "" {
+		t.Error("DeliveryID is empty")
+	}
+
+	event, err := github.ParseWebHook(github.WebHookType(req), payload)
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+	pr, ok := event.(*github.PullRequestEvent)
+	if !ok {
+		t.Fatalf("ParseWebHook returned %T, want *github.PullRequestEvent", event)
+	}
+	if got, want := pr.GetPullRequest().GetNumber(), 2; got != want {
+		t.Errorf("pull request number = %v, want %v", got, want)
+	}
+}
+
+func TestNewWebhookRequest_payload(t *testing.T) {
+	payload := []byte(`{"zen":"Keep it logically awesome.","hook_id":1}`)
+	req := NewWebhookRequest(t, "ping", payload, []byte("s3cr3t"))
+
+	if _, err := github.ValidatePayload(req, []byte("wrong")); err == nil {
+		t.Error("ValidatePayload with the wrong secret returned nil error")
+	}
+	got, err := github.ValidatePayload(NewWebhookRequest(t, "ping", payload, []byte("s3cr3t")), []byte("s3cr3t"))
+	if err != nil {
+		t.Fatalf("ValidatePayload returned error: %v", err)
+	}
+	if string(got) != string(payload) {
+		t.Errorf("ValidatePayload returned %s, want %s", got, payload)
+	}
+}
+
+func TestNewWebhookRequest_unsigned(t *testing.T) {
+	req := NewWebhookRequest(t, "push", nil, nil)
+	if sig := req.Header.Get(github.SHA256SignatureHeader); sig != "" {
+		t.Errorf("unsigned request has signature %q", sig)
+	}
+	if _, err := github.ValidatePayload(req, nil); err != nil {
+		t.Errorf("ValidatePayload without secret returned error: %v", err)
+	}
+}
+
+func TestSign(t *testing.T) {
+	// Example from https://docs.github.com/webhooks/using-webhooks/validating-webhook-deliveries.
+	got := Sign([]byte("Hello, World!"), []byte("It's a Secret to Everybody"))
+	want := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	if got != want {
+		t.Errorf("Sign = %v, want %v", got, want)
+	}
+}
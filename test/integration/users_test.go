@@ -191,8 +191,8 @@ func TestUsers_Keys(t *testing.T) {
 
 	// Add new key
 	_, _, err = client.Users.CreateKey(context.Background(), &github.Key{
-		Title: github.String("go-github test key"),
-		Key:   github.String(key),
+		Title: github.Ptr("go-github test key"),
+		Key:   github.Ptr(key),
 	})
 	if err != nil {
 		t.Fatalf("Users.CreateKey() returned error: %v", err)
@@ -0,0 +1,361 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// apiDescriptionFile is the OpenAPI description that struct fields are
+// compared against.
+const apiDescriptionFile = "descriptions/api.github.com/api.github.com.json"
+
+// fieldDrift reports the differences between the JSON fields of the struct
+// returned by a service method and the response schema of its operation.
+type fieldDrift struct {
+	Method    string `json:"method"`
+	Operation string `json:"operation"`
+	Type      string `json:"type"`
+	// Missing lists schema properties that the struct has no field for.
+	Missing []string `json:"missing,omitempty"`
+	// Extra lists struct fields that are not in the schema.
+	Extra []string `json:"extra,omitempty"`
+	// Renamed lists struct fields that are probably a schema property under
+	// another name. They are not included in Missing and Extra.
+	Renamed []renamedField `json:"renamed,omitempty"`
+	// Stubs has a Go struct field declaration for each missing property.
+	Stubs []string `json:"stubs,omitempty"`
+}
+
+type renamedField struct {
+	Field    string `json:"field"`
+	Property string `json:"property"`
+}
+
+// structField is a field of a Go struct that is encoded to JSON.
+type structField struct {
+	name    string // JSON name
+	goField string
+}
+
+// loadStructs returns the JSON fields of the struct types declared in the Go
+// files in dir, keyed by type name. Fields of embedded structs are included.
+func loadStructs(dir string) (map[string][]structField, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	types := map[string]*ast.StructType{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				if st, ok := spec.Type.(*ast.StructType); ok {
+					types[spec.Name.Name] = st
+				}
+				return false
+			})
+		}
+	}
+
+	structs := map[string][]structField{}
+	var fieldsOf func(name string, seen map[string]bool) []structField
+	fieldsOf = func(name string, seen map[string]bool) []structField {
+		st := types[name]
+		if st == nil || seen[name] {
+			return nil
+		}
+		seen[name] = true
+		var fields []structField
+		for _, f := range st.Fields.List {
+			tag := ""
+			if f.Tag != nil {
+				tag, _ = strconv.Unquote(f.Tag.Value)
+			}
+			jsonName, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+			if jsonName == "-" {
+				continue
+			}
+			if len(f.Names) == 0 {
+				// Embedded fields without a name in their tag are inlined.
+				if jsonName == "" {
+					fields = append(fields, fieldsOf(typeName(f.Type), seen)...)
+				}
+				continue
+			}
+			for _, n := range f.Names {
+				if !n.IsExported() {
+					continue
+				}
+				name := jsonName
+				if name == "" {
+					name = n.Name
+				}
+				fields = append(fields, structField{name: name, goField: n.Name})
+			}
+		}
+		return fields
+	}
+	for name := range types {
+		structs[name] = fieldsOf(name, map[string]bool{})
+	}
+	return structs, nil
+}
+
+// typeName returns the name of the type that expr refers to, through any
+// pointers, slices and maps, or "" if it isn't a named type of this package.
+func typeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return typeName(e.X)
+	case *ast.ArrayType:
+		return typeName(e.Elt)
+	case *ast.MapType:
+		return typeName(e.Value)
+	}
+	return ""
+}
+
+// resultType returns the name of the type of the first result of a service
+// method, or "" if the method doesn't return a value of a named type.
+func resultType(fn *ast.FuncDecl) string {
+	results := fn.Type.Results
+	if results == nil || len(results.List) < 3 {
+		return ""
+	}
+	return typeName(results.List[0].Type)
+}
+
+// responseProperties returns the properties of the JSON response schema of
+// the operation named opName, merging allOf, anyOf and oneOf schemas, and
+// whether the operation has one.
+func responseProperties(desc *openapi3.T, opName string) (map[string]*openapi3.Schema, bool) {
+	verb, path := parseOpName(opName)
+	pathItem := desc.Paths.Find(path)
+	if pathItem == nil {
+		return nil, false
+	}
+	op := pathItem.GetOperation(verb)
+	if op == nil || op.Responses == nil {
+		return nil, false
+	}
+	for _, status := range []int{200, 201} {
+		ref := op.Responses.Status(status)
+		if ref == nil || ref.Value == nil {
+			continue
+		}
+		mt := ref.Value.Content.Get("application/json")
+		if mt == nil || mt.Schema == nil || mt.Schema.Value == nil {
+			continue
+		}
+		schema := mt.Schema.Value
+		if schema.Type.Is("array") && schema.Items != nil && schema.Items.Value != nil {
+			schema = schema.Items.Value
+		}
+		props := map[string]*openapi3.Schema{}
+		collectProperties(schema, props)
+		return props, len(props) > 0
+	}
+	return nil, false
+}
+
+func collectProperties(schema *openapi3.Schema, props map[string]*openapi3.Schema) {
+	for name, ref := range schema.Properties {
+		if ref != nil && ref.Value != nil {
+			props[name] = ref.Value
+		}
+	}
+	for _, refs := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, ref := range refs {
+			if ref != nil && ref.Value != nil {
+				collectProperties(ref.Value, props)
+			}
+		}
+	}
+}
+
+// normalizeFieldName returns name without case or separators, to spot
+// fields that only differ in spelling.
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
+
+// structDrift compares fields with props and returns the differences.
+func structDrift(fields []structField, props map[string]*openapi3.Schema, stubs bool) *fieldDrift {
+	drift := &fieldDrift{}
+	fieldNames := map[string]bool{}
+	for _, f := range fields {
+		fieldNames[f.name] = true
+	}
+	var missing []string
+	for name := range props {
+		if !fieldNames[name] {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	missingByNorm := map[string]string{}
+	for _, name := range missing {
+		missingByNorm[normalizeFieldName(name)] = name
+	}
+
+	renamed := map[string]bool{}
+	for _, f := range fields {
+		if _, ok := props[f.name]; ok {
+			continue
+		}
+		if prop, ok := missingByNorm[normalizeFieldName(f.name)]; ok {
+			drift.Renamed = append(drift.Renamed, renamedField{Field: f.name, Property: prop})
+			renamed[prop] = true
+			continue
+		}
+		drift.Extra = append(drift.Extra, f.name)
+	}
+	sort.Strings(drift.Extra)
+	for _, name := range missing {
+		if renamed[name] {
+			continue
+		}
+		drift.Missing = append(drift.Missing, name)
+		if stubs {
+			drift.Stubs = append(drift.Stubs, fieldStub(name, props[name]))
+		}
+	}
+	if len(drift.Missing) == 0 && len(drift.Extra) == 0 && len(drift.Renamed) == 0 {
+		return nil
+	}
+	return drift
+}
+
+// commonInitialisms are written in upper case in Go field names.
+var commonInitialisms = map[string]bool{
+	"api": true, "css": true, "html": true, "http": true, "https": true, "id": true,
+	"ip": true, "json": true, "sha": true, "ssh": true, "sso": true, "url": true,
+}
+
+// fieldStub returns a Go struct field declaration for the property name with
+// the given schema, in the style of the github package.
+func fieldStub(name string, schema *openapi3.Schema) string {
+	var goName strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' }) {
+		if commonInitialisms[part] {
+			goName.WriteString(strings.ToUpper(part))
+			continue
+		}
+		goName.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return fmt.Sprintf("%s %s `json:\"%s,omitempty\"`", goName.String(), stubType(name, schema, true), name)
+}
+
+func stubType(name string, schema *openapi3.Schema, pointer bool) string {
+	ptr := ""
+	if pointer {
+		ptr = "*"
+	}
+	switch {
+	case schema.Type.Is("string") && schema.Format == "date-time":
+		return ptr + "Timestamp"
+	case schema.Type.Is("string"):
+		return ptr + "string"
+	case schema.Type.Is("integer") && (name == "id" || strings.HasSuffix(name, "_id")):
+		return ptr + "int64"
+	case schema.Type.Is("integer"):
+		return ptr + "int"
+	case schema.Type.Is("number"):
+		return ptr + "float64"
+	case schema.Type.Is("boolean"):
+		return ptr + "bool"
+	case schema.Type.Is("array") && schema.Items != nil && schema.Items.Value != nil:
+		return "[]" + stubType(name, schema.Items.Value, false)
+	}
+	return "map[string]interface{}"
+}
+
+// fieldsDrift compares the structs returned by the service methods in dir
+// with the response schemas in desc.
+func fieldsDrift(opsFile *operationsFile, dir string, desc *openapi3.T, stubs bool) ([]*fieldDrift, error) {
+	structs, err := loadStructs(dir)
+	if err != nil {
+		return nil, err
+	}
+	var result []*fieldDrift
+	err = visitServiceMethods(dir, false, func(serviceMethod string, fn *ast.FuncDecl, cmap ast.CommentMap) error {
+		ops, err := methodOps(opsFile, cmap, fn)
+		if err != nil || len(ops) != 1 {
+			return err
+		}
+		typ := resultType(fn)
+		fields, ok := structs[typ]
+		if !ok {
+			return nil
+		}
+		props, ok := responseProperties(desc, ops[0].Name)
+		if !ok {
+			return nil
+		}
+		drift := structDrift(fields, props, stubs)
+		if drift == nil {
+			return nil
+		}
+		drift.Method = serviceMethod
+		drift.Operation = ops[0].Name
+		drift.Type = typ
+		result = append(result, drift)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Method < result[j].Method
+	})
+	return result, nil
+}
+
+// loadAPIDescription loads the OpenAPI description from filename if it is
+// set, and from github.com/github/rest-api-description at ref otherwise.
+func (c *fieldsCmd) loadAPIDescription(ctx context.Context, root *rootCmd, ref string) (*openapi3.T, error) {
+	if c.Description != "" {
+		filename := c.Description
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(root.WorkingDir, filename)
+		}
+		return openapi3.NewLoader().LoadFromFile(filename)
+	}
+	if ref == "" {
+		return nil, errors.New("openapi_operations.yaml does not have an openapi_commit field")
+	}
+	client, err := githubClient(root.GithubURL)
+	if err != nil {
+		return nil, err
+	}
+	file := &openapiFile{filename: apiDescriptionFile}
+	if err := file.loadDescription(ctx, client, ref); err != nil {
+		return nil, err
+	}
+	return file.description, nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestFieldStub(t *testing.T) {
+	str := &openapi3.Schema{Type: &openapi3.Types{"string"}}
+	for _, tt := range []struct {
+		name   string
+		schema *openapi3.Schema
+		want   string
+	}{
+		{"full_name", str, "FullName *string `json:\"full_name,omitempty\"`"},
+		{"html_url", str, "HTMLURL *string `json:\"html_url,omitempty\"`"},
+		{"created_at", &openapi3.Schema{Type: &openapi3.Types{"string"}, Format: "date-time"}, "CreatedAt *Timestamp `json:\"created_at,omitempty\"`"},
+		{"owner_id", &openapi3.Schema{Type: &openapi3.Types{"integer"}}, "OwnerID *int64 `json:\"owner_id,omitempty\"`"},
+		{"count", &openapi3.Schema{Type: &openapi3.Types{"integer"}}, "Count *int `json:\"count,omitempty\"`"},
+		{"score", &openapi3.Schema{Type: &openapi3.Types{"number"}}, "Score *float64 `json:\"score,omitempty\"`"},
+		{"private", &openapi3.Schema{Type: &openapi3.Types{"boolean"}}, "Private *bool `json:\"private,omitempty\"`"},
+		{"topics", &openapi3.Schema{Type: &openapi3.Types{"array"}, Items: openapi3.NewSchemaRef("", str)}, "Topics []string `json:\"topics,omitempty\"`"},
+		{"license", &openapi3.Schema{Type: &openapi3.Types{"object"}}, "License map[string]interface{} `json:\"license,omitempty\"`"},
+	} {
+		if got := fieldStub(tt.name, tt.schema); got != tt.want {
+			t.Errorf("fieldStub(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -34,6 +34,14 @@ Update go source code to be consistent with openapi_operations.yaml.
 	"format_help": `Format whitespace in openapi_operations.yaml and sort its operations.`,
 	"unused_help": `List operations in openapi_operations.yaml that aren't used by any service methods.`,
 
+	"fields_help": `
+Compare the JSON fields of the structs returned by service methods with the response schemas in the OpenAPI
+description of api.github.com, and output a JSON report of missing, extra and probably renamed fields.
+`,
+	"fields_ref_help":         `Git ref to pull the OpenAPI description from. Defaults to openapi_commit in openapi_operations.yaml.`,
+	"fields_description_help": `Read the OpenAPI description from this file instead of pulling it from GitHub.`,
+	"fields_stubs_help":       `Include Go struct field declarations for missing fields in the report.`,
+
 	"working_dir_help": `Working directory. Should be the root of the go-github repository.`,
 	"openapi_ref_help": `Git ref to pull OpenAPI descriptions from.`,
 
@@ -51,6 +59,7 @@ type rootCmd struct {
 	UpdateGo      updateGoCmd      `kong:"cmd,help=${update_go_help}"`
 	Format        formatCmd        `kong:"cmd,help=${format_help}"`
 	Unused        unusedCmd        `kong:"cmd,help=${unused_help}"`
+	Fields        fieldsCmd        `kong:"cmd,help=${fields_help}"`
 
 	WorkingDir string `kong:"short=C,default=.,help=${working_dir_help}"`
 
@@ -172,6 +181,37 @@ func (c *unusedCmd) Run(root *rootCmd, k *kong.Context) error {
 	return nil
 }
 
+type fieldsCmd struct {
+	Ref         string `kong:"help=${fields_ref_help}"`
+	Description string `kong:"help=${fields_description_help}"`
+	Stubs       bool   `kong:"help=${fields_stubs_help}"`
+}
+
+func (c *fieldsCmd) Run(root *rootCmd, k *kong.Context) error {
+	_, opsFile, err := root.opsFile()
+	if err != nil {
+		return err
+	}
+	ref := c.Ref
+	if ref == "" {
+		ref = opsFile.GitCommit
+	}
+	desc, err := c.loadAPIDescription(context.Background(), root, ref)
+	if err != nil {
+		return err
+	}
+	drift, err := fieldsDrift(opsFile, filepath.Join(root.WorkingDir, "github"), desc, c.Stubs)
+	if err != nil {
+		return err
+	}
+	if drift == nil {
+		drift = []*fieldDrift{}
+	}
+	enc := json.NewEncoder(k.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(drift)
+}
+
 func main() {
 	err := run(os.Args[1:], nil)
 	if err != nil {
@@ -63,6 +63,57 @@ GET /undocumented/{undocumented_id}
 `, "")
 }
 
+func TestFields(t *testing.T) {
+	res := runTest(t, "testdata/fields", "fields", "--description", "description.json")
+	res.assertOutput(`
+[
+  {
+    "method": "AService.GetA",
+    "operation": "GET /a/{a_id}",
+    "type": "A",
+    "missing": [
+      "created_at",
+      "license",
+      "owner_id",
+      "private",
+      "topics"
+    ],
+    "extra": [
+      "unknown"
+    ],
+    "renamed": [
+      {
+        "field": "fullname",
+        "property": "full_name"
+      }
+    ]
+  },
+  {
+    "method": "AService.ListA",
+    "operation": "GET /a",
+    "type": "A",
+    "missing": [
+      "created_at",
+      "license",
+      "owner_id",
+      "private",
+      "topics"
+    ],
+    "extra": [
+      "unknown"
+    ],
+    "renamed": [
+      {
+        "field": "fullname",
+        "property": "full_name"
+      }
+    ]
+  }
+]
+`, "")
+	res.assertNoErr()
+}
+
 func TestUpdateOpenAPI(t *testing.T) {
 	testServer := newTestServer(t, "main", map[string]interface{}{
 		"api.github.com/api.github.com.json": openapi3.T{
@@ -349,19 +400,19 @@ func newTestServer(t *testing.T, ref string, files map[string]interface{}) *http
 	server := httptest.NewServer(mux)
 	mux.HandleFunc(
 		path.Join(repoPath, "commits", ref),
-		jsonHandler(emptyQuery, &github.RepositoryCommit{SHA: github.String("s")}),
+		jsonHandler(emptyQuery, &github.RepositoryCommit{SHA: github.Ptr("s")}),
 	)
 	var descriptionsContent []*github.RepositoryContent
 	for name, content := range files {
 		descriptionsContent = append(descriptionsContent, &github.RepositoryContent{
-			Name: github.String(path.Base(path.Dir(name))),
+			Name: github.Ptr(path.Base(path.Dir(name))),
 		})
 		mux.HandleFunc(
 			path.Join(repoPath, "contents/descriptions", path.Dir(name)),
 			jsonHandler(refQuery, []*github.RepositoryContent{
 				{
-					Name:        github.String(path.Base(name)),
-					DownloadURL: github.String(server.URL + "/dl/" + name),
+					Name:        github.Ptr(path.Base(name)),
+					DownloadURL: github.Ptr(server.URL + "/dl/" + name),
 				},
 			}),
 		)
@@ -222,16 +222,23 @@ func addOperation(ops []*operation, filename, opName, docURL string) []*operatio
 			op.DocumentationURL = docURL
 			return ops
 		}
-		// just append to files, but only add the first ghes file
+		// just append to files, but only keep the first and the last ghes
+		// files. They are added newest first, so these are the newest and
+		// the oldest GHES versions that have the operation.
 		if !strings.Contains(filename, "/ghes") {
 			op.OpenAPIFiles = append(op.OpenAPIFiles, filename)
 			return ops
 		}
-		for _, f := range op.OpenAPIFiles {
+		var ghesFiles []int
+		for i, f := range op.OpenAPIFiles {
 			if strings.Contains(f, "/ghes") {
-				return ops
+				ghesFiles = append(ghesFiles, i)
 			}
 		}
+		if len(ghesFiles) > 1 {
+			op.OpenAPIFiles[ghesFiles[len(ghesFiles)-1]] = filename
+			return ops
+		}
 		op.OpenAPIFiles = append(op.OpenAPIFiles, filename)
 		return ops
 	}
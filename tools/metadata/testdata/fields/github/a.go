@@ -0,0 +1,38 @@
+package github
+
+type AService struct{}
+
+type Response struct{}
+
+type A struct {
+	ID          *int64  `json:"id,omitempty"`
+	FullName    *string `json:"fullname,omitempty"`
+	Unknown     *string `json:"unknown,omitempty"`
+	notExported string
+	Ignored     string `json:"-"`
+
+	Embedded
+}
+
+type Embedded struct {
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+type B struct {
+	ID *int64 `json:"id,omitempty"`
+}
+
+// GetA
+//
+//meta:operation GET /a/{a_id}
+func (s *AService) GetA() (*A, *Response, error) { return nil, nil, nil }
+
+// ListA
+//
+//meta:operation GET /a
+func (s *AService) ListA() ([]*A, *Response, error) { return nil, nil, nil }
+
+// GetB
+//
+//meta:operation GET /b/{b_id}
+func (s *AService) GetB() (*B, *Response, error) { return nil, nil, nil }